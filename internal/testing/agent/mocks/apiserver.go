@@ -16,7 +16,12 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
+// PendingPatch is served to the agent until it clears it
+const PendingPatch = "diff --git a/README.md b/README.md\n"
+
 func NewMockRestServer(t *testing.T, workspace *workspace.Workspace) *httptest.Server {
+	pendingPatch := PendingPatch
+
 	router := gin.Default()
 	serverController := router.Group("/server")
 	{
@@ -42,6 +47,16 @@ func NewMockRestServer(t *testing.T, workspace *workspace.Workspace) *httptest.S
 		workspaceController.GET("/:workspaceId", func(ctx *gin.Context) {
 			ctx.JSON(http.StatusOK, workspace)
 		})
+		workspaceController.GET("/:workspaceId/:projectId/secrets", func(ctx *gin.Context) {
+			ctx.JSON(http.StatusOK, map[string]string{"TEST_SECRET": "test-secret-value"})
+		})
+		workspaceController.GET("/:workspaceId/:projectId/patch/pending", func(ctx *gin.Context) {
+			ctx.String(http.StatusOK, pendingPatch)
+		})
+		workspaceController.DELETE("/:workspaceId/:projectId/patch/pending", func(ctx *gin.Context) {
+			pendingPatch = ""
+			ctx.Status(http.StatusOK)
+		})
 	}
 
 	gitproviderController := router.Group("/gitprovider")
@@ -7,12 +7,15 @@ package build
 
 import (
 	"fmt"
+	"slices"
+	"sync"
 
 	"github.com/daytonaio/daytona/pkg/build"
 )
 
 type InMemoryBuildStore struct {
 	builds map[string]*build.Build
+	mutex  sync.RWMutex
 }
 
 func NewInMemoryBuildStore() build.Store {
@@ -22,6 +25,9 @@ func NewInMemoryBuildStore() build.Store {
 }
 
 func (s *InMemoryBuildStore) Find(filter *build.Filter) (*build.Build, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
 	builds, err := s.processFilters(filter)
 	if err != nil {
 		return nil, err
@@ -30,10 +36,14 @@ func (s *InMemoryBuildStore) Find(filter *build.Filter) (*build.Build, error) {
 		return nil, build.ErrBuildNotFound
 	}
 
-	return builds[0], nil
+	b := *builds[0]
+	return &b, nil
 }
 
 func (s *InMemoryBuildStore) List(filter *build.Filter) ([]*build.Build, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
 	builds, err := s.processFilters(filter)
 	if err != nil {
 		return nil, err
@@ -43,11 +53,31 @@ func (s *InMemoryBuildStore) List(filter *build.Filter) ([]*build.Build, error)
 }
 
 func (s *InMemoryBuildStore) Save(result *build.Build) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
 	s.builds[result.Id] = result
 	return nil
 }
 
+func (s *InMemoryBuildStore) SaveIfState(result *build.Build, states []build.BuildState) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	existing, ok := s.builds[result.Id]
+	if !ok || !slices.Contains(states, existing.State) {
+		return build.ErrBuildStateChanged
+	}
+
+	b := *result
+	s.builds[result.Id] = &b
+	return nil
+}
+
 func (s *InMemoryBuildStore) Delete(id string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
 	delete(s.builds, id)
 	return nil
 }
@@ -47,6 +47,16 @@ func (m *MockApiClient) ImageList(ctx context.Context, options image.ListOptions
 	return args.Get(0).([]image.Summary), args.Error(1)
 }
 
+func (m *MockApiClient) ImageInspectWithRaw(ctx context.Context, image string) (types.ImageInspect, []byte, error) {
+	args := m.Called(ctx, image)
+	return args.Get(0).(types.ImageInspect), nil, args.Error(1)
+}
+
+func (m *MockApiClient) ImageRemove(ctx context.Context, imageID string, options image.RemoveOptions) ([]image.DeleteResponse, error) {
+	args := m.Called(ctx, imageID, options)
+	return args.Get(0).([]image.DeleteResponse), args.Error(1)
+}
+
 func (m *MockApiClient) ImagePull(ctx context.Context, ref string, options image.PullOptions) (io.ReadCloser, error) {
 	args := m.Called(ctx, ref, options)
 	return args.Get(0).(io.ReadCloser), args.Error(1)
@@ -67,6 +77,11 @@ func (m *MockApiClient) ContainerRemove(ctx context.Context, container string, o
 	return args.Error(0)
 }
 
+func (m *MockApiClient) ContainerCommit(ctx context.Context, container string, options container.CommitOptions) (types.IDResponse, error) {
+	args := m.Called(ctx, container, options)
+	return args.Get(0).(types.IDResponse), args.Error(1)
+}
+
 func (m *MockApiClient) ContainerStart(ctx context.Context, container string, startOptions container.StartOptions) error {
 	args := m.Called(ctx, container, startOptions)
 	return args.Error(0)
@@ -6,6 +6,7 @@
 package mocks
 
 import (
+	"context"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
 	"github.com/go-git/go-git/v5/plumbing/transport/http"
@@ -16,8 +17,8 @@ type MockGitService struct {
 	mock.Mock
 }
 
-func (m *MockGitService) CloneRepository(repo *gitprovider.GitRepository, auth *http.BasicAuth) error {
-	args := m.Called(repo, auth)
+func (m *MockGitService) CloneRepository(ctx context.Context, repo *gitprovider.GitRepository, auth *http.BasicAuth) error {
+	args := m.Called(ctx, repo, auth)
 	return args.Error(0)
 }
 
@@ -41,6 +42,16 @@ func (m *MockGitService) GetGitStatus() (*project.GitStatus, error) {
 	return args.Get(0).(*project.GitStatus), args.Error(1)
 }
 
+func (m *MockGitService) GetPatch() (*project.Patch, error) {
+	args := m.Called()
+	return args.Get(0).(*project.Patch), args.Error(1)
+}
+
+func (m *MockGitService) ApplyPatch(patch string) error {
+	args := m.Called(patch)
+	return args.Error(0)
+}
+
 func NewMockGitService() *MockGitService {
 	gitService := new(MockGitService)
 	return gitService
@@ -31,6 +31,11 @@ func (m *MockGitProvider) GetUser() (*gitprovider.GitUser, error) {
 	return args.Get(0).(*gitprovider.GitUser), args.Error(1)
 }
 
+func (m *MockGitProvider) GetTokenInfo() (*gitprovider.TokenInfo, error) {
+	args := m.Called()
+	return args.Get(0).(*gitprovider.TokenInfo), args.Error(1)
+}
+
 func (m *MockGitProvider) GetBranchByCommit(staticContext *gitprovider.StaticGitContext) (string, error) {
 	args := m.Called(staticContext)
 	return args.String(0), args.Error(1)
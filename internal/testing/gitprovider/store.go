@@ -0,0 +1,62 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package gitprovider
+
+import (
+	"sort"
+
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+)
+
+type InMemoryGitProviderConfigStore struct {
+	configs map[string]*gitprovider.GitProviderConfig
+}
+
+func NewInMemoryGitProviderConfigStore() gitprovider.ConfigStore {
+	return &InMemoryGitProviderConfigStore{
+		configs: make(map[string]*gitprovider.GitProviderConfig),
+	}
+}
+
+func (s *InMemoryGitProviderConfigStore) List() ([]*gitprovider.GitProviderConfig, error) {
+	configs := []*gitprovider.GitProviderConfig{}
+	for _, config := range s.configs {
+		c := *config
+		configs = append(configs, &c)
+	}
+
+	sort.Slice(configs, func(i, j int) bool {
+		return configs[i].Id < configs[j].Id
+	})
+
+	return configs, nil
+}
+
+// Find returns a copy of the config, like the database store does
+func (s *InMemoryGitProviderConfigStore) Find(id string) (*gitprovider.GitProviderConfig, error) {
+	config, ok := s.configs[id]
+	if !ok {
+		return nil, gitprovider.ErrGitProviderConfigNotFound
+	}
+
+	c := *config
+	return &c, nil
+}
+
+func (s *InMemoryGitProviderConfigStore) Save(config *gitprovider.GitProviderConfig) error {
+	c := *config
+	s.configs[config.Id] = &c
+	return nil
+}
+
+func (s *InMemoryGitProviderConfigStore) Delete(config *gitprovider.GitProviderConfig) error {
+	_, ok := s.configs[config.Id]
+	if !ok {
+		return gitprovider.ErrGitProviderConfigNotFound
+	}
+	delete(s.configs, config.Id)
+	return nil
+}
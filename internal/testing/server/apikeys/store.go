@@ -44,7 +44,7 @@ func (s *InMemoryApiKeyStore) FindByName(name string) (*apikey.ApiKey, error) {
 		}
 	}
 
-	return nil, nil
+	return nil, apikey.ErrApiKeyNotFound
 }
 
 func (s *InMemoryApiKeyStore) Save(apiKey *apikey.ApiKey) error {
@@ -52,6 +52,12 @@ func (s *InMemoryApiKeyStore) Save(apiKey *apikey.ApiKey) error {
 	return nil
 }
 
+func (s *InMemoryApiKeyStore) Rotate(replacedKey *apikey.ApiKey, newKey *apikey.ApiKey) error {
+	s.apiKeys[replacedKey.KeyHash] = replacedKey
+	s.apiKeys[newKey.KeyHash] = newKey
+	return nil
+}
+
 func (s *InMemoryApiKeyStore) Delete(apiKey *apikey.ApiKey) error {
 	delete(s.apiKeys, apiKey.KeyHash)
 	return nil
@@ -0,0 +1,76 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package auditlog
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/audit"
+)
+
+type InMemoryAuditStore struct {
+	records map[string]*audit.Record
+	mutex   sync.RWMutex
+}
+
+func NewInMemoryAuditStore() audit.Store {
+	return &InMemoryAuditStore{
+		records: make(map[string]*audit.Record),
+	}
+}
+
+func (s *InMemoryAuditStore) List(filter *audit.Filter) ([]*audit.Record, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	records := []*audit.Record{}
+	for _, r := range s.records {
+		if filter != nil {
+			if filter.Since != nil && r.Timestamp.Before(*filter.Since) {
+				continue
+			}
+			if filter.KeyName != nil && r.KeyName != *filter.KeyName {
+				continue
+			}
+			if filter.Resource != nil && !strings.HasPrefix(r.Resource, *filter.Resource) {
+				continue
+			}
+		}
+		records = append(records, r)
+	}
+
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].Timestamp.After(records[j].Timestamp)
+	})
+
+	return records, nil
+}
+
+func (s *InMemoryAuditStore) Save(record *audit.Record) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.records[record.Id] = record
+	return nil
+}
+
+func (s *InMemoryAuditStore) DeleteBefore(timestamp time.Time) (int, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	deleted := 0
+	for id, r := range s.records {
+		if r.Timestamp.Before(timestamp) {
+			delete(s.records, id)
+			deleted++
+		}
+	}
+
+	return deleted, nil
+}
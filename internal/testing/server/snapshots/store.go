@@ -0,0 +1,70 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshots
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project/snapshot"
+)
+
+type InMemorySnapshotStore struct {
+	snapshots map[string]*snapshot.Snapshot
+	mutex     sync.RWMutex
+}
+
+func NewInMemorySnapshotStore() snapshot.Store {
+	return &InMemorySnapshotStore{
+		snapshots: make(map[string]*snapshot.Snapshot),
+	}
+}
+
+func (s *InMemorySnapshotStore) List(workspaceId string) ([]*snapshot.Snapshot, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	snapshots := []*snapshot.Snapshot{}
+	for _, snap := range s.snapshots {
+		if snap.WorkspaceId == workspaceId {
+			snapshots = append(snapshots, snap)
+		}
+	}
+
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].CreatedAt.Before(snapshots[j].CreatedAt)
+	})
+
+	return snapshots, nil
+}
+
+func (s *InMemorySnapshotStore) Find(id string) (*snapshot.Snapshot, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	snap, ok := s.snapshots[id]
+	if !ok {
+		return nil, snapshot.ErrSnapshotNotFound
+	}
+
+	return snap, nil
+}
+
+func (s *InMemorySnapshotStore) Save(snap *snapshot.Snapshot) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.snapshots[snap.Id] = snap
+	return nil
+}
+
+func (s *InMemorySnapshotStore) Delete(snap *snapshot.Snapshot) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.snapshots, snap.Id)
+	return nil
+}
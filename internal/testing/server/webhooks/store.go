@@ -0,0 +1,137 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhooks
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/daytonaio/daytona/pkg/webhook"
+)
+
+type InMemoryWebhookStore struct {
+	webhooks map[string]*webhook.Webhook
+	mutex    sync.RWMutex
+}
+
+func NewInMemoryWebhookStore() webhook.Store {
+	return &InMemoryWebhookStore{
+		webhooks: make(map[string]*webhook.Webhook),
+	}
+}
+
+func (s *InMemoryWebhookStore) List() ([]*webhook.Webhook, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	webhooks := []*webhook.Webhook{}
+	for _, w := range s.webhooks {
+		webhooks = append(webhooks, w)
+	}
+
+	return webhooks, nil
+}
+
+func (s *InMemoryWebhookStore) Find(id string) (*webhook.Webhook, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	w, ok := s.webhooks[id]
+	if !ok {
+		return nil, webhook.ErrWebhookNotFound
+	}
+
+	return w, nil
+}
+
+func (s *InMemoryWebhookStore) Save(w *webhook.Webhook) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.webhooks[w.Id] = w
+	return nil
+}
+
+func (s *InMemoryWebhookStore) Delete(w *webhook.Webhook) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.webhooks[w.Id]; !ok {
+		return webhook.ErrWebhookNotFound
+	}
+
+	delete(s.webhooks, w.Id)
+	return nil
+}
+
+type InMemoryDeliveryStore struct {
+	deliveries map[string]*webhook.Delivery
+	mutex      sync.RWMutex
+}
+
+func NewInMemoryDeliveryStore() webhook.DeliveryStore {
+	return &InMemoryDeliveryStore{
+		deliveries: make(map[string]*webhook.Delivery),
+	}
+}
+
+func (s *InMemoryDeliveryStore) List(webhookId string) ([]*webhook.Delivery, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.list(webhookId), nil
+}
+
+func (s *InMemoryDeliveryStore) Save(delivery *webhook.Delivery) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	d := *delivery
+	s.deliveries[delivery.Id] = &d
+	return nil
+}
+
+func (s *InMemoryDeliveryStore) Prune(webhookId string, keep int) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	deliveries := s.list(webhookId)
+	if len(deliveries) <= keep {
+		return nil
+	}
+
+	for _, d := range deliveries[keep:] {
+		delete(s.deliveries, d.Id)
+	}
+
+	return nil
+}
+
+func (s *InMemoryDeliveryStore) DeleteAll(webhookId string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, d := range s.list(webhookId) {
+		delete(s.deliveries, d.Id)
+	}
+
+	return nil
+}
+
+func (s *InMemoryDeliveryStore) list(webhookId string) []*webhook.Delivery {
+	deliveries := []*webhook.Delivery{}
+	for _, d := range s.deliveries {
+		if d.WebhookId == webhookId {
+			deliveries = append(deliveries, d)
+		}
+	}
+
+	sort.Slice(deliveries, func(i, j int) bool {
+		return deliveries[i].CreatedAt.After(deliveries[j].CreatedAt)
+	})
+
+	return deliveries
+}
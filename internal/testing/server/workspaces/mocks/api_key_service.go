@@ -6,6 +6,8 @@
 package mocks
 
 import (
+	"time"
+
 	"github.com/daytonaio/daytona/pkg/apikey"
 	"github.com/stretchr/testify/mock"
 )
@@ -23,6 +25,21 @@ func (s *mockApiKeyService) Generate(keyType apikey.ApiKeyType, name string) (st
 	return args.String(0), args.Error(1)
 }
 
+func (s *mockApiKeyService) GenerateWithRole(keyType apikey.ApiKeyType, name string, role apikey.ApiKeyRole, expiresAt *time.Time) (string, error) {
+	args := s.Called(keyType, name, role, expiresAt)
+	return args.String(0), args.Error(1)
+}
+
+func (s *mockApiKeyService) Rotate(name string, gracePeriod time.Duration, expiresAt *time.Time) (string, error) {
+	args := s.Called(name, gracePeriod, expiresAt)
+	return args.String(0), args.Error(1)
+}
+
+func (s *mockApiKeyService) PurgeExpired() (int, error) {
+	args := s.Called()
+	return args.Int(0), args.Error(1)
+}
+
 func (s *mockApiKeyService) IsProjectApiKey(apiKey string) bool {
 	args := s.Called(apiKey)
 	return args.Bool(0)
@@ -33,6 +50,11 @@ func (s *mockApiKeyService) IsValidApiKey(apiKey string) bool {
 	return args.Bool(0)
 }
 
+func (s *mockApiKeyService) Authenticate(apiKey string) (*apikey.ApiKey, error) {
+	args := s.Called(apiKey)
+	return args.Get(0).(*apikey.ApiKey), args.Error(1)
+}
+
 func (s *mockApiKeyService) IsWorkspaceApiKey(apiKey string) bool {
 	args := s.Called(apiKey)
 	return args.Bool(0)
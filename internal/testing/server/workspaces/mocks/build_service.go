@@ -42,6 +42,16 @@ func (m *MockBuildService) MarkForDeletion(filter *build.Filter) []error {
 	return args.Get(0).([]error)
 }
 
+func (m *MockBuildService) Cancel(id string) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockBuildService) Retry(id string) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
 func (m *MockBuildService) Delete(id string) error {
 	args := m.Called(id)
 	return args.Error(0)
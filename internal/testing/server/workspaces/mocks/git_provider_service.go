@@ -6,6 +6,7 @@
 package mocks
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/daytonaio/daytona/pkg/gitprovider"
@@ -90,6 +91,21 @@ func (m *MockGitProviderService) GetLastCommitSha(repo *gitprovider.GitRepositor
 	return args.String(0), args.Error(1)
 }
 
+func (m *MockGitProviderService) GetTokenHealth(gitProviderId string) (*gitprovider.TokenHealth, error) {
+	args := m.Called(gitProviderId)
+	return args.Get(0).(*gitprovider.TokenHealth), args.Error(1)
+}
+
+func (m *MockGitProviderService) StartOAuthDeviceFlow(gitProviderId string, baseApiUrl *string) (*gitprovider.OAuthDeviceCode, error) {
+	args := m.Called(gitProviderId, baseApiUrl)
+	return args.Get(0).(*gitprovider.OAuthDeviceCode), args.Error(1)
+}
+
+func (m *MockGitProviderService) CompleteOAuthDeviceFlow(ctx context.Context, gitProviderId string, baseApiUrl *string, deviceCode *gitprovider.OAuthDeviceCode) error {
+	args := m.Called(ctx, gitProviderId, baseApiUrl, deviceCode)
+	return args.Error(0)
+}
+
 func (m *MockGitProviderService) RegisterPrebuildWebhook(gitProviderId string, repo *gitprovider.GitRepository, endpointUrl string) (string, error) {
 	args := m.Called(gitProviderId, repo, endpointUrl)
 	return args.String(0), args.Error(1)
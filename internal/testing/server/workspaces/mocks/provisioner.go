@@ -66,3 +66,18 @@ func (p *mockProvisioner) StopWorkspace(workspace *workspace.Workspace, target *
 	args := p.Called(workspace, target)
 	return args.Error(0)
 }
+
+func (p *mockProvisioner) SnapshotProject(proj *project.Project, target *provider.ProviderTarget, snapshotId string) error {
+	args := p.Called(proj, target, snapshotId)
+	return args.Error(0)
+}
+
+func (p *mockProvisioner) RestoreProject(proj *project.Project, target *provider.ProviderTarget, snapshotId string) error {
+	args := p.Called(proj, target, snapshotId)
+	return args.Error(0)
+}
+
+func (p *mockProvisioner) DeleteSnapshot(proj *project.Project, target *provider.ProviderTarget, snapshotId string) error {
+	args := p.Called(proj, target, snapshotId)
+	return args.Error(0)
+}
@@ -6,11 +6,17 @@
 package workspaces
 
 import (
+	"sync"
+
 	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
 )
 
+// InMemoryWorkspaceStore stores copies of the workspaces so that, like a database,
+// changes are only visible to other readers after they have been saved
 type InMemoryWorkspaceStore struct {
 	workspaces map[string]*workspace.Workspace
+	mutex      sync.RWMutex
 }
 
 func NewInMemoryWorkspaceStore() workspace.Store {
@@ -20,34 +26,60 @@ func NewInMemoryWorkspaceStore() workspace.Store {
 }
 
 func (s *InMemoryWorkspaceStore) List() ([]*workspace.Workspace, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
 	workspaces := []*workspace.Workspace{}
 	for _, w := range s.workspaces {
-		workspaces = append(workspaces, w)
+		workspaces = append(workspaces, copyWorkspace(w))
 	}
 
 	return workspaces, nil
 }
 
 func (s *InMemoryWorkspaceStore) Find(idOrName string) (*workspace.Workspace, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
 	ws, ok := s.workspaces[idOrName]
 	if !ok {
 		for _, w := range s.workspaces {
 			if w.Name == idOrName {
-				return w, nil
+				return copyWorkspace(w), nil
 			}
 		}
 		return nil, workspace.ErrWorkspaceNotFound
 	}
 
-	return ws, nil
+	return copyWorkspace(ws), nil
 }
 
 func (s *InMemoryWorkspaceStore) Save(workspace *workspace.Workspace) error {
-	s.workspaces[workspace.Id] = workspace
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.workspaces[workspace.Id] = copyWorkspace(workspace)
 	return nil
 }
 
 func (s *InMemoryWorkspaceStore) Delete(workspace *workspace.Workspace) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
 	delete(s.workspaces, workspace.Id)
 	return nil
 }
+
+func copyWorkspace(w *workspace.Workspace) *workspace.Workspace {
+	workspaceCopy := *w
+
+	if w.Projects != nil {
+		workspaceCopy.Projects = []*project.Project{}
+		for _, p := range w.Projects {
+			projectCopy := *p
+			workspaceCopy.Projects = append(workspaceCopy.Projects, &projectCopy)
+		}
+	}
+
+	return &workspaceCopy
+}
@@ -37,6 +37,12 @@ func ToProject(projectDTO *apiclient.Project) *project.Project {
 			Uptime:    uint64(uptime),
 			GitStatus: ToGitStatus(projectDTO.State.GitStatus),
 		}
+		if projectDTO.State.LastActivity != nil {
+			projectState.LastActivity = *projectDTO.State.LastActivity
+		}
+		if projectDTO.State.Metrics != nil {
+			projectState.Metrics = ToProjectMetrics(*projectDTO.State.Metrics)
+		}
 	}
 
 	var projectBuild *buildconfig.BuildConfig
@@ -47,6 +53,14 @@ func ToProject(projectDTO *apiclient.Project) *project.Project {
 				FilePath: projectDTO.BuildConfig.Devcontainer.FilePath,
 			}
 		}
+		if projectDTO.BuildConfig.Dockerfile != nil {
+			projectBuild.Dockerfile = &buildconfig.DockerfileConfig{
+				FilePath:  projectDTO.BuildConfig.Dockerfile.FilePath,
+				Context:   projectDTO.BuildConfig.Dockerfile.GetContext(),
+				BuildArgs: projectDTO.BuildConfig.Dockerfile.GetBuildArgs(),
+				Target:    projectDTO.BuildConfig.Dockerfile.GetTarget(),
+			}
+		}
 	}
 
 	project := &project.Project{
@@ -54,10 +68,12 @@ func ToProject(projectDTO *apiclient.Project) *project.Project {
 		Image:       projectDTO.Image,
 		User:        projectDTO.User,
 		BuildConfig: projectBuild,
+		Resources:   ToResourceLimits(projectDTO.Resources),
 		Repository:  repository,
 		Target:      projectDTO.Target,
 		WorkspaceId: projectDTO.WorkspaceId,
 		State:       projectState,
+		Secrets:     projectDTO.Secrets,
 	}
 
 	if projectDTO.Repository.PrNumber != nil {
@@ -68,6 +84,18 @@ func ToProject(projectDTO *apiclient.Project) *project.Project {
 	return project
 }
 
+func ToResourceLimits(limitsDTO *apiclient.ResourceLimits) *project.ResourceLimits {
+	if limitsDTO == nil {
+		return nil
+	}
+
+	return &project.ResourceLimits{
+		Cpus:   float64(limitsDTO.GetCpus()),
+		Memory: uint64(limitsDTO.GetMemory()),
+		Disk:   uint64(limitsDTO.GetDisk()),
+	}
+}
+
 func ToGitStatus(gitStatusDTO apiclient.GitStatus) *project.GitStatus {
 	files := []*project.FileStatus{}
 	for _, fileDTO := range gitStatusDTO.FileStatus {
@@ -112,11 +140,41 @@ func ToGitStatusDTO(gitStatus *project.GitStatus) *apiclient.GitStatus {
 	}
 }
 
+func ToProjectMetrics(metricsDTO apiclient.ProjectMetrics) *project.ProjectMetrics {
+	return &project.ProjectMetrics{
+		CpuUsage:    float64(metricsDTO.CpuUsage),
+		MemoryUsage: uint64(metricsDTO.MemoryUsage),
+		MemoryLimit: uint64(metricsDTO.MemoryLimit),
+		DiskUsage:   uint64(metricsDTO.DiskUsage),
+		DiskTotal:   uint64(metricsDTO.DiskTotal),
+		Processes:   uint64(metricsDTO.Processes),
+		CollectedAt: metricsDTO.CollectedAt,
+	}
+}
+
+func ToProjectMetricsDTO(metrics *project.ProjectMetrics) *apiclient.ProjectMetrics {
+	if metrics == nil {
+		return nil
+	}
+
+	return &apiclient.ProjectMetrics{
+		CpuUsage:    float32(metrics.CpuUsage),
+		MemoryUsage: int32(metrics.MemoryUsage),
+		MemoryLimit: int32(metrics.MemoryLimit),
+		DiskUsage:   int32(metrics.DiskUsage),
+		DiskTotal:   int32(metrics.DiskTotal),
+		Processes:   int32(metrics.Processes),
+		CollectedAt: metrics.CollectedAt,
+	}
+}
+
 func ToProjectConfig(createProjectConfigDto pc_dto.CreateProjectConfigDTO) *config.ProjectConfig {
 	result := &config.ProjectConfig{
 		Name:        createProjectConfigDto.Name,
 		BuildConfig: createProjectConfigDto.BuildConfig,
+		Resources:   createProjectConfigDto.Resources,
 		EnvVars:     createProjectConfigDto.EnvVars,
+		Secrets:     createProjectConfigDto.Secrets,
 	}
 
 	result.RepositoryUrl = createProjectConfigDto.RepositoryUrl
@@ -136,8 +194,10 @@ func CreateDtoToProject(createProjectDto project_dto.CreateProjectDTO) *project.
 	p := &project.Project{
 		Name:        createProjectDto.Name,
 		BuildConfig: createProjectDto.BuildConfig,
+		Resources:   createProjectDto.Resources,
 		Repository:  createProjectDto.Source.Repository,
 		EnvVars:     createProjectDto.EnvVars,
+		Secrets:     createProjectDto.Secrets,
 	}
 
 	if createProjectDto.Image != nil {
@@ -148,6 +208,10 @@ func CreateDtoToProject(createProjectDto project_dto.CreateProjectDTO) *project.
 		p.User = *createProjectDto.User
 	}
 
+	if createProjectDto.Patch != nil {
+		p.Patch = *createProjectDto.Patch
+	}
+
 	return p
 }
 
@@ -157,9 +221,11 @@ func CreateConfigDtoToProject(createProjectConfigDto pc_dto.CreateProjectConfigD
 		Image:       *createProjectConfigDto.Image,
 		User:        *createProjectConfigDto.User,
 		BuildConfig: createProjectConfigDto.BuildConfig,
+		Resources:   createProjectConfigDto.Resources,
 		Repository: &gitprovider.GitRepository{
 			Url: createProjectConfigDto.RepositoryUrl,
 		},
 		EnvVars: createProjectConfigDto.EnvVars,
+		Secrets: createProjectConfigDto.Secrets,
 	}
 }
@@ -0,0 +1,111 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package apiclient
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+)
+
+type EventStreamFilter struct {
+	Types       []apiclient.EventType
+	WorkspaceId *string
+	BuildId     *string
+}
+
+// ReadEventStream subscribes to the server event stream and calls handler for each received event.
+// It blocks until the context is cancelled or the server closes the stream.
+func ReadEventStream(ctx context.Context, profile *config.Profile, filter EventStreamFilter, handler func(apiclient.Event)) error {
+	var activeProfile config.Profile
+	if profile == nil {
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		activeProfile, err = c.GetActiveProfile()
+		if err != nil {
+			return err
+		}
+	} else {
+		activeProfile = *profile
+	}
+
+	eventsUrl, err := url.JoinPath(activeProfile.Api.Url, "events")
+	if err != nil {
+		return err
+	}
+
+	query := url.Values{}
+	if len(filter.Types) > 0 {
+		types := []string{}
+		for _, t := range filter.Types {
+			types = append(types, string(t))
+		}
+		query.Set("types", strings.Join(types, ","))
+	}
+	if filter.WorkspaceId != nil {
+		query.Set("workspaceId", *filter.WorkspaceId)
+	}
+	if filter.BuildId != nil {
+		query.Set("buildId", *filter.BuildId)
+	}
+	if len(query) > 0 {
+		eventsUrl = fmt.Sprintf("%s?%s", eventsUrl, query.Encode())
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, eventsUrl, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", activeProfile.Api.Key))
+	req.Header.Set("Accept", "text/event-stream")
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return HandleErrorResponse(res, fmt.Errorf("failed to subscribe to events: %s", res.Status))
+	}
+
+	var data strings.Builder
+	scanner := bufio.NewScanner(res.Body)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if line == "" {
+			if data.Len() > 0 {
+				var event apiclient.Event
+				err := json.Unmarshal([]byte(data.String()), &event)
+				if err != nil {
+					return err
+				}
+				handler(event)
+				data.Reset()
+			}
+			continue
+		}
+
+		if strings.HasPrefix(line, "data:") {
+			data.WriteString(strings.TrimSpace(strings.TrimPrefix(line, "data:")))
+		}
+	}
+
+	if ctx.Err() != nil {
+		return nil
+	}
+
+	return scanner.Err()
+}
@@ -66,3 +66,12 @@ func FormatUptime(uptime int32) string {
 		return fmt.Sprintf("%d days", days)
 	}
 }
+
+// DurationToMinutes converts a user supplied duration (e.g. 30m, 2h) to whole minutes
+func DurationToMinutes(duration time.Duration) (int32, error) {
+	if duration < 0 || duration%time.Minute != 0 {
+		return 0, fmt.Errorf("%s is not a whole number of minutes", duration)
+	}
+
+	return int32(duration / time.Minute), nil
+}
@@ -0,0 +1,76 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package activity
+
+import (
+	"net"
+	"sync"
+	"time"
+)
+
+// Tracker records when the project was last used through SSH sessions, port-forwards or IDE connections.
+// A project is considered active for as long as at least one connection is open.
+type Tracker struct {
+	mutex        sync.Mutex
+	connections  int
+	lastActivity time.Time
+}
+
+func NewTracker() *Tracker {
+	return &Tracker{
+		lastActivity: time.Now(),
+	}
+}
+
+// Track marks the start of a connection and returns a function that marks its end
+func (t *Tracker) Track() func() {
+	t.mutex.Lock()
+	t.connections++
+	t.lastActivity = time.Now()
+	t.mutex.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			t.mutex.Lock()
+			t.connections--
+			t.lastActivity = time.Now()
+			t.mutex.Unlock()
+		})
+	}
+}
+
+// TrackConn tracks the connection until it is closed
+func (t *Tracker) TrackConn(conn net.Conn) net.Conn {
+	return &trackedConn{
+		Conn: conn,
+		done: t.Track(),
+	}
+}
+
+func (t *Tracker) LastActivity() time.Time {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.connections > 0 {
+		return time.Now()
+	}
+
+	return t.lastActivity
+}
+
+// IdleTime returns the time elapsed since the last connection was closed
+func (t *Tracker) IdleTime() time.Duration {
+	return time.Since(t.LastActivity())
+}
+
+type trackedConn struct {
+	net.Conn
+	done func()
+}
+
+func (c *trackedConn) Close() error {
+	c.done()
+	return c.Conn.Close()
+}
@@ -59,7 +59,17 @@ func (a *Agent) startProjectMode() error {
 		return err
 	}
 
-	// Ignoring error because we don't want to fail if the git provider is not found
+	if len(project.Secrets) > 0 {
+		err = a.injectSecrets()
+		if err != nil {
+			log.Error(fmt.Sprintf("failed to inject secrets: %s", err))
+		}
+	}
+
+	// Ignoring error because we don't want to fail if the git provider is not found.
+	// These credentials are only used for the initial clone. Later git operations get the credentials
+	// through the 'daytona git-cred' helper set by SetGitConfig, which fetches them from the server on
+	// every use so that refreshed or updated tokens are picked up without restarting the agent
 	gitProvider, _ := a.getGitProvider(project.Repository.Url)
 
 	var auth *http.BasicAuth
@@ -88,7 +98,7 @@ func (a *Agent) startProjectMode() error {
 			}
 
 			log.Info("Cloning repository...")
-			err = a.Git.CloneRepository(project.Repository, auth)
+			err = a.Git.CloneRepository(context.Background(), project.Repository, auth)
 			if err != nil {
 				log.Error(fmt.Sprintf("failed to clone repository: %s", err))
 			} else {
@@ -97,6 +107,11 @@ func (a *Agent) startProjectMode() error {
 		}
 	}
 
+	err = a.applyPendingPatch()
+	if err != nil {
+		log.Error(fmt.Sprintf("failed to apply uncommitted changes: %s", err))
+	}
+
 	var gitUser *gitprovider.GitUser
 	if gitProvider != nil {
 		user, err := a.getGitUser(gitProvider.Id)
@@ -117,6 +132,15 @@ func (a *Agent) startProjectMode() error {
 		log.Error(fmt.Sprintf("failed to set git config: %s", err))
 	}
 
+	if a.Toolbox != nil {
+		go func() {
+			err := a.Toolbox.Start()
+			if err != nil {
+				log.Error(fmt.Sprintf("failed to start toolbox server: %s", err))
+			}
+		}()
+	}
+
 	go func() {
 		for {
 			err := a.updateProjectState()
@@ -153,6 +177,61 @@ func (a *Agent) getProject() (*project.Project, error) {
 	return nil, errors.New("project not found")
 }
 
+// injectSecrets sets the project secrets as environment variables of the agent.
+// Sessions started by the SSH server inherit them
+func (a *Agent) injectSecrets() error {
+	apiClient, err := apiclient_util.GetAgentApiClient(a.Config.Server.ApiUrl, a.Config.Server.ApiKey, a.Config.ClientId, a.TelemetryEnabled)
+	if err != nil {
+		return err
+	}
+
+	secrets, res, err := apiClient.WorkspaceAPI.GetProjectSecrets(context.Background(), a.Config.WorkspaceId, a.Config.ProjectName).Execute()
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	for name, value := range *secrets {
+		err = os.Setenv(name, value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// applyPendingPatch applies the uncommitted changes copied from another project, e.g. when the workspace was cloned.
+// The changes are removed from the server once they have been applied
+func (a *Agent) applyPendingPatch() error {
+	ctx := context.Background()
+
+	apiClient, err := apiclient_util.GetAgentApiClient(a.Config.Server.ApiUrl, a.Config.Server.ApiKey, a.Config.ClientId, a.TelemetryEnabled)
+	if err != nil {
+		return err
+	}
+
+	patch, res, err := apiClient.WorkspaceAPI.GetPendingProjectPatch(ctx, a.Config.WorkspaceId, a.Config.ProjectName).Execute()
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	if patch == "" {
+		return nil
+	}
+
+	err = a.Git.ApplyPatch(patch)
+	if err != nil {
+		return err
+	}
+
+	res, err = apiClient.WorkspaceAPI.ClearPendingProjectPatch(ctx, a.Config.WorkspaceId, a.Config.ProjectName).Execute()
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	return nil
+}
+
 func (a *Agent) getGitProvider(repoUrl string) (*apiclient.GitProvider, error) {
 	ctx := context.Background()
 
@@ -239,10 +318,31 @@ func (a *Agent) updateProjectState() error {
 	}
 
 	uptime := a.uptime()
-	res, err := apiClient.WorkspaceAPI.SetProjectState(context.Background(), a.Config.WorkspaceId, a.Config.ProjectName).SetState(apiclient.SetProjectState{
+	state := apiclient.SetProjectState{
 		Uptime:    uptime,
 		GitStatus: conversion.ToGitStatusDTO(gitStatus),
-	}).Execute()
+	}
+
+	if a.Config.Target != "" {
+		state.Target = &a.Config.Target
+	}
+
+	if a.ActivityTracker != nil {
+		idleTime := int32(a.ActivityTracker.IdleTime().Seconds())
+		state.IdleTime = &idleTime
+	}
+
+	if a.MetricsCollector != nil {
+		metrics, err := a.MetricsCollector.Collect()
+		if err != nil {
+			// The rest of the state is still reported
+			log.Error(fmt.Sprintf("failed to collect metrics: %s", err))
+		} else {
+			state.Metrics = conversion.ToProjectMetricsDTO(metrics)
+		}
+	}
+
+	res, err := apiClient.WorkspaceAPI.SetProjectState(context.Background(), a.Config.WorkspaceId, a.Config.ProjectName).SetState(state).Execute()
 	if err != nil {
 		return apiclient_util.HandleErrorResponse(res, err)
 	}
@@ -5,6 +5,9 @@ package agent_test
 
 import (
 	"bytes"
+	"io"
+	"net/http"
+	"os"
 	"testing"
 
 	log "github.com/sirupsen/logrus"
@@ -17,6 +20,7 @@ import (
 	"github.com/daytonaio/daytona/pkg/agent/config"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/lifecycle"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
 )
 
@@ -29,6 +33,8 @@ var project1 = &project.Project{
 	},
 	WorkspaceId: "123",
 	Target:      "local",
+	Secrets:     []string{"TEST_SECRET"},
+	Lifecycle:   lifecycle.New(),
 	State: &project.ProjectState{
 		UpdatedAt: "123",
 		Uptime:    148,
@@ -37,9 +43,10 @@ var project1 = &project.Project{
 }
 
 var workspace1 = &workspace.Workspace{
-	Id:     "123",
-	Name:   "test",
-	Target: "local",
+	Id:        "123",
+	Name:      "test",
+	Target:    "local",
+	Lifecycle: lifecycle.New(),
 	Projects: []*project.Project{
 		project1,
 	},
@@ -77,6 +84,7 @@ func TestAgent(t *testing.T) {
 	mockGitService.On("RepositoryExists").Return(true, nil)
 	mockGitService.On("SetGitConfig", mock.Anything).Return(nil)
 	mockGitService.On("GetGitStatus").Return(gitStatus1, nil)
+	mockGitService.On("ApplyPatch", mocks.PendingPatch).Return(nil).Once()
 
 	mockSshServer := mocks.NewMockSshServer()
 	mockTailscaleServer := mocks.NewMockTailscaleServer()
@@ -95,6 +103,17 @@ func TestAgent(t *testing.T) {
 		err := a.Start()
 
 		require.Nil(t, err)
+		require.Equal(t, "test-secret-value", os.Getenv("TEST_SECRET"))
+	})
+
+	t.Run("Pending patch is cleared after it is applied", func(t *testing.T) {
+		res, err := http.Get(apiServer.URL + "/workspace/123/test/patch/pending")
+		require.Nil(t, err)
+		defer res.Body.Close()
+
+		body, err := io.ReadAll(res.Body)
+		require.Nil(t, err)
+		require.Empty(t, string(body))
 	})
 
 	t.Cleanup(func() {
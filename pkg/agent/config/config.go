@@ -24,6 +24,7 @@ type Config struct {
 	ProjectDir  string
 	ClientId    string  `envconfig:"DAYTONA_CLIENT_ID" validate:"required"`
 	ProjectName string  `envconfig:"DAYTONA_WS_PROJECT_NAME"`
+	Target      string  `envconfig:"DAYTONA_WS_PROJECT_TARGET"`
 	WorkspaceId string  `envconfig:"DAYTONA_WS_ID" validate:"required"`
 	LogFilePath *string `envconfig:"DAYTONA_AGENT_LOG_FILE_PATH"`
 	Server      DaytonaServerConfig
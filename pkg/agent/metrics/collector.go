@@ -0,0 +1,200 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/shirou/gopsutil/cpu"
+	"github.com/shirou/gopsutil/disk"
+	"github.com/shirou/gopsutil/mem"
+	"github.com/shirou/gopsutil/process"
+)
+
+const defaultCgroupDir = "/sys/fs/cgroup"
+
+var errCgroupNotFound = errors.New("cgroup metrics not found")
+
+// Collector samples the resource usage of the project.
+// CPU and memory are read from the container cgroup (v2 or v1) and fall back to host-wide values
+// when the agent does not run in a cgroup, e.g. in host mode.
+type Collector struct {
+	projectDir string
+	cgroupDir  string
+
+	mutex       sync.Mutex
+	lastCpuTime time.Duration
+	lastSample  time.Time
+}
+
+func NewCollector(projectDir string) *Collector {
+	return &Collector{
+		projectDir: projectDir,
+		cgroupDir:  defaultCgroupDir,
+	}
+}
+
+// Collect returns the current resource usage.
+// CPU usage is measured since the previous call, so the first sample reports no CPU usage.
+func (c *Collector) Collect() (*project.ProjectMetrics, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	metrics := &project.ProjectMetrics{
+		CollectedAt: time.Now().Format(time.RFC1123),
+	}
+
+	cpuUsage, err := c.getCpuUsage()
+	if err != nil {
+		return nil, err
+	}
+	metrics.CpuUsage = cpuUsage
+
+	memoryUsage, memoryLimit, err := c.getMemoryUsage()
+	if err != nil {
+		return nil, err
+	}
+	metrics.MemoryUsage = toMB(memoryUsage)
+	metrics.MemoryLimit = toMB(memoryLimit)
+
+	diskUsage, err := disk.Usage(c.projectDir)
+	if err != nil {
+		return nil, err
+	}
+	metrics.DiskUsage = toMB(diskUsage.Used)
+	metrics.DiskTotal = toMB(diskUsage.Total)
+
+	pids, err := process.Pids()
+	if err != nil {
+		return nil, err
+	}
+	metrics.Processes = uint64(len(pids))
+
+	return metrics, nil
+}
+
+func (c *Collector) getCpuUsage() (float64, error) {
+	cpuTime, err := c.readCgroupCpuTime()
+	if errors.Is(err, errCgroupNotFound) {
+		// cpu.Percent measures from the previous call when the interval is 0
+		percent, err := cpu.Percent(0, false)
+		if err != nil || len(percent) == 0 {
+			return 0, err
+		}
+
+		cpuCount, err := cpu.Counts(true)
+		if err != nil {
+			return 0, err
+		}
+
+		return percent[0] * float64(cpuCount), nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	defer func() {
+		c.lastCpuTime = cpuTime
+		c.lastSample = now
+	}()
+
+	if c.lastSample.IsZero() || cpuTime < c.lastCpuTime {
+		return 0, nil
+	}
+
+	return float64(cpuTime-c.lastCpuTime) / float64(now.Sub(c.lastSample)) * 100, nil
+}
+
+// readCgroupCpuTime returns the total CPU time consumed by the cgroup
+func (c *Collector) readCgroupCpuTime() (time.Duration, error) {
+	// cgroup v2
+	content, err := os.ReadFile(filepath.Join(c.cgroupDir, "cpu.stat"))
+	if err == nil {
+		for _, line := range strings.Split(string(content), "\n") {
+			fields := strings.Fields(line)
+			if len(fields) == 2 && fields[0] == "usage_usec" {
+				usec, err := strconv.ParseUint(fields[1], 10, 64)
+				if err != nil {
+					return 0, err
+				}
+				return time.Duration(usec) * time.Microsecond, nil
+			}
+		}
+	}
+
+	// cgroup v1
+	nsec, err := readUintFile(filepath.Join(c.cgroupDir, "cpuacct", "cpuacct.usage"))
+	if err != nil {
+		return 0, errCgroupNotFound
+	}
+
+	return time.Duration(nsec), nil
+}
+
+// getMemoryUsage returns the used and available memory in bytes.
+// The limit is the host memory if the cgroup is not limited.
+func (c *Collector) getMemoryUsage() (uint64, uint64, error) {
+	hostMemory, err := mem.VirtualMemory()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	usage, limit, err := c.readCgroupMemory()
+	if err != nil {
+		return hostMemory.Used, hostMemory.Total, nil
+	}
+
+	if limit == 0 || limit > hostMemory.Total {
+		limit = hostMemory.Total
+	}
+
+	return usage, limit, nil
+}
+
+func (c *Collector) readCgroupMemory() (uint64, uint64, error) {
+	// cgroup v2
+	usage, err := readUintFile(filepath.Join(c.cgroupDir, "memory.current"))
+	if err == nil {
+		limit, err := readUintFile(filepath.Join(c.cgroupDir, "memory.max"))
+		if err != nil {
+			// The limit is "max" if it is not set
+			limit = 0
+		}
+		return usage, limit, nil
+	}
+
+	// cgroup v1, where an unset limit is reported as a very large number
+	usage, err = readUintFile(filepath.Join(c.cgroupDir, "memory", "memory.usage_in_bytes"))
+	if err != nil {
+		return 0, 0, errCgroupNotFound
+	}
+
+	limit, err := readUintFile(filepath.Join(c.cgroupDir, "memory", "memory.limit_in_bytes"))
+	if err != nil {
+		limit = 0
+	}
+
+	return usage, limit, nil
+}
+
+func readUintFile(path string) (uint64, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseUint(strings.TrimSpace(string(content)), 10, 64)
+}
+
+func toMB(bytes uint64) uint64 {
+	return bytes / 1024 / 1024
+}
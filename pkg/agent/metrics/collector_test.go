@@ -0,0 +1,64 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollectorCgroupV2(t *testing.T) {
+	cgroupDir := t.TempDir()
+
+	writeFile(t, filepath.Join(cgroupDir, "cpu.stat"), "usage_usec 1000000\nuser_usec 800000\n")
+	writeFile(t, filepath.Join(cgroupDir, "memory.current"), "104857600\n")
+	writeFile(t, filepath.Join(cgroupDir, "memory.max"), "209715200\n")
+
+	collector := NewCollector(t.TempDir())
+	collector.cgroupDir = cgroupDir
+
+	metrics, err := collector.Collect()
+	require.Nil(t, err)
+	require.Equal(t, float64(0), metrics.CpuUsage)
+	require.Equal(t, uint64(100), metrics.MemoryUsage)
+	require.Equal(t, uint64(200), metrics.MemoryLimit)
+	require.NotZero(t, metrics.DiskTotal)
+
+	// Pretend one second passed in which the cgroup used half a second of CPU time
+	collector.lastSample = collector.lastSample.Add(-time.Second)
+	writeFile(t, filepath.Join(cgroupDir, "cpu.stat"), "usage_usec 1500000\n")
+
+	metrics, err = collector.Collect()
+	require.Nil(t, err)
+	require.InDelta(t, 50, metrics.CpuUsage, 5)
+}
+
+func TestCollectorCgroupV1(t *testing.T) {
+	cgroupDir := t.TempDir()
+
+	writeFile(t, filepath.Join(cgroupDir, "cpuacct", "cpuacct.usage"), "1000000000\n")
+	writeFile(t, filepath.Join(cgroupDir, "memory", "memory.usage_in_bytes"), "52428800\n")
+	// Unlimited
+	writeFile(t, filepath.Join(cgroupDir, "memory", "memory.limit_in_bytes"), "9223372036854771712\n")
+
+	collector := NewCollector(t.TempDir())
+	collector.cgroupDir = cgroupDir
+
+	metrics, err := collector.Collect()
+	require.Nil(t, err)
+	require.Equal(t, uint64(50), metrics.MemoryUsage)
+	require.Less(t, metrics.MemoryLimit, uint64(9223372036854771712/1024/1024))
+}
+
+func writeFile(t *testing.T, path, content string) {
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	require.Nil(t, err)
+
+	err = os.WriteFile(path, []byte(content), 0644)
+	require.Nil(t, err)
+}
@@ -6,6 +6,7 @@ package ssh
 import (
 	"fmt"
 	"io"
+	"net"
 	"os"
 	"os/exec"
 	"strings"
@@ -13,6 +14,7 @@ import (
 	"unsafe"
 
 	"github.com/creack/pty"
+	"github.com/daytonaio/daytona/pkg/agent/activity"
 	"github.com/daytonaio/daytona/pkg/agent/ssh/config"
 	"github.com/gliderlabs/ssh"
 	"github.com/pkg/sftp"
@@ -24,6 +26,7 @@ import (
 type Server struct {
 	ProjectDir        string
 	DefaultProjectDir string
+	ActivityTracker   *activity.Tracker
 }
 
 func (s *Server) Start() error {
@@ -74,6 +77,12 @@ func (s *Server) Start() error {
 		SessionRequestCallback: func(sess ssh.Session, requestType string) bool {
 			return true
 		},
+		ConnCallback: func(ctx ssh.Context, conn net.Conn) net.Conn {
+			if s.ActivityTracker == nil {
+				return conn
+			}
+			return s.ActivityTracker.TrackConn(conn)
+		},
 	}
 
 	log.Printf("Starting ssh server on port %d...\n", config.SSH_PORT)
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/agent/activity"
 	"github.com/daytonaio/daytona/pkg/agent/config"
 	"github.com/daytonaio/daytona/pkg/apiclient"
 	"tailscale.com/tsnet"
@@ -25,6 +26,7 @@ type Server struct {
 	Server           config.DaytonaServerConfig
 	TelemetryEnabled bool
 	ClientId         string
+	ActivityTracker  *activity.Tracker
 }
 
 func (s *Server) Start() error {
@@ -99,6 +101,10 @@ func (s *Server) getTsnetServer() (*tsnet.Server, error) {
 		destPort := dest.Port()
 
 		return func(src net.Conn) {
+			if s.ActivityTracker != nil {
+				defer s.ActivityTracker.Track()()
+			}
+
 			defer src.Close()
 			dst, err := net.Dial("tcp", fmt.Sprintf("localhost:%d", destPort))
 			if err != nil {
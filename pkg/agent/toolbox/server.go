@@ -0,0 +1,52 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package toolbox
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/agent/toolbox/config"
+	"github.com/daytonaio/daytona/pkg/git"
+	log "github.com/sirupsen/logrus"
+)
+
+// Server exposes project data that is too expensive to report with every heartbeat.
+// It only listens on localhost and is reached through the tailscale server of the agent.
+type Server struct {
+	Git git.IGitService
+}
+
+func (s *Server) Start() error {
+	log.Printf("Starting toolbox server on port %d...\n", config.TOOLBOX_PORT)
+
+	return http.ListenAndServe(fmt.Sprintf("localhost:%d", config.TOOLBOX_PORT), s.Handler())
+}
+
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /git/patch", s.getPatch)
+
+	return mux
+}
+
+func (s *Server) getPatch(w http.ResponseWriter, r *http.Request) {
+	patch, err := s.Git.GetPatch()
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, git.ErrPatchTooLarge) {
+			statusCode = http.StatusRequestEntityTooLarge
+		}
+		http.Error(w, err.Error(), statusCode)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(patch)
+	if err != nil {
+		log.Error(err)
+	}
+}
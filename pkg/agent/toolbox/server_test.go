@@ -0,0 +1,65 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package toolbox_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	git_mocks "github.com/daytonaio/daytona/internal/testing/git/mocks"
+	"github.com/daytonaio/daytona/pkg/agent/toolbox"
+	"github.com/daytonaio/daytona/pkg/git"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPatch(t *testing.T) {
+	tests := []struct {
+		name       string
+		patch      *project.Patch
+		err        error
+		statusCode int
+	}{
+		{
+			name:       "returns the patch",
+			patch:      &project.Patch{Sha: "abc123", Branch: "main", Diff: "diff --git a/main.go b/main.go\n"},
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "rejects large patches",
+			err:        git.ErrPatchTooLarge,
+			statusCode: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:       "reports git errors",
+			err:        errors.New("git add failed"),
+			statusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockGitService := git_mocks.NewMockGitService()
+			mockGitService.On("GetPatch").Return(tt.patch, tt.err)
+
+			server := httptest.NewServer((&toolbox.Server{Git: mockGitService}).Handler())
+			defer server.Close()
+
+			res, err := http.Get(server.URL + "/git/patch")
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			require.Equal(t, tt.statusCode, res.StatusCode)
+
+			if tt.err == nil {
+				var patch project.Patch
+				require.NoError(t, json.NewDecoder(res.Body).Decode(&patch))
+				require.Equal(t, tt.patch, &patch)
+			}
+		})
+	}
+}
@@ -7,7 +7,9 @@ import (
 	"io"
 	"time"
 
+	"github.com/daytonaio/daytona/pkg/agent/activity"
 	"github.com/daytonaio/daytona/pkg/agent/config"
+	"github.com/daytonaio/daytona/pkg/agent/metrics"
 	"github.com/daytonaio/daytona/pkg/git"
 )
 
@@ -19,12 +21,19 @@ type TailscaleServer interface {
 	Start() error
 }
 
+type ToolboxServer interface {
+	Start() error
+}
+
 type Agent struct {
 	Config           *config.Config
 	Git              git.IGitService
 	Ssh              SshServer
 	Tailscale        TailscaleServer
+	Toolbox          ToolboxServer
 	LogWriter        io.Writer
+	ActivityTracker  *activity.Tracker
+	MetricsCollector *metrics.Collector
 	TelemetryEnabled bool
 	startTime        time.Time
 }
@@ -4,8 +4,10 @@
 package apikey
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/daytonaio/daytona/pkg/apikey"
 	"github.com/daytonaio/daytona/pkg/server"
@@ -19,6 +21,8 @@ import (
 //	@Description	Generate an API key
 //	@Produce		plain
 //	@Param			apiKeyName	path		string	true	"API key name"
+//	@Param			role		query		string	false	"API key role (admin, developer or read-only). Defaults to admin"
+//	@Param			expiresAt	query		string	false	"RFC 3339 timestamp after which the key is rejected. The key never expires if omitted"
 //	@Success		200			{string}	apiKey
 //	@Router			/apikey/{apiKeyName} [post]
 //
@@ -26,13 +30,42 @@ import (
 func GenerateApiKey(ctx *gin.Context) {
 	apiKeyName := ctx.Param("apiKeyName")
 
+	role := apikey.GetDefaultRole(apikey.ApiKeyTypeClient)
+	if roleQuery := ctx.Query("role"); roleQuery != "" {
+		role = apikey.ApiKeyRole(roleQuery)
+	}
+
+	expiresAt, err := parseExpiresAt(ctx)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
 	server := server.GetInstance(nil)
 
-	response, err := server.ApiKeyService.Generate(apikey.ApiKeyTypeClient, apiKeyName)
+	response, err := server.ApiKeyService.GenerateWithRole(apikey.ApiKeyTypeClient, apiKeyName, role, expiresAt)
 	if err != nil {
+		if errors.Is(err, apikey.ErrInvalidRole) {
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("%w: %s", err, role))
+			return
+		}
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get API keys: %w", err))
 		return
 	}
 
 	ctx.String(200, response)
 }
+
+func parseExpiresAt(ctx *gin.Context) (*time.Time, error) {
+	expiresAtQuery := ctx.Query("expiresAt")
+	if expiresAtQuery == "" {
+		return nil, nil
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, expiresAtQuery)
+	if err != nil {
+		return nil, errors.New("invalid value for expiresAt, expected an RFC 3339 timestamp")
+	}
+
+	return &expiresAt, nil
+}
@@ -0,0 +1,64 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package apikey
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/gin-gonic/gin"
+)
+
+const defaultGracePeriod = 24 * time.Hour
+
+// RotateApiKey 			godoc
+//
+//	@Tags			apiKey
+//	@Summary		Rotate an API key
+//	@Description	Generate a replacement for an API key. The replaced key is renamed and stays valid for the grace period
+//	@Produce		plain
+//	@Param			apiKeyName	path		string	true	"API key name"
+//	@Param			gracePeriod	query		string	false	"Duration for which the replaced key stays valid, e.g. 1h. Defaults to 24h, 0 revokes the key immediately"
+//	@Param			expiresAt	query		string	false	"RFC 3339 timestamp after which the new key is rejected. The key never expires if omitted"
+//	@Success		200			{string}	apiKey
+//	@Router			/apikey/{apiKeyName}/rotate [post]
+//
+//	@id				RotateApiKey
+func RotateApiKey(ctx *gin.Context) {
+	apiKeyName := ctx.Param("apiKeyName")
+
+	gracePeriod := defaultGracePeriod
+	if gracePeriodQuery := ctx.Query("gracePeriod"); gracePeriodQuery != "" {
+		var err error
+		gracePeriod, err = time.ParseDuration(gracePeriodQuery)
+		if err != nil || gracePeriod < 0 {
+			ctx.AbortWithError(http.StatusBadRequest, errors.New("invalid value for gracePeriod, expected a duration such as 1h"))
+			return
+		}
+	}
+
+	expiresAt, err := parseExpiresAt(ctx)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	response, err := server.ApiKeyService.Rotate(apiKeyName, gracePeriod, expiresAt)
+	if err != nil {
+		if apikey.IsApiKeyNotFound(err) {
+			ctx.AbortWithError(http.StatusNotFound, err)
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to rotate API key: %w", err))
+		return
+	}
+
+	ctx.String(200, response)
+}
@@ -0,0 +1,59 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/audit"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/gin-gonic/gin"
+)
+
+// ListAuditRecords godoc
+//
+//	@Tags			audit
+//	@Summary		List audit records
+//	@Description	List the audit records of mutating API requests, newest first
+//	@Produce		json
+//	@Param			since		query	string	false	"Only return records created at or after this RFC 3339 timestamp"
+//	@Param			key			query	string	false	"API key name"
+//	@Param			resource	query	string	false	"Only return records whose resource path starts with this value"
+//	@Success		200			{array}	AuditRecord
+//	@Router			/audit [get]
+//
+//	@id				ListAuditRecords
+func ListAuditRecords(ctx *gin.Context) {
+	filter := &audit.Filter{}
+
+	if since := ctx.Query("since"); since != "" {
+		sinceTime, err := time.Parse(time.RFC3339, since)
+		if err != nil {
+			ctx.AbortWithError(http.StatusBadRequest, errors.New("invalid value for since, expected an RFC 3339 timestamp"))
+			return
+		}
+		filter.Since = &sinceTime
+	}
+
+	if key := ctx.Query("key"); key != "" {
+		filter.KeyName = &key
+	}
+
+	if resource := ctx.Query("resource"); resource != "" {
+		filter.Resource = &resource
+	}
+
+	server := server.GetInstance(nil)
+
+	records, err := server.AuditLogService.List(filter)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list audit records: %w", err))
+		return
+	}
+
+	ctx.JSON(200, records)
+}
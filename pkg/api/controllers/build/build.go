@@ -4,6 +4,7 @@
 package build
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/daytonaio/daytona/pkg/build"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/builds"
 	builds_dto "github.com/daytonaio/daytona/pkg/server/builds/dto"
 	"github.com/daytonaio/daytona/pkg/workspace/project/config"
 	"github.com/gin-gonic/gin"
@@ -66,10 +68,18 @@ func CreateBuild(ctx *gin.Context) {
 		BuildConfig: projectConfig.BuildConfig,
 		Repository:  repo,
 		EnvVars:     createBuildDto.EnvVars,
+		Priority:    build.BuildPriorityHigh,
 	}
 
 	if createBuildDto.PrebuildId != nil {
 		newBuildDto.PrebuildId = *createBuildDto.PrebuildId
+
+		prebuild, err := projectConfig.FindPrebuild(&config.PrebuildFilter{
+			Id: createBuildDto.PrebuildId,
+		})
+		if err == nil {
+			newBuildDto.MaxRetries = prebuild.MaxRetries
+		}
 	}
 
 	buildId, err := s.BuildService.Create(newBuildDto)
@@ -187,6 +197,66 @@ func DeleteBuild(ctx *gin.Context) {
 	ctx.Status(204)
 }
 
+// CancelBuild godoc
+//
+//	@Tags			build
+//	@Summary		Cancel build
+//	@Description	Cancel a pending or running build
+//	@Param			buildId	path	string	true	"Build ID"
+//	@Success		204
+//	@Router			/build/{buildId}/cancel [post]
+//
+//	@id				CancelBuild
+func CancelBuild(ctx *gin.Context) {
+	buildId := ctx.Param("buildId")
+
+	server := server.GetInstance(nil)
+
+	err := server.BuildService.Cancel(buildId)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if build.IsBuildNotFound(err) {
+			statusCode = http.StatusNotFound
+		} else if errors.Is(err, builds.ErrBuildNotCancellable) {
+			statusCode = http.StatusConflict
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to cancel build: %w", err))
+		return
+	}
+
+	ctx.Status(204)
+}
+
+// RetryBuild godoc
+//
+//	@Tags			build
+//	@Summary		Retry build
+//	@Description	Re-queue a failed or cancelled build
+//	@Param			buildId	path	string	true	"Build ID"
+//	@Success		204
+//	@Router			/build/{buildId}/retry [post]
+//
+//	@id				RetryBuild
+func RetryBuild(ctx *gin.Context) {
+	buildId := ctx.Param("buildId")
+
+	server := server.GetInstance(nil)
+
+	err := server.BuildService.Retry(buildId)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if build.IsBuildNotFound(err) {
+			statusCode = http.StatusNotFound
+		} else if errors.Is(err, builds.ErrBuildNotRetryable) {
+			statusCode = http.StatusConflict
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to retry build: %w", err))
+		return
+	}
+
+	ctx.Status(204)
+}
+
 // DeleteBuildsFromPrebuild godoc
 //
 //	@Tags			build
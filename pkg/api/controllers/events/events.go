@@ -0,0 +1,78 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/events"
+	"github.com/gin-gonic/gin"
+)
+
+const keepAliveInterval = 15 * time.Second
+
+// StreamEvents godoc
+//
+//	@Tags			events
+//	@Summary		Stream server events
+//	@Description	Stream workspace, project and build events as server-sent events
+//	@Produce		text/event-stream
+//	@Param			types		query		string	false	"Comma-separated list of event types"
+//	@Param			workspaceId	query		string	false	"Workspace ID"
+//	@Param			buildId		query		string	false	"Build ID"
+//	@Success		200			{object}	Event
+//	@Router			/events [get]
+//
+//	@id				StreamEvents
+func StreamEvents(ctx *gin.Context) {
+	server := server.GetInstance(nil)
+
+	filter := &events.EventFilter{}
+
+	if types := ctx.Query("types"); types != "" {
+		for _, t := range strings.Split(types, ",") {
+			filter.Types = append(filter.Types, events.EventType(strings.TrimSpace(t)))
+		}
+	}
+
+	if workspaceId := ctx.Query("workspaceId"); workspaceId != "" {
+		filter.WorkspaceId = &workspaceId
+	}
+
+	if buildId := ctx.Query("buildId"); buildId != "" {
+		filter.BuildId = &buildId
+	}
+
+	eventCh, unsubscribe := server.EventBus.Subscribe(filter)
+	defer unsubscribe()
+
+	ctx.Header("Content-Type", "text/event-stream")
+	ctx.Header("Cache-Control", "no-cache")
+	ctx.Header("Connection", "keep-alive")
+	ctx.Status(http.StatusOK)
+	ctx.Writer.Flush()
+
+	keepAlive := time.NewTicker(keepAliveInterval)
+	defer keepAlive.Stop()
+
+	ctx.Stream(func(w io.Writer) bool {
+		select {
+		case <-ctx.Request.Context().Done():
+			return false
+		case event, ok := <-eventCh:
+			if !ok {
+				return false
+			}
+			ctx.SSEvent(string(event.Type), event)
+			return true
+		case <-keepAlive.C:
+			_, err := w.Write([]byte(": keepalive\n\n"))
+			return err == nil
+		}
+	})
+}
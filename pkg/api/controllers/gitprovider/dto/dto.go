@@ -3,6 +3,8 @@
 
 package dto
 
+import "github.com/daytonaio/daytona/pkg/gitprovider"
+
 type RepositoryUrl struct {
 	URL string `json:"url" validate:"required"`
 } // @name RepositoryUrl
@@ -13,3 +15,12 @@ type SetGitProviderConfig struct {
 	Token      string  `json:"token" validate:"required"`
 	BaseApiUrl *string `json:"baseApiUrl,omitempty" validate:"optional"`
 } // @name SetGitProviderConfig
+
+type StartOAuthDeviceFlow struct {
+	BaseApiUrl *string `json:"baseApiUrl,omitempty" validate:"optional"`
+} // @name StartOAuthDeviceFlow
+
+type CompleteOAuthDeviceFlow struct {
+	BaseApiUrl *string                     `json:"baseApiUrl,omitempty" validate:"optional"`
+	DeviceCode gitprovider.OAuthDeviceCode `json:"deviceCode" validate:"required"`
+} // @name CompleteOAuthDeviceFlow
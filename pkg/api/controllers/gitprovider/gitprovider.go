@@ -38,6 +38,7 @@ func ListGitProviders(ctx *gin.Context) {
 
 	for _, provider := range response {
 		provider.Token = ""
+		provider.RefreshToken = ""
 	}
 
 	ctx.JSON(200, response)
@@ -71,6 +72,8 @@ func GetGitProviderForUrl(ctx *gin.Context) {
 		return
 	}
 
+	gitProvider.RefreshToken = ""
+
 	ctx.JSON(200, gitProvider)
 }
 
@@ -0,0 +1,79 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package gitprovider
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/api/controllers/gitprovider/dto"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/gin-gonic/gin"
+)
+
+// StartOAuthDeviceFlow 			godoc
+//
+//	@Tags			gitProvider
+//	@Summary		Start OAuth device flow
+//	@Description	Request a device code the user authorizes with the Git provider
+//	@Param			gitProviderId	path	string					true	"Git provider"
+//	@Param			request			body	StartOAuthDeviceFlow	true	"Device flow request"
+//	@Produce		json
+//	@Success		200	{object}	OAuthDeviceCode
+//	@Router			/gitprovider/{gitProviderId}/oauth/device [post]
+//
+//	@id				StartOAuthDeviceFlow
+func StartOAuthDeviceFlow(ctx *gin.Context) {
+	gitProviderId := ctx.Param("gitProviderId")
+
+	var req dto.StartOAuthDeviceFlow
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	deviceCode, err := server.GitProviderService.StartOAuthDeviceFlow(gitProviderId, req.BaseApiUrl)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to start OAuth device flow: %w", err))
+		return
+	}
+
+	ctx.JSON(200, deviceCode)
+}
+
+// CompleteOAuthDeviceFlow 			godoc
+//
+//	@Tags			gitProvider
+//	@Summary		Complete OAuth device flow
+//	@Description	Wait until the user authorizes the device code and register the Git provider with the obtained token
+//	@Param			gitProviderId	path	string					true	"Git provider"
+//	@Param			request			body	CompleteOAuthDeviceFlow	true	"Device code"
+//	@Produce		json
+//	@Success		200
+//	@Router			/gitprovider/{gitProviderId}/oauth/token [post]
+//
+//	@id				CompleteOAuthDeviceFlow
+func CompleteOAuthDeviceFlow(ctx *gin.Context) {
+	gitProviderId := ctx.Param("gitProviderId")
+
+	var req dto.CompleteOAuthDeviceFlow
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	err = server.GitProviderService.CompleteOAuthDeviceFlow(ctx.Request.Context(), gitProviderId, req.BaseApiUrl, &req.DeviceCode)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to complete OAuth device flow: %w", err))
+		return
+	}
+
+	ctx.JSON(200, nil)
+}
@@ -0,0 +1,42 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package gitprovider
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/gin-gonic/gin"
+)
+
+// GetTokenHealth 			godoc
+//
+//	@Tags			gitProvider
+//	@Summary		Get Git provider token health
+//	@Description	Check the token of a Git provider against the provider
+//	@Produce		json
+//	@Param			gitProviderId	path		string	true	"Git provider"
+//	@Success		200				{object}	TokenHealth
+//	@Router			/gitprovider/{gitProviderId}/token-health [get]
+//
+//	@id				GetTokenHealth
+func GetTokenHealth(ctx *gin.Context) {
+	gitProviderId := ctx.Param("gitProviderId")
+
+	server := server.GetInstance(nil)
+
+	health, err := server.GitProviderService.GetTokenHealth(gitProviderId)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if gitprovider.IsGitProviderNotFound(err) {
+			statusCode = http.StatusNotFound
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to get token health: %w", err))
+		return
+	}
+
+	ctx.JSON(200, health)
+}
@@ -0,0 +1,34 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secret
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/gin-gonic/gin"
+)
+
+// ListSecrets godoc
+//
+//	@Tags			secret
+//	@Summary		List secrets
+//	@Description	List the names of the secrets. Secret values are never returned
+//	@Produce		json
+//	@Success		200	{array}	Secret
+//	@Router			/secret [get]
+//
+//	@id				ListSecrets
+func ListSecrets(ctx *gin.Context) {
+	server := server.GetInstance(nil)
+
+	secrets, err := server.SecretService.List()
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list secrets: %w", err))
+		return
+	}
+
+	ctx.JSON(200, secrets)
+}
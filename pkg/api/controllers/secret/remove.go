@@ -0,0 +1,41 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secret
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/secret"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/gin-gonic/gin"
+)
+
+// DeleteSecret godoc
+//
+//	@Tags			secret
+//	@Summary		Delete a secret
+//	@Description	Delete a secret
+//	@Param			secretName	path	string	true	"Secret name"
+//	@Success		204
+//	@Router			/secret/{secretName} [delete]
+//
+//	@id				DeleteSecret
+func DeleteSecret(ctx *gin.Context) {
+	secretName := ctx.Param("secretName")
+
+	server := server.GetInstance(nil)
+
+	err := server.SecretService.Delete(secretName)
+	if err != nil {
+		if secret.IsSecretNotFound(err) {
+			ctx.AbortWithError(http.StatusNotFound, err)
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to delete secret: %w", err))
+		return
+	}
+
+	ctx.Status(204)
+}
@@ -0,0 +1,52 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secret
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/api/controllers/secret/dto"
+	"github.com/daytonaio/daytona/pkg/secret"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/gin-gonic/gin"
+)
+
+// SetSecret godoc
+//
+//	@Tags			secret
+//	@Summary		Set a secret
+//	@Description	Create a secret or replace its value
+//	@Accept			json
+//	@Param			secretName	path	string			true	"Secret name"
+//	@Param			secret		body	SetSecretDTO	true	"Secret value"
+//	@Success		201
+//	@Router			/secret/{secretName} [put]
+//
+//	@id				SetSecret
+func SetSecret(ctx *gin.Context) {
+	secretName := ctx.Param("secretName")
+
+	var req dto.SetSecretDTO
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	err = server.SecretService.Set(secretName, req.Value)
+	if err != nil {
+		if errors.Is(err, secret.ErrInvalidSecretName) || errors.Is(err, secret.ErrSecretTooShort) {
+			ctx.AbortWithError(http.StatusBadRequest, err)
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to set secret: %w", err))
+		return
+	}
+
+	ctx.Status(201)
+}
@@ -36,6 +36,7 @@ func SetTarget(ctx *gin.Context) {
 	if err == nil {
 		target.Options = req.Options
 		target.ProviderInfo = req.ProviderInfo
+		target.AutoStop = req.AutoStop
 	} else {
 		target = &req
 	}
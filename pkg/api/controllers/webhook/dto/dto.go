@@ -0,0 +1,13 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import "github.com/daytonaio/daytona/pkg/server/events"
+
+type CreateWebhookDTO struct {
+	Url    string             `json:"url" validate:"required"`
+	Events []events.EventType `json:"events" validate:"required"`
+	// Generated by the server if omitted
+	Secret *string `json:"secret" validate:"optional"`
+} // @name CreateWebhookDTO
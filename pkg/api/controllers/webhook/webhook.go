@@ -0,0 +1,164 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/api/controllers/webhook/dto"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/webhooks"
+	"github.com/daytonaio/daytona/pkg/webhook"
+	"github.com/gin-gonic/gin"
+)
+
+// ListWebhooks godoc
+//
+//	@Tags			webhook
+//	@Summary		List webhooks
+//	@Description	List webhooks
+//	@Produce		json
+//	@Success		200	{array}	Webhook
+//	@Router			/webhook [get]
+//
+//	@id				ListWebhooks
+func ListWebhooks(ctx *gin.Context) {
+	server := server.GetInstance(nil)
+
+	response, err := server.WebhookService.List()
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list webhooks: %w", err))
+		return
+	}
+
+	ctx.JSON(200, response)
+}
+
+// CreateWebhook godoc
+//
+//	@Tags			webhook
+//	@Summary		Create a webhook
+//	@Description	Create a webhook. The signing secret is only returned in this response
+//	@Accept			json
+//	@Produce		json
+//	@Param			webhook	body		CreateWebhookDTO	true	"Webhook"
+//	@Success		201		{object}	Webhook
+//	@Router			/webhook [post]
+//
+//	@id				CreateWebhook
+func CreateWebhook(ctx *gin.Context) {
+	var req dto.CreateWebhookDTO
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	secret := ""
+	if req.Secret != nil {
+		secret = *req.Secret
+	}
+
+	w, err := server.WebhookService.Create(req.Url, req.Events, secret)
+	if err != nil {
+		if errors.Is(err, webhooks.ErrInvalidWebhookUrl) {
+			ctx.AbortWithError(http.StatusBadRequest, err)
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to create webhook: %w", err))
+		return
+	}
+
+	ctx.JSON(201, w)
+}
+
+// DeleteWebhook godoc
+//
+//	@Tags			webhook
+//	@Summary		Delete a webhook
+//	@Description	Delete a webhook and its delivery log
+//	@Param			webhookId	path	string	true	"Webhook ID"
+//	@Success		204
+//	@Router			/webhook/{webhookId} [delete]
+//
+//	@id				DeleteWebhook
+func DeleteWebhook(ctx *gin.Context) {
+	webhookId := ctx.Param("webhookId")
+
+	server := server.GetInstance(nil)
+
+	err := server.WebhookService.Delete(webhookId)
+	if err != nil {
+		if webhook.IsWebhookNotFound(err) {
+			ctx.AbortWithError(http.StatusNotFound, err)
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to delete webhook: %w", err))
+		return
+	}
+
+	ctx.Status(204)
+}
+
+// TestWebhook godoc
+//
+//	@Tags			webhook
+//	@Summary		Test a webhook
+//	@Description	Send a test event to a webhook
+//	@Produce		json
+//	@Param			webhookId	path		string	true	"Webhook ID"
+//	@Success		200			{object}	WebhookDelivery
+//	@Router			/webhook/{webhookId}/test [post]
+//
+//	@id				TestWebhook
+func TestWebhook(ctx *gin.Context) {
+	webhookId := ctx.Param("webhookId")
+
+	server := server.GetInstance(nil)
+
+	delivery, err := server.WebhookService.Test(webhookId)
+	if err != nil {
+		if webhook.IsWebhookNotFound(err) {
+			ctx.AbortWithError(http.StatusNotFound, err)
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to test webhook: %w", err))
+		return
+	}
+
+	ctx.JSON(200, delivery)
+}
+
+// ListWebhookDeliveries godoc
+//
+//	@Tags			webhook
+//	@Summary		List webhook deliveries
+//	@Description	List the most recent deliveries of a webhook
+//	@Produce		json
+//	@Param			webhookId	path	string	true	"Webhook ID"
+//	@Success		200			{array}	WebhookDelivery
+//	@Router			/webhook/{webhookId}/deliveries [get]
+//
+//	@id				ListWebhookDeliveries
+func ListWebhookDeliveries(ctx *gin.Context) {
+	webhookId := ctx.Param("webhookId")
+
+	server := server.GetInstance(nil)
+
+	deliveries, err := server.WebhookService.ListDeliveries(webhookId)
+	if err != nil {
+		if webhook.IsWebhookNotFound(err) {
+			ctx.AbortWithError(http.StatusNotFound, err)
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list webhook deliveries: %w", err))
+		return
+	}
+
+	ctx.JSON(200, deliveries)
+}
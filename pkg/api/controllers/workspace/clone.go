@@ -0,0 +1,54 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// CloneWorkspace 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Clone a workspace
+//	@Description	Create a new workspace with the same target and projects as an existing workspace
+//	@Param			workspaceId	path	string				true	"Workspace ID or Name"
+//	@Param			workspace	body	CloneWorkspaceDTO	true	"Clone workspace"
+//	@Produce		json
+//	@Success		200	{object}	Workspace
+//	@Router			/workspace/{workspaceId}/clone [post]
+//
+//	@id				CloneWorkspace
+func CloneWorkspace(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+
+	var req dto.CloneWorkspaceDTO
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	w, err := server.WorkspaceService.CloneWorkspace(ctx.Request.Context(), workspaceId, req)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if workspaces.IsWorkspaceNotFound(err) {
+			statusCode = http.StatusNotFound
+		} else if workspaces.IsWorkspaceAlreadyExists(err) || workspaces.IsProjectNotStarted(err) || errors.Is(err, workspaces.ErrProjectChangesNotPushed) {
+			statusCode = http.StatusConflict
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to clone workspace %s: %w", workspaceId, err))
+		return
+	}
+
+	ctx.JSON(200, w)
+}
@@ -10,4 +10,29 @@ import (
 type SetProjectState struct {
 	Uptime    uint64             `json:"uptime" validate:"required"`
 	GitStatus *project.GitStatus `json:"gitStatus,omitempty" validate:"optional"`
+	// Seconds since the last SSH session, port-forward or IDE connection was closed
+	IdleTime *uint64                 `json:"idleTime,omitempty" validate:"optional"`
+	Metrics  *project.ProjectMetrics `json:"metrics,omitempty" validate:"optional"`
+	// Target the reporting agent runs on
+	Target string `json:"target,omitempty" validate:"optional"`
 } // @name SetProjectState
+
+type ExtendWorkspace struct {
+	// Minutes by which the workspace expiry is postponed
+	Duration uint32 `json:"duration" validate:"required"`
+} // @name ExtendWorkspace
+
+type MigrateWorkspace struct {
+	// Name of the target the workspace is moved to
+	Target string `json:"target" validate:"required"`
+} // @name MigrateWorkspace
+
+type CreateSnapshot struct {
+	// Defaults to the snapshot ID
+	Name string `json:"name,omitempty" validate:"optional"`
+} // @name CreateSnapshot
+
+type RestoreSnapshot struct {
+	// ID or name of the snapshot
+	Snapshot string `json:"snapshot" validate:"required"`
+} // @name RestoreSnapshot
@@ -0,0 +1,59 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/api/controllers/workspace/dto"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/gin-gonic/gin"
+)
+
+// ExtendWorkspace 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Extend workspace expiry
+//	@Description	Postpone the expiry of the workspace
+//	@Param			workspaceId	path		string			true	"Workspace ID or Name"
+//	@Param			extend		body		ExtendWorkspace	true	"Extend workspace"
+//	@Success		200			{object}	Workspace
+//	@Router			/workspace/{workspaceId}/extend [post]
+//
+//	@id				ExtendWorkspace
+func ExtendWorkspace(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+
+	var req dto.ExtendWorkspace
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	if req.Duration == 0 {
+		ctx.AbortWithError(http.StatusBadRequest, errors.New("duration must be greater than zero"))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	w, err := server.WorkspaceService.ExtendWorkspace(workspaceId, time.Duration(req.Duration)*time.Minute)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if workspaces.IsWorkspaceNotFound(err) {
+			statusCode = http.StatusNotFound
+		} else if workspaces.IsWorkspaceWithoutExpiry(err) {
+			statusCode = http.StatusBadRequest
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to extend workspace %s: %w", workspaceId, err))
+		return
+	}
+
+	ctx.JSON(200, w)
+}
@@ -0,0 +1,55 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/api/controllers/workspace/dto"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/gin-gonic/gin"
+)
+
+// MigrateWorkspace 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Migrate workspace
+//	@Description	Recreate the workspace on another target and remove it from the current one
+//	@Param			workspaceId	path		string				true	"Workspace ID or Name"
+//	@Param			migrate		body		MigrateWorkspace	true	"Migrate workspace"
+//	@Success		200			{object}	Workspace
+//	@Router			/workspace/{workspaceId}/migrate [post]
+//
+//	@id				MigrateWorkspace
+func MigrateWorkspace(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+
+	var req dto.MigrateWorkspace
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	w, err := server.WorkspaceService.MigrateWorkspace(ctx.Request.Context(), workspaceId, req.Target)
+	if err != nil {
+		statusCode := getLifecycleErrorStatusCode(err)
+		if workspaces.IsWorkspaceNotFound(err) || provider.IsTargetNotFound(err) {
+			statusCode = http.StatusNotFound
+		} else if workspaces.IsWorkspaceAlreadyOnTarget(err) {
+			statusCode = http.StatusBadRequest
+		} else if workspaces.IsProjectNotStarted(err) {
+			statusCode = http.StatusConflict
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to migrate workspace %s: %w", workspaceId, err))
+		return
+	}
+
+	ctx.JSON(200, w)
+}
@@ -4,16 +4,90 @@
 package workspace
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/daytonaio/daytona/pkg/api/controllers/workspace/dto"
+	"github.com/daytonaio/daytona/pkg/secret"
 	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	server_dto "github.com/daytonaio/daytona/pkg/server/workspaces/dto"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
 	"github.com/gin-gonic/gin"
 )
 
+// AddProject 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Add a project to a workspace
+//	@Description	Provision a new project in an existing workspace
+//	@Param			workspaceId	path		string				true	"Workspace ID or Name"
+//	@Param			project		body		CreateProjectDTO	true	"Create project"
+//	@Success		201			{object}	Project
+//	@Router			/workspace/{workspaceId}/project [post]
+//
+//	@id				AddProject
+func AddProject(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+
+	var req server_dto.CreateProjectDTO
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	p, err := server.WorkspaceService.AddProject(ctx.Request.Context(), workspaceId, req)
+	if err != nil {
+		statusCode := getLifecycleErrorStatusCode(err)
+		if workspaces.IsWorkspaceNotFound(err) {
+			statusCode = http.StatusNotFound
+		} else if workspaces.IsProjectAlreadyExists(err) || workspaces.IsWorkspaceNotStarted(err) {
+			statusCode = http.StatusConflict
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to add project to workspace %s: %w", workspaceId, err))
+		return
+	}
+
+	ctx.JSON(201, p)
+}
+
+// RemoveProject 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Remove a project from a workspace
+//	@Description	Destroy the project and remove it from the workspace
+//	@Param			workspaceId	path	string	true	"Workspace ID or Name"
+//	@Param			projectId	path	string	true	"Project ID"
+//	@Success		200
+//	@Router			/workspace/{workspaceId}/{projectId} [delete]
+//
+//	@id				RemoveProject
+func RemoveProject(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	server := server.GetInstance(nil)
+
+	err := server.WorkspaceService.RemoveProject(ctx.Request.Context(), workspaceId, projectId)
+	if err != nil {
+		statusCode := getLifecycleErrorStatusCode(err)
+		if workspaces.IsWorkspaceNotFound(err) || workspaces.IsProjectNotFound(err) {
+			statusCode = http.StatusNotFound
+		} else if workspaces.IsLastProject(err) {
+			statusCode = http.StatusBadRequest
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to remove project %s: %w", projectId, err))
+		return
+	}
+
+	ctx.Status(200)
+}
+
 // SetProjectState 			godoc
 //
 //	@Tags			workspace
@@ -39,11 +113,21 @@ func SetProjectState(ctx *gin.Context) {
 
 	server := server.GetInstance(nil)
 
-	_, err = server.WorkspaceService.SetProjectState(workspaceId, projectId, &project.ProjectState{
+	now := time.Now()
+	state := &project.ProjectState{
 		Uptime:    setProjectStateDTO.Uptime,
-		UpdatedAt: time.Now().Format(time.RFC1123),
+		UpdatedAt: now.Format(time.RFC1123),
 		GitStatus: setProjectStateDTO.GitStatus,
-	})
+		Metrics:   setProjectStateDTO.Metrics,
+	}
+
+	// Agents that do not report activity are never considered idle
+	if setProjectStateDTO.IdleTime != nil {
+		idleTime := time.Duration(*setProjectStateDTO.IdleTime) * time.Second
+		state.LastActivity = now.Add(-idleTime).Format(time.RFC1123)
+	}
+
+	_, err = server.WorkspaceService.SetProjectState(workspaceId, projectId, setProjectStateDTO.Target, state)
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to stop workspace %s: %w", workspaceId, err))
 		return
@@ -51,3 +135,157 @@ func SetProjectState(ctx *gin.Context) {
 
 	ctx.Status(200)
 }
+
+// GetProjectMetrics 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Get project metrics
+//	@Description	Get the recent resource usage samples of the project, oldest first
+//	@Param			workspaceId	path	string	true	"Workspace ID or Name"
+//	@Param			projectId	path	string	true	"Project ID"
+//	@Success		200			{array}	ProjectMetrics
+//	@Router			/workspace/{workspaceId}/{projectId}/metrics [get]
+//
+//	@id				GetProjectMetrics
+func GetProjectMetrics(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	server := server.GetInstance(nil)
+
+	metrics, err := server.WorkspaceService.GetProjectMetrics(workspaceId, projectId)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if workspaces.IsWorkspaceNotFound(err) || workspaces.IsProjectNotFound(err) {
+			statusCode = http.StatusNotFound
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to get metrics of project %s: %w", projectId, err))
+		return
+	}
+
+	ctx.JSON(200, metrics)
+}
+
+// GetProjectPatch 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Get project patch
+//	@Description	Get the uncommitted changes of a started project, including untracked files, as a binary patch against HEAD
+//	@Param			workspaceId	path	string	true	"Workspace ID or Name"
+//	@Param			projectId	path	string	true	"Project ID"
+//	@Produce		plain
+//	@Success		200	{string}	patch
+//	@Router			/workspace/{workspaceId}/{projectId}/patch [get]
+//
+//	@id				GetProjectPatch
+func GetProjectPatch(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	server := server.GetInstance(nil)
+
+	patch, err := server.WorkspaceService.GetProjectPatch(ctx.Request.Context(), workspaceId, projectId)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if workspaces.IsWorkspaceNotFound(err) || workspaces.IsProjectNotFound(err) {
+			statusCode = http.StatusNotFound
+		} else if workspaces.IsProjectNotStarted(err) {
+			statusCode = http.StatusConflict
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to get patch of project %s: %w", projectId, err))
+		return
+	}
+
+	ctx.String(200, patch)
+}
+
+// GetPendingProjectPatch 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Get pending project patch
+//	@Description	Get the uncommitted changes that the project agent applies after cloning the repository. Only the project's own API key can read them
+//	@Param			workspaceId	path	string	true	"Workspace ID or Name"
+//	@Param			projectId	path	string	true	"Project ID"
+//	@Produce		plain
+//	@Success		200	{string}	patch
+//	@Router			/workspace/{workspaceId}/{projectId}/patch/pending [get]
+//
+//	@id				GetPendingProjectPatch
+func GetPendingProjectPatch(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	server := server.GetInstance(nil)
+
+	patch, err := server.WorkspaceService.GetPendingProjectPatch(workspaceId, projectId)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if workspaces.IsWorkspaceNotFound(err) || workspaces.IsProjectNotFound(err) {
+			statusCode = http.StatusNotFound
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to get pending patch of project %s: %w", projectId, err))
+		return
+	}
+
+	ctx.String(200, patch)
+}
+
+// ClearPendingProjectPatch 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Clear pending project patch
+//	@Description	Remove the uncommitted changes of the project after they have been applied. Only the project's own API key can remove them
+//	@Param			workspaceId	path	string	true	"Workspace ID or Name"
+//	@Param			projectId	path	string	true	"Project ID"
+//	@Success		200
+//	@Router			/workspace/{workspaceId}/{projectId}/patch/pending [delete]
+//
+//	@id				ClearPendingProjectPatch
+func ClearPendingProjectPatch(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	server := server.GetInstance(nil)
+
+	err := server.WorkspaceService.ClearPendingProjectPatch(workspaceId, projectId)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if workspaces.IsWorkspaceNotFound(err) || workspaces.IsProjectNotFound(err) {
+			statusCode = http.StatusNotFound
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to clear pending patch of project %s: %w", projectId, err))
+		return
+	}
+
+	ctx.Status(200)
+}
+
+// GetProjectSecrets 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Get project secrets
+//	@Description	Get the values of the secrets referenced by the project. Only the project's own API key can read them
+//	@Param			workspaceId	path		string	true	"Workspace ID or Name"
+//	@Param			projectId	path		string	true	"Project ID"
+//	@Success		200			{object}	map[string]string
+//	@Router			/workspace/{workspaceId}/{projectId}/secrets [get]
+//
+//	@id				GetProjectSecrets
+func GetProjectSecrets(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	server := server.GetInstance(nil)
+
+	secrets, err := server.WorkspaceService.GetProjectSecrets(workspaceId, projectId)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if workspaces.IsWorkspaceNotFound(err) || workspaces.IsProjectNotFound(err) || errors.Is(err, secret.ErrSecretNotFound) {
+			statusCode = http.StatusNotFound
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to get secrets of project %s: %w", projectId, err))
+		return
+	}
+
+	ctx.JSON(200, secrets)
+}
@@ -0,0 +1,156 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/api/controllers/workspace/dto"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/daytonaio/daytona/pkg/workspace/project/snapshot"
+	"github.com/gin-gonic/gin"
+)
+
+// SnapshotProject 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Snapshot a project
+//	@Description	Save the current state of the project
+//	@Param			workspaceId	path		string			true	"Workspace ID or Name"
+//	@Param			projectId	path		string			true	"Project ID"
+//	@Param			snapshot	body		CreateSnapshot	true	"Create snapshot"
+//	@Success		201			{object}	ProjectSnapshot
+//	@Router			/workspace/{workspaceId}/{projectId}/snapshot [post]
+//
+//	@id				SnapshotProject
+func SnapshotProject(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	var req dto.CreateSnapshot
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	snap, err := server.WorkspaceService.SnapshotProject(ctx.Request.Context(), workspaceId, projectId, req.Name)
+	if err != nil {
+		statusCode := getSnapshotErrorStatusCode(err)
+		if workspaces.IsSnapshotAlreadyExists(err) {
+			statusCode = http.StatusConflict
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to snapshot project %s: %w", projectId, err))
+		return
+	}
+
+	ctx.JSON(201, snap)
+}
+
+// RestoreProject 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Restore a project
+//	@Description	Restore the project from a snapshot
+//	@Param			workspaceId	path	string			true	"Workspace ID or Name"
+//	@Param			projectId	path	string			true	"Project ID"
+//	@Param			restore		body	RestoreSnapshot	true	"Restore snapshot"
+//	@Success		200
+//	@Router			/workspace/{workspaceId}/{projectId}/restore [post]
+//
+//	@id				RestoreProject
+func RestoreProject(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	var req dto.RestoreSnapshot
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	err = server.WorkspaceService.RestoreProject(ctx.Request.Context(), workspaceId, projectId, req.Snapshot)
+	if err != nil {
+		ctx.AbortWithError(getSnapshotErrorStatusCode(err), fmt.Errorf("failed to restore project %s: %w", projectId, err))
+		return
+	}
+
+	ctx.Status(200)
+}
+
+// ListSnapshots 			godoc
+//
+//	@Tags			workspace
+//	@Summary		List snapshots
+//	@Description	List the project snapshots of a workspace
+//	@Param			workspaceId	path	string	true	"Workspace ID or Name"
+//	@Success		200			{array}	ProjectSnapshot
+//	@Router			/workspace/{workspaceId}/snapshots [get]
+//
+//	@id				ListSnapshots
+func ListSnapshots(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+
+	server := server.GetInstance(nil)
+
+	snapshots, err := server.WorkspaceService.ListSnapshots(workspaceId)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if workspaces.IsWorkspaceNotFound(err) {
+			statusCode = http.StatusNotFound
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to list snapshots: %w", err))
+		return
+	}
+
+	ctx.JSON(200, snapshots)
+}
+
+// DeleteSnapshot 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Delete a snapshot
+//	@Description	Delete a project snapshot
+//	@Param			workspaceId	path	string	true	"Workspace ID or Name"
+//	@Param			projectId	path	string	true	"Project ID"
+//	@Param			snapshotId	path	string	true	"Snapshot ID or Name"
+//	@Success		200
+//	@Router			/workspace/{workspaceId}/{projectId}/snapshot/{snapshotId} [delete]
+//
+//	@id				DeleteSnapshot
+func DeleteSnapshot(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+	snapshotId := ctx.Param("snapshotId")
+
+	server := server.GetInstance(nil)
+
+	err := server.WorkspaceService.DeleteSnapshot(ctx.Request.Context(), workspaceId, projectId, snapshotId)
+	if err != nil {
+		ctx.AbortWithError(getSnapshotErrorStatusCode(err), fmt.Errorf("failed to delete snapshot %s: %w", snapshotId, err))
+		return
+	}
+
+	ctx.Status(200)
+}
+
+func getSnapshotErrorStatusCode(err error) int {
+	if workspaces.IsWorkspaceNotFound(err) || workspaces.IsProjectNotFound(err) || snapshot.IsSnapshotNotFound(err) {
+		return http.StatusNotFound
+	}
+
+	if provider.IsSnapshotNotSupported(err) {
+		return http.StatusNotImplemented
+	}
+
+	return getLifecycleErrorStatusCode(err)
+}
@@ -5,7 +5,6 @@ package workspace
 
 import (
 	"fmt"
-	"net/http"
 
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/gin-gonic/gin"
@@ -28,7 +27,7 @@ func StopWorkspace(ctx *gin.Context) {
 
 	err := server.WorkspaceService.StopWorkspace(ctx.Request.Context(), workspaceId)
 	if err != nil {
-		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to stop workspace %s: %w", workspaceId, err))
+		ctx.AbortWithError(getLifecycleErrorStatusCode(err), fmt.Errorf("failed to stop workspace %s: %w", workspaceId, err))
 		return
 	}
 
@@ -54,7 +53,7 @@ func StopProject(ctx *gin.Context) {
 
 	err := server.WorkspaceService.StopProject(ctx.Request.Context(), workspaceId, projectId)
 	if err != nil {
-		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to stop project %s: %w", projectId, err))
+		ctx.AbortWithError(getLifecycleErrorStatusCode(err), fmt.Errorf("failed to stop project %s: %w", projectId, err))
 		return
 	}
 
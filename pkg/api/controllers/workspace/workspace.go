@@ -10,6 +10,7 @@ import (
 	"strconv"
 
 	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/workspace/lifecycle"
 	"github.com/gin-gonic/gin"
 )
 
@@ -107,9 +108,18 @@ func RemoveWorkspace(ctx *gin.Context) {
 	}
 
 	if err != nil {
-		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to remove workspace: %w", err))
+		ctx.AbortWithError(getLifecycleErrorStatusCode(err), fmt.Errorf("failed to remove workspace: %w", err))
 		return
 	}
 
 	ctx.Status(200)
 }
+
+// getLifecycleErrorStatusCode returns 409 Conflict for operations that are not allowed in the current lifecycle state
+func getLifecycleErrorStatusCode(err error) int {
+	if errors.Is(err, lifecycle.ErrInvalidTransition) {
+		return http.StatusConflict
+	}
+
+	return http.StatusInternalServerError
+}
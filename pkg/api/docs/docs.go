@@ -57,6 +57,18 @@ const docTemplate = `{
                         "name": "apiKeyName",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API key role (admin, developer or read-only). Defaults to admin",
+                        "name": "role",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "RFC 3339 timestamp after which the key is rejected. The key never expires if omitted",
+                        "name": "expiresAt",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -91,6 +103,92 @@ const docTemplate = `{
                 }
             }
         },
+        "/apikey/{apiKeyName}/rotate": {
+            "post": {
+                "description": "Generate a replacement for an API key. The replaced key is renamed and stays valid for the grace period",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "apiKey"
+                ],
+                "summary": "Rotate an API key",
+                "operationId": "RotateApiKey",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "API key name",
+                        "name": "apiKeyName",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Duration for which the replaced key stays valid, e.g. 1h. Defaults to 24h, 0 revokes the key immediately",
+                        "name": "gracePeriod",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "RFC 3339 timestamp after which the new key is rejected. The key never expires if omitted",
+                        "name": "expiresAt",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/audit": {
+            "get": {
+                "description": "List the audit records of mutating API requests, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "audit"
+                ],
+                "summary": "List audit records",
+                "operationId": "ListAuditRecords",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Only return records created at or after this RFC 3339 timestamp",
+                        "name": "since",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "API key name",
+                        "name": "key",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only return records whose resource path starts with this value",
+                        "name": "resource",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/AuditRecord"
+                            }
+                        }
+                    }
+                }
+            }
+        },
         "/build": {
             "get": {
                 "description": "List builds",
@@ -234,6 +332,54 @@ const docTemplate = `{
                 }
             }
         },
+        "/build/{buildId}/cancel": {
+            "post": {
+                "description": "Cancel a pending or running build",
+                "tags": [
+                    "build"
+                ],
+                "summary": "Cancel build",
+                "operationId": "CancelBuild",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Build ID",
+                        "name": "buildId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/build/{buildId}/retry": {
+            "post": {
+                "description": "Re-queue a failed or cancelled build",
+                "tags": [
+                    "build"
+                ],
+                "summary": "Retry build",
+                "operationId": "RetryBuild",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Build ID",
+                        "name": "buildId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
         "/container-registry": {
             "get": {
                 "description": "List container registries",
@@ -341,6 +487,47 @@ const docTemplate = `{
                 }
             }
         },
+        "/events": {
+            "get": {
+                "description": "Stream workspace, project and build events as server-sent events",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Stream server events",
+                "operationId": "StreamEvents",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma-separated list of event types",
+                        "name": "types",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Build ID",
+                        "name": "buildId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Event"
+                        }
+                    }
+                }
+            }
+        },
         "/gitprovider": {
             "get": {
                 "description": "List Git providers",
@@ -576,6 +763,111 @@ const docTemplate = `{
                 }
             }
         },
+        "/gitprovider/{gitProviderId}/oauth/device": {
+            "post": {
+                "description": "Request a device code the user authorizes with the Git provider",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "gitProvider"
+                ],
+                "summary": "Start OAuth device flow",
+                "operationId": "StartOAuthDeviceFlow",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Git provider",
+                        "name": "gitProviderId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Device flow request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/StartOAuthDeviceFlow"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/OAuthDeviceCode"
+                        }
+                    }
+                }
+            }
+        },
+        "/gitprovider/{gitProviderId}/oauth/token": {
+            "post": {
+                "description": "Wait until the user authorizes the device code and register the Git provider with the obtained token",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "gitProvider"
+                ],
+                "summary": "Complete OAuth device flow",
+                "operationId": "CompleteOAuthDeviceFlow",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Git provider",
+                        "name": "gitProviderId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Device code",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CompleteOAuthDeviceFlow"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/gitprovider/{gitProviderId}/token-health": {
+            "get": {
+                "description": "Check the token of a Git provider against the provider",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "gitProvider"
+                ],
+                "summary": "Get Git provider token health",
+                "operationId": "GetTokenHealth",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Git provider",
+                        "name": "gitProviderId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/TokenHealth"
+                        }
+                    }
+                }
+            }
+        },
         "/gitprovider/{gitProviderId}/user": {
             "get": {
                 "description": "Get Git context",
@@ -1290,44 +1582,126 @@ const docTemplate = `{
                 }
             }
         },
-        "/server/config": {
+        "/secret": {
             "get": {
-                "description": "Get the server configuration",
+                "description": "List the names of the secrets. Secret values are never returned",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "server"
+                    "secret"
                 ],
-                "summary": "Get the server configuration",
-                "operationId": "GetConfig",
+                "summary": "List secrets",
+                "operationId": "ListSecrets",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/ServerConfig"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/Secret"
+                            }
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Set the server configuration",
+            }
+        },
+        "/secret/{secretName}": {
+            "put": {
+                "description": "Create a secret or replace its value",
                 "consumes": [
                     "application/json"
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "server"
+                    "secret"
                 ],
-                "summary": "Set the server configuration",
-                "operationId": "SetConfig",
+                "summary": "Set a secret",
+                "operationId": "SetSecret",
                 "parameters": [
                     {
-                        "description": "Server configuration",
-                        "name": "config",
-                        "in": "body",
+                        "type": "string",
+                        "description": "Secret name",
+                        "name": "secretName",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Secret value",
+                        "name": "secret",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/SetSecretDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created"
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a secret",
+                "tags": [
+                    "secret"
+                ],
+                "summary": "Delete a secret",
+                "operationId": "DeleteSecret",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Secret name",
+                        "name": "secretName",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/server/config": {
+            "get": {
+                "description": "Get the server configuration",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "server"
+                ],
+                "summary": "Get the server configuration",
+                "operationId": "GetConfig",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ServerConfig"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Set the server configuration",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "server"
+                ],
+                "summary": "Set the server configuration",
+                "operationId": "SetConfig",
+                "parameters": [
+                    {
+                        "description": "Server configuration",
+                        "name": "config",
+                        "in": "body",
                         "required": true,
                         "schema": {
                             "$ref": "#/definitions/ServerConfig"
@@ -1437,6 +1811,150 @@ const docTemplate = `{
                 }
             }
         },
+        "/webhook": {
+            "get": {
+                "description": "List webhooks",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "List webhooks",
+                "operationId": "ListWebhooks",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/Webhook"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a webhook. The signing secret is only returned in this response",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "Create a webhook",
+                "operationId": "CreateWebhook",
+                "parameters": [
+                    {
+                        "description": "Webhook",
+                        "name": "webhook",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateWebhookDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/Webhook"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhook/{webhookId}": {
+            "delete": {
+                "description": "Delete a webhook and its delivery log",
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "Delete a webhook",
+                "operationId": "DeleteWebhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "webhookId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/webhook/{webhookId}/deliveries": {
+            "get": {
+                "description": "List the most recent deliveries of a webhook",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "List webhook deliveries",
+                "operationId": "ListWebhookDeliveries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "webhookId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/WebhookDelivery"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/webhook/{webhookId}/test": {
+            "post": {
+                "description": "Send a test event to a webhook",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "Test a webhook",
+                "operationId": "TestWebhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "webhookId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/WebhookDelivery"
+                        }
+                    }
+                }
+            }
+        },
         "/workspace": {
             "get": {
                 "description": "List workspaces",
@@ -1557,14 +2075,89 @@ const docTemplate = `{
                 }
             }
         },
-        "/workspace/{workspaceId}/start": {
+        "/workspace/{workspaceId}/clone": {
             "post": {
-                "description": "Start workspace",
+                "description": "Create a new workspace with the same target and projects as an existing workspace",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
                     "workspace"
                 ],
-                "summary": "Start workspace",
-                "operationId": "StartWorkspace",
+                "summary": "Clone a workspace",
+                "operationId": "CloneWorkspace",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Clone workspace",
+                        "name": "workspace",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CloneWorkspaceDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Workspace"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/extend": {
+            "post": {
+                "description": "Postpone the expiry of the workspace",
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Extend workspace expiry",
+                "operationId": "ExtendWorkspace",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Extend workspace",
+                        "name": "extend",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ExtendWorkspace"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Workspace"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/migrate": {
+            "post": {
+                "description": "Recreate the workspace on another target and remove it from the current one",
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Migrate workspace",
+                "operationId": "MigrateWorkspace",
                 "parameters": [
                     {
                         "type": "string",
@@ -1572,23 +2165,440 @@ const docTemplate = `{
                         "name": "workspaceId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Migrate workspace",
+                        "name": "migrate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/MigrateWorkspace"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Workspace"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/project": {
+            "post": {
+                "description": "Provision a new project in an existing workspace",
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Add a project to a workspace",
+                "operationId": "AddProject",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Create project",
+                        "name": "project",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateProjectDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/Project"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/snapshots": {
+            "get": {
+                "description": "List the project snapshots of a workspace",
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "List snapshots",
+                "operationId": "ListSnapshots",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/ProjectSnapshot"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/start": {
+            "post": {
+                "description": "Start workspace",
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Start workspace",
+                "operationId": "StartWorkspace",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/stop": {
+            "post": {
+                "description": "Stop workspace",
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Stop workspace",
+                "operationId": "StopWorkspace",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}": {
+            "delete": {
+                "description": "Destroy the project and remove it from the workspace",
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Remove a project from a workspace",
+                "operationId": "RemoveProject",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/metrics": {
+            "get": {
+                "description": "Get the recent resource usage samples of the project, oldest first",
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Get project metrics",
+                "operationId": "GetProjectMetrics",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/ProjectMetrics"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/patch": {
+            "get": {
+                "description": "Get the uncommitted changes of a started project, including untracked files, as a binary patch against HEAD",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Get project patch",
+                "operationId": "GetProjectPatch",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/patch/pending": {
+            "get": {
+                "description": "Get the uncommitted changes that the project agent applies after cloning the repository. Only the project's own API key can read them",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Get pending project patch",
+                "operationId": "GetPendingProjectPatch",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove the uncommitted changes of the project after they have been applied. Only the project's own API key can remove them",
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Clear pending project patch",
+                "operationId": "ClearPendingProjectPatch",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/restore": {
+            "post": {
+                "description": "Restore the project from a snapshot",
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Restore a project",
+                "operationId": "RestoreProject",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Restore snapshot",
+                        "name": "restore",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/RestoreSnapshot"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/secrets": {
+            "get": {
+                "description": "Get the values of the secrets referenced by the project. Only the project's own API key can read them",
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Get project secrets",
+                "operationId": "GetProjectSecrets",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/snapshot": {
+            "post": {
+                "description": "Save the current state of the project",
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Snapshot a project",
+                "operationId": "SnapshotProject",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Create snapshot",
+                        "name": "snapshot",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateSnapshot"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK"
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/ProjectSnapshot"
+                        }
                     }
                 }
             }
         },
-        "/workspace/{workspaceId}/stop": {
-            "post": {
-                "description": "Stop workspace",
+        "/workspace/{workspaceId}/{projectId}/snapshot/{snapshotId}": {
+            "delete": {
+                "description": "Delete a project snapshot",
                 "tags": [
                     "workspace"
                 ],
-                "summary": "Stop workspace",
-                "operationId": "StopWorkspace",
+                "summary": "Delete a snapshot",
+                "operationId": "DeleteSnapshot",
                 "parameters": [
                     {
                         "type": "string",
@@ -1596,6 +2606,20 @@ const docTemplate = `{
                         "name": "workspaceId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Snapshot ID or Name",
+                        "name": "snapshotId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -1714,21 +2738,80 @@ const docTemplate = `{
             "required": [
                 "keyHash",
                 "name",
+                "role",
                 "type"
             ],
             "properties": {
+                "expiresAt": {
+                    "description": "The key is rejected after this time. Keys without an expiry never expire",
+                    "type": "string"
+                },
                 "keyHash": {
                     "type": "string"
                 },
+                "lastUsedAt": {
+                    "type": "string"
+                },
                 "name": {
                     "description": "Project or client name",
                     "type": "string"
                 },
+                "role": {
+                    "$ref": "#/definitions/apikey.ApiKeyRole"
+                },
                 "type": {
                     "$ref": "#/definitions/apikey.ApiKeyType"
                 }
             }
         },
+        "AuditRecord": {
+            "type": "object",
+            "required": [
+                "id",
+                "keyName",
+                "keyType",
+                "method",
+                "resource",
+                "result",
+                "route",
+                "statusCode",
+                "timestamp"
+            ],
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "keyName": {
+                    "type": "string"
+                },
+                "keyType": {
+                    "$ref": "#/definitions/apikey.ApiKeyType"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "resource": {
+                    "description": "Request path with the resource identifiers, e.g. /workspace/my-workspace",
+                    "type": "string"
+                },
+                "result": {
+                    "$ref": "#/definitions/audit.Result"
+                },
+                "route": {
+                    "description": "Route template, e.g. /workspace/:workspaceId",
+                    "type": "string"
+                },
+                "statusCode": {
+                    "type": "integer"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
         "Build": {
             "type": "object",
             "required": [
@@ -1737,7 +2820,9 @@ const docTemplate = `{
                 "id",
                 "image",
                 "prebuildId",
+                "priority",
                 "repository",
+                "retryCount",
                 "state",
                 "updatedAt",
                 "user"
@@ -1761,12 +2846,27 @@ const docTemplate = `{
                 "image": {
                     "type": "string"
                 },
+                "maxRetries": {
+                    "type": "integer"
+                },
+                "nextRetryAt": {
+                    "type": "string"
+                },
                 "prebuildId": {
                     "type": "string"
                 },
+                "priority": {
+                    "type": "integer"
+                },
+                "queuePosition": {
+                    "type": "integer"
+                },
                 "repository": {
                     "$ref": "#/definitions/GitRepository"
                 },
+                "retryCount": {
+                    "type": "integer"
+                },
                 "state": {
                     "$ref": "#/definitions/build.BuildState"
                 },
@@ -1786,6 +2886,9 @@ const docTemplate = `{
                 },
                 "devcontainer": {
                     "$ref": "#/definitions/DevcontainerConfig"
+                },
+                "dockerfile": {
+                    "$ref": "#/definitions/DockerfileConfig"
                 }
             }
         },
@@ -1815,6 +2918,39 @@ const docTemplate = `{
                 "CloneTargetCommit"
             ]
         },
+        "CloneWorkspaceDTO": {
+            "type": "object",
+            "required": [
+                "id",
+                "name"
+            ],
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "withChanges": {
+                    "description": "Carry over the uncommitted changes of the projects",
+                    "type": "boolean"
+                }
+            }
+        },
+        "CompleteOAuthDeviceFlow": {
+            "type": "object",
+            "required": [
+                "deviceCode"
+            ],
+            "properties": {
+                "baseApiUrl": {
+                    "type": "string"
+                },
+                "deviceCode": {
+                    "$ref": "#/definitions/OAuthDeviceCode"
+                }
+            }
+        },
         "ContainerRegistry": {
             "type": "object",
             "required": [
@@ -1874,6 +3010,9 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
+                "maxRetries": {
+                    "type": "integer"
+                },
                 "retention": {
                     "type": "integer"
                 },
@@ -1911,6 +3050,15 @@ const docTemplate = `{
                 "repositoryUrl": {
                     "type": "string"
                 },
+                "resources": {
+                    "$ref": "#/definitions/ResourceLimits"
+                },
+                "secrets": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "user": {
                     "type": "string"
                 }
@@ -1939,6 +3087,19 @@ const docTemplate = `{
                 "name": {
                     "type": "string"
                 },
+                "patch": {
+                    "description": "Uncommitted changes applied to the repository once it is cloned",
+                    "type": "string"
+                },
+                "resources": {
+                    "$ref": "#/definitions/ResourceLimits"
+                },
+                "secrets": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "source": {
                     "$ref": "#/definitions/CreateProjectSourceDTO"
                 },
@@ -1958,6 +3119,37 @@ const docTemplate = `{
                 }
             }
         },
+        "CreateSnapshot": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "description": "Defaults to the snapshot ID",
+                    "type": "string"
+                }
+            }
+        },
+        "CreateWebhookDTO": {
+            "type": "object",
+            "required": [
+                "events",
+                "url"
+            ],
+            "properties": {
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/EventType"
+                    }
+                },
+                "secret": {
+                    "description": "Generated by the server if omitted",
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
         "CreateWorkspaceDTO": {
             "type": "object",
             "required": [
@@ -1967,6 +3159,9 @@ const docTemplate = `{
                 "target"
             ],
             "properties": {
+                "autoStop": {
+                    "type": "integer"
+                },
                 "id": {
                     "type": "string"
                 },
@@ -1981,6 +3176,10 @@ const docTemplate = `{
                 },
                 "target": {
                     "type": "string"
+                },
+                "ttl": {
+                    "description": "Minutes after which the workspace is removed",
+                    "type": "integer"
                 }
             }
         },
@@ -1995,6 +3194,101 @@ const docTemplate = `{
                 }
             }
         },
+        "DockerfileConfig": {
+            "type": "object",
+            "required": [
+                "filePath"
+            ],
+            "properties": {
+                "buildArgs": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "context": {
+                    "description": "Build context directory, relative to the repository root; defaults to the repository root",
+                    "type": "string"
+                },
+                "filePath": {
+                    "description": "Path to the Dockerfile, relative to the repository root",
+                    "type": "string"
+                },
+                "target": {
+                    "description": "Target stage of a multi-stage Dockerfile",
+                    "type": "string"
+                }
+            }
+        },
+        "Event": {
+            "type": "object",
+            "required": [
+                "id",
+                "timestamp",
+                "type"
+            ],
+            "properties": {
+                "buildId": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "projectName": {
+                    "type": "string"
+                },
+                "state": {
+                    "type": "string"
+                },
+                "timestamp": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/EventType"
+                },
+                "workspaceId": {
+                    "type": "string"
+                }
+            }
+        },
+        "EventType": {
+            "type": "string",
+            "enum": [
+                "workspace.created",
+                "workspace.started",
+                "workspace.stopped",
+                "workspace.removed",
+                "workspace.error",
+                "project.state.updated",
+                "build.state.changed",
+                "webhook.test"
+            ],
+            "x-enum-varnames": [
+                "EventTypeWorkspaceCreated",
+                "EventTypeWorkspaceStarted",
+                "EventTypeWorkspaceStopped",
+                "EventTypeWorkspaceRemoved",
+                "EventTypeWorkspaceError",
+                "EventTypeProjectStateUpdated",
+                "EventTypeBuildStateChanged",
+                "EventTypeWebhookTest"
+            ]
+        },
+        "ExtendWorkspace": {
+            "type": "object",
+            "required": [
+                "duration"
+            ],
+            "properties": {
+                "duration": {
+                    "description": "Minutes by which the workspace expiry is postponed",
+                    "type": "integer"
+                }
+            }
+        },
         "FRPSConfig": {
             "type": "object",
             "required": [
@@ -2116,9 +3410,23 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
+                "refreshToken": {
+                    "description": "Set when the token was obtained through OAuth and can be refreshed",
+                    "type": "string"
+                },
                 "token": {
                     "type": "string"
                 },
+                "tokenExpiresAt": {
+                    "type": "string"
+                },
+                "tokenScopes": {
+                    "description": "Scopes granted to the token, as reported by the provider",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "username": {
                     "type": "string"
                 }
@@ -2244,20 +3552,78 @@ const docTemplate = `{
                 }
             }
         },
-        "InstallProviderRequest": {
+        "InstallProviderRequest": {
+            "type": "object",
+            "required": [
+                "downloadUrls",
+                "name"
+            ],
+            "properties": {
+                "downloadUrls": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "Lifecycle": {
+            "type": "object",
+            "required": [
+                "createdAt",
+                "state",
+                "updatedAt"
+            ],
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "lastStartedAt": {
+                    "type": "string"
+                },
+                "state": {
+                    "$ref": "#/definitions/LifecycleState"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "LifecycleState": {
+            "type": "string",
+            "enum": [
+                "creating",
+                "starting",
+                "started",
+                "stopping",
+                "stopped",
+                "error",
+                "deleting"
+            ],
+            "x-enum-varnames": [
+                "StateCreating",
+                "StateStarting",
+                "StateStarted",
+                "StateStopping",
+                "StateStopped",
+                "StateError",
+                "StateDeleting"
+            ]
+        },
+        "MigrateWorkspace": {
             "type": "object",
             "required": [
-                "downloadUrls",
-                "name"
+                "target"
             ],
             "properties": {
-                "downloadUrls": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "string"
-                    }
-                },
-                "name": {
+                "target": {
+                    "description": "Name of the target the workspace is moved to",
                     "type": "string"
                 }
             }
@@ -2273,6 +3639,34 @@ const docTemplate = `{
                 }
             }
         },
+        "OAuthDeviceCode": {
+            "type": "object",
+            "required": [
+                "deviceCode",
+                "expiresAt",
+                "interval",
+                "userCode",
+                "verificationUri"
+            ],
+            "properties": {
+                "deviceCode": {
+                    "type": "string"
+                },
+                "expiresAt": {
+                    "type": "string"
+                },
+                "interval": {
+                    "description": "Seconds to wait between polls for the access token",
+                    "type": "integer"
+                },
+                "userCode": {
+                    "type": "string"
+                },
+                "verificationUri": {
+                    "type": "string"
+                }
+            }
+        },
         "PrebuildConfig": {
             "type": "object",
             "required": [
@@ -2292,6 +3686,9 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
+                "maxRetries": {
+                    "type": "integer"
+                },
                 "retention": {
                     "type": "integer"
                 },
@@ -2321,6 +3718,9 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
+                "maxRetries": {
+                    "type": "integer"
+                },
                 "projectConfigName": {
                     "type": "string"
                 },
@@ -2354,6 +3754,7 @@ const docTemplate = `{
             "required": [
                 "envVars",
                 "image",
+                "lifecycle",
                 "name",
                 "repository",
                 "target",
@@ -2373,12 +3774,25 @@ const docTemplate = `{
                 "image": {
                     "type": "string"
                 },
+                "lifecycle": {
+                    "$ref": "#/definitions/Lifecycle"
+                },
                 "name": {
                     "type": "string"
                 },
                 "repository": {
                     "$ref": "#/definitions/GitRepository"
                 },
+                "resources": {
+                    "$ref": "#/definitions/ResourceLimits"
+                },
+                "secrets": {
+                    "description": "Names of the secrets injected into the project as environment variables",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "state": {
                     "$ref": "#/definitions/ProjectState"
                 },
@@ -2431,6 +3845,15 @@ const docTemplate = `{
                 "repositoryUrl": {
                     "type": "string"
                 },
+                "resources": {
+                    "$ref": "#/definitions/ResourceLimits"
+                },
+                "secrets": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "user": {
                     "type": "string"
                 }
@@ -2462,6 +3885,73 @@ const docTemplate = `{
                 }
             }
         },
+        "ProjectMetrics": {
+            "type": "object",
+            "required": [
+                "collectedAt",
+                "cpuUsage",
+                "diskTotal",
+                "diskUsage",
+                "memoryLimit",
+                "memoryUsage",
+                "processes"
+            ],
+            "properties": {
+                "collectedAt": {
+                    "type": "string"
+                },
+                "cpuUsage": {
+                    "description": "CPU usage in percent, where 100 is one fully used CPU",
+                    "type": "number"
+                },
+                "diskTotal": {
+                    "description": "Size of the project directory's filesystem in MB",
+                    "type": "integer"
+                },
+                "diskUsage": {
+                    "description": "Disk usage of the project directory's filesystem in MB",
+                    "type": "integer"
+                },
+                "memoryLimit": {
+                    "description": "Memory available to the project in MB",
+                    "type": "integer"
+                },
+                "memoryUsage": {
+                    "description": "Memory usage in MB",
+                    "type": "integer"
+                },
+                "processes": {
+                    "type": "integer"
+                }
+            }
+        },
+        "ProjectSnapshot": {
+            "type": "object",
+            "required": [
+                "createdAt",
+                "id",
+                "name",
+                "projectName",
+                "workspaceId"
+            ],
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "projectName": {
+                    "type": "string"
+                },
+                "workspaceId": {
+                    "type": "string"
+                }
+            }
+        },
         "ProjectState": {
             "type": "object",
             "required": [
@@ -2473,6 +3963,12 @@ const docTemplate = `{
                 "gitStatus": {
                     "$ref": "#/definitions/GitStatus"
                 },
+                "lastActivity": {
+                    "type": "string"
+                },
+                "metrics": {
+                    "$ref": "#/definitions/ProjectMetrics"
+                },
                 "updatedAt": {
                     "type": "string"
                 },
@@ -2504,6 +4000,10 @@ const docTemplate = `{
                 "providerInfo"
             ],
             "properties": {
+                "autoStop": {
+                    "description": "Minutes of inactivity after which projects on the target are stopped, 0 disables auto-stop",
+                    "type": "integer"
+                },
                 "name": {
                     "type": "string"
                 },
@@ -2533,6 +4033,35 @@ const docTemplate = `{
                 }
             }
         },
+        "ResourceLimits": {
+            "type": "object",
+            "properties": {
+                "cpus": {
+                    "description": "Number of CPUs, fractions are allowed",
+                    "type": "number"
+                },
+                "disk": {
+                    "description": "Disk size in GB",
+                    "type": "integer"
+                },
+                "memory": {
+                    "description": "Memory in MB",
+                    "type": "integer"
+                }
+            }
+        },
+        "RestoreSnapshot": {
+            "type": "object",
+            "required": [
+                "snapshot"
+            ],
+            "properties": {
+                "snapshot": {
+                    "description": "ID or name of the snapshot",
+                    "type": "string"
+                }
+            }
+        },
         "Sample": {
             "type": "object",
             "required": [
@@ -2552,11 +4081,28 @@ const docTemplate = `{
                 }
             }
         },
+        "Secret": {
+            "type": "object",
+            "required": [
+                "name",
+                "updatedAt"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
         "ServerConfig": {
             "type": "object",
             "required": [
                 "apiPort",
+                "auditRetentionDays",
                 "binariesPath",
+                "buildTimeout",
                 "builderImage",
                 "builderRegistryServer",
                 "defaultProjectImage",
@@ -2566,6 +4112,8 @@ const docTemplate = `{
                 "localBuilderRegistryImage",
                 "localBuilderRegistryPort",
                 "logFilePath",
+                "maxBuildRetries",
+                "maxConcurrentBuilds",
                 "providersDir",
                 "registryUrl",
                 "serverDownloadUrl"
@@ -2574,12 +4122,18 @@ const docTemplate = `{
                 "apiPort": {
                     "type": "integer"
                 },
+                "auditRetentionDays": {
+                    "type": "integer"
+                },
                 "binariesPath": {
                     "type": "string"
                 },
                 "buildImageNamespace": {
                     "type": "string"
                 },
+                "buildTimeout": {
+                    "type": "integer"
+                },
                 "builderImage": {
                     "type": "string"
                 },
@@ -2610,6 +4164,12 @@ const docTemplate = `{
                 "logFilePath": {
                     "type": "string"
                 },
+                "maxBuildRetries": {
+                    "type": "integer"
+                },
+                "maxConcurrentBuilds": {
+                    "type": "integer"
+                },
                 "providersDir": {
                     "type": "string"
                 },
@@ -2654,11 +4214,41 @@ const docTemplate = `{
                 "gitStatus": {
                     "$ref": "#/definitions/GitStatus"
                 },
+                "idleTime": {
+                    "description": "Seconds since the last SSH session, port-forward or IDE connection was closed",
+                    "type": "integer"
+                },
+                "metrics": {
+                    "$ref": "#/definitions/ProjectMetrics"
+                },
+                "target": {
+                    "description": "Target the reporting agent runs on",
+                    "type": "string"
+                },
                 "uptime": {
                     "type": "integer"
                 }
             }
         },
+        "SetSecretDTO": {
+            "type": "object",
+            "required": [
+                "value"
+            ],
+            "properties": {
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "StartOAuthDeviceFlow": {
+            "type": "object",
+            "properties": {
+                "baseApiUrl": {
+                    "type": "string"
+                }
+            }
+        },
         "Status": {
             "type": "string",
             "enum": [
@@ -2682,18 +4272,150 @@ const docTemplate = `{
                 "UpdatedButUnmerged"
             ]
         },
+        "TokenHealth": {
+            "type": "object",
+            "required": [
+                "refreshable",
+                "status"
+            ],
+            "properties": {
+                "error": {
+                    "description": "Reason the token was reported as invalid",
+                    "type": "string"
+                },
+                "expiresAt": {
+                    "type": "string"
+                },
+                "refreshable": {
+                    "type": "boolean"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "status": {
+                    "$ref": "#/definitions/TokenStatus"
+                }
+            }
+        },
+        "TokenStatus": {
+            "type": "string",
+            "enum": [
+                "valid",
+                "expiring",
+                "expired",
+                "invalid"
+            ],
+            "x-enum-varnames": [
+                "TokenStatusValid",
+                "TokenStatusExpiring",
+                "TokenStatusExpired",
+                "TokenStatusInvalid"
+            ]
+        },
+        "Webhook": {
+            "type": "object",
+            "required": [
+                "createdAt",
+                "events",
+                "id",
+                "url"
+            ],
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "events": {
+                    "description": "Event types the webhook is subscribed to. An empty list subscribes to all events except project state updates",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/EventType"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "secret": {
+                    "description": "Only returned when the webhook is created",
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "WebhookDelivery": {
+            "type": "object",
+            "required": [
+                "attempts",
+                "createdAt",
+                "eventId",
+                "eventType",
+                "id",
+                "statusCode",
+                "success",
+                "updatedAt",
+                "webhookId"
+            ],
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "eventId": {
+                    "type": "string"
+                },
+                "eventType": {
+                    "$ref": "#/definitions/EventType"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "statusCode": {
+                    "type": "integer"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "webhookId": {
+                    "type": "string"
+                }
+            }
+        },
         "Workspace": {
             "type": "object",
             "required": [
                 "id",
+                "lifecycle",
                 "name",
                 "projects",
                 "target"
             ],
             "properties": {
+                "autoStop": {
+                    "description": "Minutes of inactivity after which the workspace projects are stopped, 0 falls back to the target setting",
+                    "type": "integer"
+                },
+                "expiresAt": {
+                    "description": "The workspace is removed once it expires, nil means it never expires",
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
+                "lifecycle": {
+                    "$ref": "#/definitions/Lifecycle"
+                },
                 "name": {
                     "type": "string"
                 },
@@ -2712,17 +4434,29 @@ const docTemplate = `{
             "type": "object",
             "required": [
                 "id",
+                "lifecycle",
                 "name",
                 "projects",
                 "target"
             ],
             "properties": {
+                "autoStop": {
+                    "description": "Minutes of inactivity after which the workspace projects are stopped, 0 falls back to the target setting",
+                    "type": "integer"
+                },
+                "expiresAt": {
+                    "description": "The workspace is removed once it expires, nil means it never expires",
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
                 "info": {
                     "$ref": "#/definitions/WorkspaceInfo"
                 },
+                "lifecycle": {
+                    "$ref": "#/definitions/Lifecycle"
+                },
                 "name": {
                     "type": "string"
                 },
@@ -2758,6 +4492,21 @@ const docTemplate = `{
                 }
             }
         },
+        "apikey.ApiKeyRole": {
+            "type": "string",
+            "enum": [
+                "admin",
+                "developer",
+                "read-only",
+                "project"
+            ],
+            "x-enum-varnames": [
+                "ApiKeyRoleAdmin",
+                "ApiKeyRoleDeveloper",
+                "ApiKeyRoleReadOnly",
+                "ApiKeyRoleProject"
+            ]
+        },
         "apikey.ApiKeyType": {
             "type": "string",
             "enum": [
@@ -2771,6 +4520,17 @@ const docTemplate = `{
                 "ApiKeyTypeWorkspace"
             ]
         },
+        "audit.Result": {
+            "type": "string",
+            "enum": [
+                "success",
+                "failure"
+            ],
+            "x-enum-varnames": [
+                "ResultSuccess",
+                "ResultFailure"
+            ]
+        },
         "build.BuildState": {
             "type": "string",
             "enum": [
@@ -2780,7 +4540,8 @@ const docTemplate = `{
                 "success",
                 "published",
                 "pending-delete",
-                "deleting"
+                "deleting",
+                "cancelled"
             ],
             "x-enum-varnames": [
                 "BuildStatePendingRun",
@@ -2789,7 +4550,8 @@ const docTemplate = `{
                 "BuildStateSuccess",
                 "BuildStatePublished",
                 "BuildStatePendingDelete",
-                "BuildStateDeleting"
+                "BuildStateDeleting",
+                "BuildStateCancelled"
             ]
         },
         "provider.ProviderInfo": {
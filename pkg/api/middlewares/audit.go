@@ -0,0 +1,56 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package middlewares
+
+import (
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/audit"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+)
+
+// Project agents report their state every few seconds, so the reports are not recorded
+var unauditedRoutes = map[string]bool{
+	"/workspace/:workspaceId/:projectId/state": true,
+}
+
+func AuditMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctx.Next()
+
+		switch ctx.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			return
+		}
+
+		if unauditedRoutes[ctx.FullPath()] {
+			return
+		}
+
+		record := &audit.Record{
+			KeyName:    audit.AnonymousKeyName,
+			Method:     ctx.Request.Method,
+			Route:      ctx.FullPath(),
+			Resource:   ctx.Request.URL.Path,
+			StatusCode: ctx.Writer.Status(),
+		}
+
+		// Requests rejected by the authentication have no key
+		if apiKey := getApiKey(ctx); apiKey != nil {
+			record.KeyName = apiKey.Name
+			record.KeyType = apiKey.Type
+		}
+
+		if lastErr := ctx.Errors.Last(); lastErr != nil {
+			record.Error = lastErr.Error()
+		}
+
+		err := server.GetInstance(nil).AuditLogService.Save(record)
+		if err != nil {
+			log.Errorf("failed to save audit record: %s", err)
+		}
+	}
+}
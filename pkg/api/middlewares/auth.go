@@ -7,10 +7,13 @@ import (
 	"errors"
 	"strings"
 
+	"github.com/daytonaio/daytona/pkg/apikey"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/gin-gonic/gin"
 )
 
+const apiKeyContextKey = "apiKey"
+
 func AuthMiddleware() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		bearerToken := ctx.GetHeader("Authorization")
@@ -27,10 +30,18 @@ func AuthMiddleware() gin.HandlerFunc {
 
 		server := server.GetInstance(nil)
 
-		if !server.ApiKeyService.IsValidApiKey(token) {
+		apiKey, err := server.ApiKeyService.Authenticate(token)
+		if err != nil {
+			if apikey.IsApiKeyExpired(err) {
+				ctx.AbortWithError(401, err)
+				return
+			}
 			ctx.AbortWithError(401, errors.New("unauthorized"))
+			return
 		}
 
+		ctx.Set(apiKeyContextKey, apiKey)
+
 		ctx.Next()
 	}
 }
@@ -42,3 +53,14 @@ func ExtractToken(bearerToken string) string {
 
 	return strings.TrimPrefix(bearerToken, "Bearer ")
 }
+
+// getApiKey returns the API key the request was authenticated with by AuthMiddleware
+func getApiKey(ctx *gin.Context) *apikey.ApiKey {
+	value, ok := ctx.Get(apiKeyContextKey)
+	if !ok {
+		return nil
+	}
+
+	apiKey, _ := value.(*apikey.ApiKey)
+	return apiKey
+}
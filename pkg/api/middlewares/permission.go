@@ -0,0 +1,99 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package middlewares
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/gin-gonic/gin"
+)
+
+// PermissionMiddleware only allows requests authenticated with a key that has one of the given permissions.
+// It must be used after AuthMiddleware
+func PermissionMiddleware(permissions ...apikey.Permission) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		authorize(ctx, permissions...)
+	}
+}
+
+// WorkspacePermissionMiddleware allows read requests to keys with the read permission and all other
+// requests to keys with the write workspaces permission. Keys generated for workspaces and projects
+// can only read their own workspace. It must be used after AuthMiddleware
+func WorkspacePermissionMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		switch ctx.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			apiKey := getApiKey(ctx)
+			if apiKey != nil && apiKey.Role == apikey.ApiKeyRoleProject && ownsWorkspace(apiKey, ctx) {
+				ctx.Next()
+				return
+			}
+
+			authorize(ctx, apikey.PermissionRead)
+		default:
+			authorize(ctx, apikey.PermissionWriteWorkspaces)
+		}
+	}
+}
+
+// ProjectPermissionMiddleware only allows requests authenticated with a key that has the given permission.
+// Keys generated for workspaces and projects can only access their own workspace and project in the path parameters.
+// It must be used after AuthMiddleware
+func ProjectPermissionMiddleware(permission apikey.Permission) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		apiKey := getApiKey(ctx)
+		if apiKey != nil && apiKey.Role == apikey.ApiKeyRoleProject && !ownsWorkspace(apiKey, ctx) {
+			ctx.AbortWithError(http.StatusForbidden, errors.New("the API key can only access its own workspace and project"))
+			return
+		}
+
+		authorize(ctx, permission)
+	}
+}
+
+func authorize(ctx *gin.Context, permissions ...apikey.Permission) {
+	apiKey := getApiKey(ctx)
+	if apiKey == nil {
+		ctx.AbortWithError(http.StatusUnauthorized, errors.New("unauthorized"))
+		return
+	}
+
+	for _, permission := range permissions {
+		if apiKey.Role.HasPermission(permission) {
+			ctx.Next()
+			return
+		}
+	}
+
+	ctx.AbortWithError(http.StatusForbidden, fmt.Errorf("API key role %s does not have the %s permission", apiKey.Role, permissions[0]))
+}
+
+// ownsWorkspace returns true if the key was generated for the workspace in the workspaceId path parameter,
+// or for the project in the workspaceId and projectId path parameters
+func ownsWorkspace(apiKey *apikey.ApiKey, ctx *gin.Context) bool {
+	workspaceId := ctx.Param("workspaceId")
+	if workspaceId == "" {
+		return false
+	}
+
+	switch apiKey.Type {
+	case apikey.ApiKeyTypeWorkspace:
+		return apiKey.Name == workspaceId
+	case apikey.ApiKeyTypeProject:
+		if projectId := ctx.Param("projectId"); projectId != "" {
+			return apiKey.Name == getProjectApiKeyName(workspaceId, projectId)
+		}
+		return strings.HasPrefix(apiKey.Name, workspaceId+"/")
+	}
+
+	return false
+}
+
+func getProjectApiKeyName(workspaceId, projectId string) string {
+	return fmt.Sprintf("%s/%s", workspaceId, projectId)
+}
@@ -0,0 +1,86 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func newPermissionTestRouter(apiKey *apikey.ApiKey) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(func(ctx *gin.Context) {
+		ctx.Set(apiKeyContextKey, apiKey)
+	})
+
+	ok := func(ctx *gin.Context) { ctx.Status(http.StatusOK) }
+
+	router.GET("/profile", PermissionMiddleware(apikey.PermissionAdmin), ok)
+	router.GET("/binary", PermissionMiddleware(apikey.PermissionRead, apikey.PermissionReportState), ok)
+
+	workspaces := router.Group("/workspace")
+	workspaces.Use(WorkspacePermissionMiddleware())
+	workspaces.GET("/", ok)
+	workspaces.POST("/", ok)
+	workspaces.GET("/:workspaceId", ok)
+	workspaces.GET("/:workspaceId/:projectId/metrics", ok)
+
+	router.POST("/state/:workspaceId/:projectId", ProjectPermissionMiddleware(apikey.PermissionReportState), ok)
+
+	return router
+}
+
+func TestPermissionMiddleware(t *testing.T) {
+	readOnlyKey := &apikey.ApiKey{Name: "ci", Type: apikey.ApiKeyTypeClient, Role: apikey.ApiKeyRoleReadOnly}
+	developerKey := &apikey.ApiKey{Name: "dev", Type: apikey.ApiKeyTypeClient, Role: apikey.ApiKeyRoleDeveloper}
+	adminKey := &apikey.ApiKey{Name: "default", Type: apikey.ApiKeyTypeClient, Role: apikey.ApiKeyRoleAdmin}
+	projectKey := &apikey.ApiKey{Name: "ws1/p1", Type: apikey.ApiKeyTypeProject, Role: apikey.ApiKeyRoleProject}
+	workspaceKey := &apikey.ApiKey{Name: "ws1", Type: apikey.ApiKeyTypeWorkspace, Role: apikey.ApiKeyRoleProject}
+
+	tests := []struct {
+		name     string
+		apiKey   *apikey.ApiKey
+		method   string
+		path     string
+		expected int
+	}{
+		{"read-only key reads an admin resource", readOnlyKey, http.MethodGet, "/profile", http.StatusForbidden},
+		{"developer key reads an admin resource", developerKey, http.MethodGet, "/profile", http.StatusForbidden},
+		{"admin key reads an admin resource", adminKey, http.MethodGet, "/profile", http.StatusOK},
+		{"read-only key downloads the binary", readOnlyKey, http.MethodGet, "/binary", http.StatusOK},
+		{"project key downloads the binary", projectKey, http.MethodGet, "/binary", http.StatusOK},
+		{"read-only key lists workspaces", readOnlyKey, http.MethodGet, "/workspace/", http.StatusOK},
+		{"read-only key creates a workspace", readOnlyKey, http.MethodPost, "/workspace/", http.StatusForbidden},
+		{"developer key creates a workspace", developerKey, http.MethodPost, "/workspace/", http.StatusOK},
+		{"project key lists workspaces", projectKey, http.MethodGet, "/workspace/", http.StatusForbidden},
+		{"project key reads its workspace", projectKey, http.MethodGet, "/workspace/ws1", http.StatusOK},
+		{"project key reads another workspace", projectKey, http.MethodGet, "/workspace/ws2", http.StatusForbidden},
+		{"project key reads its project", projectKey, http.MethodGet, "/workspace/ws1/p1/metrics", http.StatusOK},
+		{"project key reads another project", projectKey, http.MethodGet, "/workspace/ws1/p2/metrics", http.StatusForbidden},
+		{"project key creates a workspace", projectKey, http.MethodPost, "/workspace/", http.StatusForbidden},
+		{"workspace key reads its project", workspaceKey, http.MethodGet, "/workspace/ws1/p2/metrics", http.StatusOK},
+		{"workspace key reads another workspace", workspaceKey, http.MethodGet, "/workspace/ws2", http.StatusForbidden},
+		{"project key reports the state of its project", projectKey, http.MethodPost, "/state/ws1/p1", http.StatusOK},
+		{"project key reports the state of another project", projectKey, http.MethodPost, "/state/ws1/p2", http.StatusForbidden},
+		{"project key reports the state of another workspace", projectKey, http.MethodPost, "/state/ws2/p1", http.StatusForbidden},
+		{"workspace key reports the state of its project", workspaceKey, http.MethodPost, "/state/ws1/p2", http.StatusOK},
+		{"read-only key reports the state of a project", readOnlyKey, http.MethodPost, "/state/ws1/p1", http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			newPermissionTestRouter(tt.apiKey).ServeHTTP(recorder, httptest.NewRequest(tt.method, tt.path, nil))
+
+			require.Equal(t, tt.expected, recorder.Code)
+		})
+	}
+}
@@ -5,29 +5,73 @@ package middlewares
 
 import (
 	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
 
+	"github.com/daytonaio/daytona/pkg/apikey"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/gin-gonic/gin"
 )
 
-func ProjectAuthMiddleware() gin.HandlerFunc {
+// ProjectApiKeyMiddleware only allows requests authenticated with the API key generated for the
+// project in the workspaceId and projectId path parameters. It must be used after AuthMiddleware
+func ProjectApiKeyMiddleware() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
-		bearerToken := ctx.GetHeader("Authorization")
-		if bearerToken == "" {
-			ctx.AbortWithError(401, errors.New("unauthorized"))
+		apiKey := getApiKey(ctx)
+		if apiKey == nil {
+			ctx.AbortWithError(http.StatusUnauthorized, errors.New("unauthorized"))
 			return
 		}
 
-		token := ExtractToken(bearerToken)
-		if token == "" {
-			ctx.AbortWithError(401, errors.New("unauthorized"))
+		projectKeyName := getProjectApiKeyName(ctx.Param("workspaceId"), ctx.Param("projectId"))
+		if apiKey.Type != apikey.ApiKeyTypeProject || apiKey.Name != projectKeyName {
+			ctx.AbortWithError(http.StatusForbidden, errors.New("only the project's API key can access this resource"))
+			return
+		}
+
+		ctx.Next()
+	}
+}
+
+// GitProviderOwnerMiddleware only allows keys generated for workspaces and projects to get the git provider
+// for the url path parameter if it is also the git provider of the repository of their project, or of any
+// project of their workspace. Other keys are not limited. It must be used after AuthMiddleware
+func GitProviderOwnerMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		apiKey := getApiKey(ctx)
+		if apiKey == nil || apiKey.Role != apikey.ApiKeyRoleProject {
+			ctx.Next()
+			return
+		}
+
+		decodedUrl, err := url.QueryUnescape(ctx.Param("url"))
+		if err != nil {
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to decode query param: %w", err))
 			return
 		}
 
 		server := server.GetInstance(nil)
 
-		if !server.ApiKeyService.IsProjectApiKey(token) && !server.ApiKeyService.IsWorkspaceApiKey(token) {
-			ctx.AbortWithError(401, errors.New("unauthorized"))
+		gitProvider, err := server.GitProviderService.GetConfigForUrl(decodedUrl)
+		if err != nil {
+			// The error is reported by the handler
+			ctx.Next()
+			return
+		}
+
+		workspaceId, projectName, _ := strings.Cut(apiKey.Name, "/")
+
+		usesGitProvider, err := server.WorkspaceService.UsesGitProvider(workspaceId, projectName, gitProvider.Id)
+		if err != nil {
+			ctx.AbortWithError(http.StatusInternalServerError, err)
+			return
+		}
+
+		if !usesGitProvider {
+			ctx.AbortWithError(http.StatusForbidden, errors.New("the API key can only access the git provider of its own repository"))
+			return
 		}
 
 		ctx.Next()
@@ -29,13 +29,17 @@ import (
 	"github.com/daytonaio/daytona/internal"
 	"github.com/daytonaio/daytona/pkg/api/docs"
 	"github.com/daytonaio/daytona/pkg/api/middlewares"
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/metrics"
 	"github.com/daytonaio/daytona/pkg/telemetry"
 	"github.com/gin-contrib/cors"
 
-	"github.com/daytonaio/daytona/pkg/api/controllers/apikey"
+	apikey_controller "github.com/daytonaio/daytona/pkg/api/controllers/apikey"
+	"github.com/daytonaio/daytona/pkg/api/controllers/audit"
 	"github.com/daytonaio/daytona/pkg/api/controllers/binary"
 	"github.com/daytonaio/daytona/pkg/api/controllers/build"
 	"github.com/daytonaio/daytona/pkg/api/controllers/containerregistry"
+	"github.com/daytonaio/daytona/pkg/api/controllers/events"
 	"github.com/daytonaio/daytona/pkg/api/controllers/gitprovider"
 	log_controller "github.com/daytonaio/daytona/pkg/api/controllers/log"
 	"github.com/daytonaio/daytona/pkg/api/controllers/profiledata"
@@ -43,8 +47,10 @@ import (
 	"github.com/daytonaio/daytona/pkg/api/controllers/projectconfig/prebuild"
 	"github.com/daytonaio/daytona/pkg/api/controllers/provider"
 	"github.com/daytonaio/daytona/pkg/api/controllers/sample"
+	"github.com/daytonaio/daytona/pkg/api/controllers/secret"
 	"github.com/daytonaio/daytona/pkg/api/controllers/server"
 	"github.com/daytonaio/daytona/pkg/api/controllers/target"
+	"github.com/daytonaio/daytona/pkg/api/controllers/webhook"
 	"github.com/daytonaio/daytona/pkg/api/controllers/workspace"
 
 	"github.com/gin-gonic/gin"
@@ -99,10 +105,28 @@ func (a *ApiServer) Start() error {
 		a.router.Use(gin.Recovery())
 	}
 
+	a.router.Use(middlewares.MetricsMiddleware())
 	a.router.Use(middlewares.TelemetryMiddleware(a.telemetryService))
 	a.router.Use(middlewares.LoggingMiddleware())
 	a.router.Use(middlewares.SetVersionMiddleware())
 
+	a.setupRoutes()
+
+	a.httpServer = &http.Server{
+		Addr:    fmt.Sprintf(":%d", a.apiPort),
+		Handler: a.router,
+	}
+
+	listener, err := net.Listen("tcp", a.httpServer.Addr)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Starting api server on port %d", a.apiPort)
+	return a.httpServer.Serve(listener)
+}
+
+func (a *ApiServer) setupRoutes() {
 	public := a.router.Group("/")
 	public.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerfiles.Handler))
 	public.GET(constants.HEALTH_CHECK_ROUTE, func(c *gin.Context) {
@@ -110,34 +134,59 @@ func (a *ApiServer) Start() error {
 	})
 
 	protected := a.router.Group("/")
+	// Registered before the authentication so that rejected requests are recorded too
+	protected.Use(middlewares.AuditMiddleware())
 	protected.Use(middlewares.AuthMiddleware())
 
+	protected.GET("/metrics", middlewares.PermissionMiddleware(apikey.PermissionRead), gin.WrapH(metrics.Handler()))
+
 	serverController := protected.Group("/server")
+	serverController.Use(middlewares.PermissionMiddleware(apikey.PermissionAdmin))
 	{
 		serverController.GET("/config", server.GetConfig)
 		serverController.POST("/config", server.SetConfig)
-		serverController.POST("/network-key", server.GenerateNetworkKey)
+	}
+
+	networkKeyController := protected.Group("/server")
+	networkKeyController.Use(middlewares.PermissionMiddleware(apikey.PermissionConnect))
+	{
+		networkKeyController.POST("/network-key", server.GenerateNetworkKey)
 	}
 
 	binaryController := protected.Group("/binary")
+	// Projects download the binary with their API key
+	binaryController.Use(middlewares.PermissionMiddleware(apikey.PermissionRead, apikey.PermissionReportState))
 	{
 		binaryController.GET("/script", binary.GetDaytonaScript)
 		binaryController.GET("/:version/:binaryName", binary.GetBinary)
 	}
 
 	workspaceController := protected.Group("/workspace")
+	workspaceController.Use(middlewares.WorkspacePermissionMiddleware())
 	{
 		workspaceController.GET("/:workspaceId", workspace.GetWorkspace)
 		workspaceController.GET("/", workspace.ListWorkspaces)
 		workspaceController.POST("/", workspace.CreateWorkspace)
 		workspaceController.POST("/:workspaceId/start", workspace.StartWorkspace)
 		workspaceController.POST("/:workspaceId/stop", workspace.StopWorkspace)
+		workspaceController.POST("/:workspaceId/extend", workspace.ExtendWorkspace)
+		workspaceController.POST("/:workspaceId/clone", workspace.CloneWorkspace)
+		workspaceController.POST("/:workspaceId/migrate", workspace.MigrateWorkspace)
 		workspaceController.DELETE("/:workspaceId", workspace.RemoveWorkspace)
+		workspaceController.POST("/:workspaceId/project", workspace.AddProject)
+		workspaceController.DELETE("/:workspaceId/:projectId", workspace.RemoveProject)
 		workspaceController.POST("/:workspaceId/:projectId/start", workspace.StartProject)
 		workspaceController.POST("/:workspaceId/:projectId/stop", workspace.StopProject)
+		workspaceController.GET("/:workspaceId/:projectId/metrics", workspace.GetProjectMetrics)
+		workspaceController.GET("/:workspaceId/:projectId/patch", workspace.GetProjectPatch)
+		workspaceController.GET("/:workspaceId/snapshots", workspace.ListSnapshots)
+		workspaceController.POST("/:workspaceId/:projectId/snapshot", workspace.SnapshotProject)
+		workspaceController.POST("/:workspaceId/:projectId/restore", workspace.RestoreProject)
+		workspaceController.DELETE("/:workspaceId/:projectId/snapshot/:snapshotId", workspace.DeleteSnapshot)
 	}
 
 	projectConfigController := protected.Group("/project-config")
+	projectConfigController.Use(middlewares.PermissionMiddleware(apikey.PermissionAdmin))
 	{
 		// Defining the prebuild routes first to avoid conflicts with the project config routes
 		prebuildRoutePath := "/prebuild"
@@ -166,6 +215,7 @@ func (a *ApiServer) Start() error {
 	public.POST(constants.WEBHOOK_EVENT_ROUTE, prebuild.ProcessGitEvent)
 
 	providerController := protected.Group("/provider")
+	providerController.Use(middlewares.PermissionMiddleware(apikey.PermissionAdmin))
 	{
 		providerController.POST("/install", provider.InstallProvider)
 		providerController.GET("/", provider.ListProviders)
@@ -174,6 +224,7 @@ func (a *ApiServer) Start() error {
 	}
 
 	containerRegistryController := protected.Group("/container-registry")
+	containerRegistryController.Use(middlewares.PermissionMiddleware(apikey.PermissionAdmin))
 	{
 		containerRegistryController.GET("/", containerregistry.ListContainerRegistries)
 		containerRegistryController.GET("/:server", containerregistry.GetContainerRegistry)
@@ -182,16 +233,20 @@ func (a *ApiServer) Start() error {
 	}
 
 	buildController := protected.Group("/build")
+	buildController.Use(middlewares.PermissionMiddleware(apikey.PermissionAdmin))
 	{
 		buildController.POST("/", build.CreateBuild)
 		buildController.GET("/:buildId", build.GetBuild)
 		buildController.GET("/", build.ListBuilds)
 		buildController.DELETE("/", build.DeleteAllBuilds)
 		buildController.DELETE("/:buildId", build.DeleteBuild)
+		buildController.POST("/:buildId/cancel", build.CancelBuild)
+		buildController.POST("/:buildId/retry", build.RetryBuild)
 		buildController.DELETE("/prebuild/:prebuildId", build.DeleteBuildsFromPrebuild)
 	}
 
 	targetController := protected.Group("/target")
+	targetController.Use(middlewares.PermissionMiddleware(apikey.PermissionAdmin))
 	{
 		targetController.GET("/", target.ListTargets)
 		targetController.PUT("/", target.SetTarget)
@@ -199,6 +254,7 @@ func (a *ApiServer) Start() error {
 	}
 
 	logController := protected.Group("/log")
+	logController.Use(middlewares.PermissionMiddleware(apikey.PermissionRead))
 	{
 		logController.GET("/server", log_controller.ReadServerLog)
 		logController.GET("/workspace/:workspaceId", log_controller.ReadWorkspaceLog)
@@ -207,58 +263,100 @@ func (a *ApiServer) Start() error {
 	}
 
 	gitProviderController := protected.Group("/gitprovider")
+	gitProviderController.Use(middlewares.PermissionMiddleware(apikey.PermissionAdmin))
 	{
 		gitProviderController.GET("/", gitprovider.ListGitProviders)
 		gitProviderController.PUT("/", gitprovider.SetGitProvider)
 		gitProviderController.DELETE("/:gitProviderId", gitprovider.RemoveGitProvider)
-		gitProviderController.GET("/:gitProviderId/user", gitprovider.GetGitUser)
+		gitProviderController.GET("/:gitProviderId/token-health", gitprovider.GetTokenHealth)
+		gitProviderController.POST("/:gitProviderId/oauth/device", gitprovider.StartOAuthDeviceFlow)
+		gitProviderController.POST("/:gitProviderId/oauth/token", gitprovider.CompleteOAuthDeviceFlow)
 		gitProviderController.GET("/:gitProviderId/namespaces", gitprovider.GetNamespaces)
 		gitProviderController.GET("/:gitProviderId/:namespaceId/repositories", gitprovider.GetRepositories)
 		gitProviderController.GET("/:gitProviderId/:namespaceId/:repositoryId/branches", gitprovider.GetRepoBranches)
 		gitProviderController.GET("/:gitProviderId/:namespaceId/:repositoryId/pull-requests", gitprovider.GetRepoPRs)
-		gitProviderController.POST("/context", gitprovider.GetGitContext)
-		gitProviderController.POST("/context/url", gitprovider.GetUrlFromRepository)
 		gitProviderController.GET("/id-for-url/:url", gitprovider.GetGitProviderIdForUrl)
 	}
 
+	// Project agents configure git with the user of their repository's git provider
+	gitUserController := protected.Group("/gitprovider")
+	gitUserController.Use(middlewares.PermissionMiddleware(apikey.PermissionAdmin, apikey.PermissionReportState))
+	{
+		gitUserController.GET("/:gitProviderId/user", gitprovider.GetGitUser)
+	}
+
+	// Resolves repository context without changing any state
+	gitContextController := protected.Group("/gitprovider")
+	gitContextController.Use(middlewares.PermissionMiddleware(apikey.PermissionRead))
+	{
+		gitContextController.POST("/context", gitprovider.GetGitContext)
+		gitContextController.POST("/context/url", gitprovider.GetUrlFromRepository)
+	}
+
 	apiKeyController := protected.Group("/apikey")
+	apiKeyController.Use(middlewares.PermissionMiddleware(apikey.PermissionAdmin))
 	{
-		apiKeyController.GET("/", apikey.ListClientApiKeys)
-		apiKeyController.POST("/:apiKeyName", apikey.GenerateApiKey)
-		apiKeyController.DELETE("/:apiKeyName", apikey.RevokeApiKey)
+		apiKeyController.GET("/", apikey_controller.ListClientApiKeys)
+		apiKeyController.POST("/:apiKeyName", apikey_controller.GenerateApiKey)
+		apiKeyController.DELETE("/:apiKeyName", apikey_controller.RevokeApiKey)
+		apiKeyController.POST("/:apiKeyName/rotate", apikey_controller.RotateApiKey)
 	}
 
 	profileDataController := protected.Group("/profile")
+	profileDataController.Use(middlewares.PermissionMiddleware(apikey.PermissionAdmin))
 	{
 		profileDataController.GET("/", profiledata.GetProfileData)
 		profileDataController.PUT("/", profiledata.SetProfileData)
 		profileDataController.DELETE("/", profiledata.DeleteProfileData)
 	}
 
+	protected.GET("/events", middlewares.PermissionMiddleware(apikey.PermissionRead), events.StreamEvents)
+
+	webhookController := protected.Group("/webhook")
+	webhookController.Use(middlewares.PermissionMiddleware(apikey.PermissionAdmin))
+	{
+		webhookController.GET("/", webhook.ListWebhooks)
+		webhookController.POST("/", webhook.CreateWebhook)
+		webhookController.DELETE("/:webhookId", webhook.DeleteWebhook)
+		webhookController.POST("/:webhookId/test", webhook.TestWebhook)
+		webhookController.GET("/:webhookId/deliveries", webhook.ListWebhookDeliveries)
+	}
+
+	protected.GET("/audit", middlewares.PermissionMiddleware(apikey.PermissionRead), audit.ListAuditRecords)
+
+	secretController := protected.Group("/secret")
+	secretController.Use(middlewares.PermissionMiddleware(apikey.PermissionAdmin))
+	{
+		secretController.GET("/", secret.ListSecrets)
+		secretController.PUT("/:secretName", secret.SetSecret)
+		secretController.DELETE("/:secretName", secret.DeleteSecret)
+	}
+
 	samplesController := protected.Group("/sample")
+	samplesController.Use(middlewares.PermissionMiddleware(apikey.PermissionRead))
 	{
 		samplesController.GET("/", sample.ListSamples)
 	}
 
 	projectGroup := protected.Group("/")
-	projectGroup.Use(middlewares.ProjectAuthMiddleware())
+	projectGroup.Use(middlewares.ProjectPermissionMiddleware(apikey.PermissionReportState))
 	{
 		projectGroup.POST(workspaceController.BasePath()+"/:workspaceId/:projectId/state", workspace.SetProjectState)
-		projectGroup.GET(gitProviderController.BasePath()+"/for-url/:url", gitprovider.GetGitProviderForUrl)
 	}
 
-	a.httpServer = &http.Server{
-		Addr:    fmt.Sprintf(":%d", a.apiPort),
-		Handler: a.router,
+	gitProviderForUrlGroup := protected.Group("/")
+	gitProviderForUrlGroup.Use(middlewares.PermissionMiddleware(apikey.PermissionReportState), middlewares.GitProviderOwnerMiddleware())
+	{
+		gitProviderForUrlGroup.GET(gitProviderController.BasePath()+"/for-url/:url", gitprovider.GetGitProviderForUrl)
 	}
 
-	listener, err := net.Listen("tcp", a.httpServer.Addr)
-	if err != nil {
-		return err
+	projectApiKeyGroup := protected.Group("/")
+	projectApiKeyGroup.Use(middlewares.ProjectApiKeyMiddleware())
+	{
+		projectApiKeyGroup.GET(workspaceController.BasePath()+"/:workspaceId/:projectId/secrets", workspace.GetProjectSecrets)
+		projectApiKeyGroup.GET(workspaceController.BasePath()+"/:workspaceId/:projectId/patch/pending", workspace.GetPendingProjectPatch)
+		projectApiKeyGroup.DELETE(workspaceController.BasePath()+"/:workspaceId/:projectId/patch/pending", workspace.ClearPendingProjectPatch)
 	}
-
-	log.Infof("Starting api server on port %d", a.apiPort)
-	return a.httpServer.Serve(listener)
 }
 
 func (a *ApiServer) HealthCheck() error {
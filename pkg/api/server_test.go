@@ -0,0 +1,127 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	t_apikeys "github.com/daytonaio/daytona/internal/testing/server/apikeys"
+	t_auditlog "github.com/daytonaio/daytona/internal/testing/server/auditlog"
+	t_workspaces "github.com/daytonaio/daytona/internal/testing/server/workspaces"
+	"github.com/daytonaio/daytona/internal/testing/server/workspaces/mocks"
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/apikeys"
+	"github.com/daytonaio/daytona/pkg/server/auditlog"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoutePermissions(t *testing.T) {
+	apiKeyService := apikeys.NewApiKeyService(apikeys.ApiKeyServiceConfig{
+		ApiKeyStore: t_apikeys.NewInMemoryApiKeyStore(),
+	})
+
+	githubConfig := &gitprovider.GitProviderConfig{Id: "github"}
+	gitlabConfig := &gitprovider.GitProviderConfig{Id: "gitlab"}
+
+	gitProviderService := mocks.NewMockGitProviderService()
+	gitProviderService.On("GetConfigForUrl", "https://github.com/daytonaio/daytona").Return(githubConfig, nil)
+	gitProviderService.On("GetConfigForUrl", "github.com").Return(githubConfig, nil)
+	gitProviderService.On("GetConfigForUrl", "gitlab.com").Return(gitlabConfig, nil)
+
+	workspaceStore := t_workspaces.NewInMemoryWorkspaceStore()
+	err := workspaceStore.Save(&workspace.Workspace{
+		Id:   "ws1",
+		Name: "ws1",
+		Projects: []*project.Project{
+			{
+				Name:        "p1",
+				WorkspaceId: "ws1",
+				Repository:  &gitprovider.GitRepository{Url: "https://github.com/daytonaio/daytona"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	server.GetInstance(&server.ServerInstanceConfig{
+		ApiKeyService: apiKeyService,
+		AuditLogService: auditlog.NewAuditLogService(auditlog.AuditLogServiceConfig{
+			AuditStore: t_auditlog.NewInMemoryAuditStore(),
+		}),
+		GitProviderService: gitProviderService,
+		WorkspaceService: workspaces.NewWorkspaceService(workspaces.WorkspaceServiceConfig{
+			WorkspaceStore:     workspaceStore,
+			GitProviderService: gitProviderService,
+		}),
+	})
+
+	readOnlyKey, err := apiKeyService.GenerateWithRole(apikey.ApiKeyTypeClient, "read-only", apikey.ApiKeyRoleReadOnly, nil)
+	require.NoError(t, err)
+	developerKey, err := apiKeyService.GenerateWithRole(apikey.ApiKeyTypeClient, "developer", apikey.ApiKeyRoleDeveloper, nil)
+	require.NoError(t, err)
+	projectKey, err := apiKeyService.Generate(apikey.ApiKeyTypeProject, "ws1/p1")
+	require.NoError(t, err)
+	otherProjectKey, err := apiKeyService.Generate(apikey.ApiKeyTypeProject, "ws1/p2")
+	require.NoError(t, err)
+	workspaceKey, err := apiKeyService.Generate(apikey.ApiKeyTypeWorkspace, "ws1")
+	require.NoError(t, err)
+
+	gin.SetMode(gin.TestMode)
+	apiServer := &ApiServer{router: gin.New()}
+	apiServer.setupRoutes()
+
+	tests := []struct {
+		name     string
+		apiKey   string
+		method   string
+		path     string
+		expected int
+	}{
+		{"missing key", "", http.MethodGet, "/profile/", http.StatusUnauthorized},
+		{"read-only key reads the profile", readOnlyKey, http.MethodGet, "/profile/", http.StatusForbidden},
+		{"read-only key lists API keys", readOnlyKey, http.MethodGet, "/apikey/", http.StatusForbidden},
+		{"read-only key reads the server config", readOnlyKey, http.MethodGet, "/server/config", http.StatusForbidden},
+		{"read-only key lists secrets", readOnlyKey, http.MethodGet, "/secret/", http.StatusForbidden},
+		{"read-only key creates a workspace", readOnlyKey, http.MethodPost, "/workspace/", http.StatusForbidden},
+		{"developer key lists API keys", developerKey, http.MethodGet, "/apikey/", http.StatusForbidden},
+		{"developer key lists git providers", developerKey, http.MethodGet, "/gitprovider/", http.StatusForbidden},
+		{"project key lists workspaces", projectKey, http.MethodGet, "/workspace/", http.StatusForbidden},
+		{"project key reads another workspace", projectKey, http.MethodGet, "/workspace/ws2", http.StatusForbidden},
+		{"project key lists targets", projectKey, http.MethodGet, "/target/", http.StatusForbidden},
+		{"read-only key reads project secrets", readOnlyKey, http.MethodGet, "/workspace/ws1/p1/secrets", http.StatusForbidden},
+		{"workspace key reads project secrets", workspaceKey, http.MethodGet, "/workspace/ws1/p1/secrets", http.StatusForbidden},
+		{"project key reads secrets of another project", otherProjectKey, http.MethodGet, "/workspace/ws1/p1/secrets", http.StatusForbidden},
+		{"workspace key reads the pending patch", workspaceKey, http.MethodGet, "/workspace/ws1/p1/patch/pending", http.StatusForbidden},
+		{"project key reads the pending patch of another project", otherProjectKey, http.MethodGet, "/workspace/ws1/p1/patch/pending", http.StatusForbidden},
+		{"project key clears the pending patch of another project", otherProjectKey, http.MethodDelete, "/workspace/ws1/p1/patch/pending", http.StatusForbidden},
+		{"project key reports the state of another project", otherProjectKey, http.MethodPost, "/workspace/ws1/p1/state", http.StatusForbidden},
+		{"project key reports the state of another workspace", projectKey, http.MethodPost, "/workspace/ws2/p1/state", http.StatusForbidden},
+		{"project key gets the git provider of its repository", projectKey, http.MethodGet, "/gitprovider/for-url/github.com", http.StatusOK},
+		{"project key gets another git provider", projectKey, http.MethodGet, "/gitprovider/for-url/gitlab.com", http.StatusForbidden},
+		{"workspace key gets the git provider of its repositories", workspaceKey, http.MethodGet, "/gitprovider/for-url/github.com", http.StatusOK},
+		{"workspace key gets another git provider", workspaceKey, http.MethodGet, "/gitprovider/for-url/gitlab.com", http.StatusForbidden},
+		{"project key of another project gets the git provider", otherProjectKey, http.MethodGet, "/gitprovider/for-url/github.com", http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.apiKey != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.apiKey)
+			}
+
+			recorder := httptest.NewRecorder()
+			apiServer.router.ServeHTTP(recorder, req)
+
+			require.Equal(t, tt.expected, recorder.Code)
+		})
+	}
+}
@@ -26,6 +26,20 @@ type ApiGenerateApiKeyRequest struct {
 	ctx        context.Context
 	ApiService *ApiKeyAPIService
 	apiKeyName string
+	role       *string
+	expiresAt  *string
+}
+
+// API key role (admin, developer or read-only). Defaults to admin
+func (r ApiGenerateApiKeyRequest) Role(role string) ApiGenerateApiKeyRequest {
+	r.role = &role
+	return r
+}
+
+// RFC 3339 timestamp after which the key is rejected. The key never expires if omitted
+func (r ApiGenerateApiKeyRequest) ExpiresAt(expiresAt string) ApiGenerateApiKeyRequest {
+	r.expiresAt = &expiresAt
+	return r
 }
 
 func (r ApiGenerateApiKeyRequest) Execute() (string, *http.Response, error) {
@@ -72,6 +86,12 @@ func (a *ApiKeyAPIService) GenerateApiKeyExecute(r ApiGenerateApiKeyRequest) (st
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
+	if r.role != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "role", r.role, "")
+	}
+	if r.expiresAt != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "expiresAt", r.expiresAt, "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -359,3 +379,141 @@ func (a *ApiKeyAPIService) RevokeApiKeyExecute(r ApiRevokeApiKeyRequest) (*http.
 
 	return localVarHTTPResponse, nil
 }
+
+type ApiRotateApiKeyRequest struct {
+	ctx         context.Context
+	ApiService  *ApiKeyAPIService
+	apiKeyName  string
+	gracePeriod *string
+	expiresAt   *string
+}
+
+// Duration for which the replaced key stays valid, e.g. 1h. Defaults to 24h, 0 revokes the key immediately
+func (r ApiRotateApiKeyRequest) GracePeriod(gracePeriod string) ApiRotateApiKeyRequest {
+	r.gracePeriod = &gracePeriod
+	return r
+}
+
+// RFC 3339 timestamp after which the new key is rejected. The key never expires if omitted
+func (r ApiRotateApiKeyRequest) ExpiresAt(expiresAt string) ApiRotateApiKeyRequest {
+	r.expiresAt = &expiresAt
+	return r
+}
+
+func (r ApiRotateApiKeyRequest) Execute() (string, *http.Response, error) {
+	return r.ApiService.RotateApiKeyExecute(r)
+}
+
+/*
+RotateApiKey Rotate an API key
+
+Generate a replacement for an API key. The replaced key is renamed and stays valid for the grace period
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param apiKeyName API key name
+	@return ApiRotateApiKeyRequest
+*/
+func (a *ApiKeyAPIService) RotateApiKey(ctx context.Context, apiKeyName string) ApiRotateApiKeyRequest {
+	return ApiRotateApiKeyRequest{
+		ApiService: a,
+		ctx:        ctx,
+		apiKeyName: apiKeyName,
+	}
+}
+
+// Execute executes the request
+//
+//	@return string
+func (a *ApiKeyAPIService) RotateApiKeyExecute(r ApiRotateApiKeyRequest) (string, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue string
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApiKeyAPIService.RotateApiKey")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/apikey/{apiKeyName}/rotate"
+	localVarPath = strings.Replace(localVarPath, "{"+"apiKeyName"+"}", url.PathEscape(parameterValueToString(r.apiKeyName, "apiKeyName")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.gracePeriod != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "gracePeriod", r.gracePeriod, "")
+	}
+	if r.expiresAt != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "expiresAt", r.expiresAt, "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"text/plain"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.ctx != nil {
+		// API Key Authentication
+		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
+			if apiKey, ok := auth["Bearer"]; ok {
+				var key string
+				if apiKey.Prefix != "" {
+					key = apiKey.Prefix + " " + apiKey.Key
+				} else {
+					key = apiKey.Key
+				}
+				localVarHeaderParams["Authorization"] = key
+			}
+		}
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
@@ -50,10 +50,14 @@ type APIClient struct {
 
 	ApiKeyAPI *ApiKeyAPIService
 
+	AuditAPI *AuditAPIService
+
 	BuildAPI *BuildAPIService
 
 	ContainerRegistryAPI *ContainerRegistryAPIService
 
+	EventsAPI *EventsAPIService
+
 	GitProviderAPI *GitProviderAPIService
 
 	PrebuildAPI *PrebuildAPIService
@@ -66,10 +70,14 @@ type APIClient struct {
 
 	SampleAPI *SampleAPIService
 
+	SecretAPI *SecretAPIService
+
 	ServerAPI *ServerAPIService
 
 	TargetAPI *TargetAPIService
 
+	WebhookAPI *WebhookAPIService
+
 	WorkspaceAPI *WorkspaceAPIService
 }
 
@@ -90,16 +98,20 @@ func NewAPIClient(cfg *Configuration) *APIClient {
 
 	// API Services
 	c.ApiKeyAPI = (*ApiKeyAPIService)(&c.common)
+	c.AuditAPI = (*AuditAPIService)(&c.common)
 	c.BuildAPI = (*BuildAPIService)(&c.common)
 	c.ContainerRegistryAPI = (*ContainerRegistryAPIService)(&c.common)
+	c.EventsAPI = (*EventsAPIService)(&c.common)
 	c.GitProviderAPI = (*GitProviderAPIService)(&c.common)
 	c.PrebuildAPI = (*PrebuildAPIService)(&c.common)
 	c.ProfileAPI = (*ProfileAPIService)(&c.common)
 	c.ProjectConfigAPI = (*ProjectConfigAPIService)(&c.common)
 	c.ProviderAPI = (*ProviderAPIService)(&c.common)
 	c.SampleAPI = (*SampleAPIService)(&c.common)
+	c.SecretAPI = (*SecretAPIService)(&c.common)
 	c.ServerAPI = (*ServerAPIService)(&c.common)
 	c.TargetAPI = (*TargetAPIService)(&c.common)
+	c.WebhookAPI = (*WebhookAPIService)(&c.common)
 	c.WorkspaceAPI = (*WorkspaceAPIService)(&c.common)
 
 	return c
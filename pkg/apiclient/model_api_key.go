@@ -21,9 +21,13 @@ var _ MappedNullable = &ApiKey{}
 
 // ApiKey struct for ApiKey
 type ApiKey struct {
-	KeyHash string `json:"keyHash"`
+	// The key is rejected after this time. Keys without an expiry never expire
+	ExpiresAt  *string `json:"expiresAt,omitempty"`
+	KeyHash    string  `json:"keyHash"`
+	LastUsedAt *string `json:"lastUsedAt,omitempty"`
 	// Project or client name
 	Name string           `json:"name"`
+	Role ApikeyApiKeyRole `json:"role"`
 	Type ApikeyApiKeyType `json:"type"`
 }
 
@@ -33,10 +37,11 @@ type _ApiKey ApiKey
 // This constructor will assign default values to properties that have it defined,
 // and makes sure properties required by API are set, but the set of arguments
 // will change when the set of required properties is changed
-func NewApiKey(keyHash string, name string, type_ ApikeyApiKeyType) *ApiKey {
+func NewApiKey(keyHash string, name string, role ApikeyApiKeyRole, type_ ApikeyApiKeyType) *ApiKey {
 	this := ApiKey{}
 	this.KeyHash = keyHash
 	this.Name = name
+	this.Role = role
 	this.Type = type_
 	return &this
 }
@@ -49,6 +54,38 @@ func NewApiKeyWithDefaults() *ApiKey {
 	return &this
 }
 
+// GetExpiresAt returns the ExpiresAt field value if set, zero value otherwise.
+func (o *ApiKey) GetExpiresAt() string {
+	if o == nil || IsNil(o.ExpiresAt) {
+		var ret string
+		return ret
+	}
+	return *o.ExpiresAt
+}
+
+// GetExpiresAtOk returns a tuple with the ExpiresAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ApiKey) GetExpiresAtOk() (*string, bool) {
+	if o == nil || IsNil(o.ExpiresAt) {
+		return nil, false
+	}
+	return o.ExpiresAt, true
+}
+
+// HasExpiresAt returns a boolean if a field has been set.
+func (o *ApiKey) HasExpiresAt() bool {
+	if o != nil && !IsNil(o.ExpiresAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetExpiresAt gets a reference to the given string and assigns it to the ExpiresAt field.
+func (o *ApiKey) SetExpiresAt(v string) {
+	o.ExpiresAt = &v
+}
+
 // GetKeyHash returns the KeyHash field value
 func (o *ApiKey) GetKeyHash() string {
 	if o == nil {
@@ -73,6 +110,38 @@ func (o *ApiKey) SetKeyHash(v string) {
 	o.KeyHash = v
 }
 
+// GetLastUsedAt returns the LastUsedAt field value if set, zero value otherwise.
+func (o *ApiKey) GetLastUsedAt() string {
+	if o == nil || IsNil(o.LastUsedAt) {
+		var ret string
+		return ret
+	}
+	return *o.LastUsedAt
+}
+
+// GetLastUsedAtOk returns a tuple with the LastUsedAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ApiKey) GetLastUsedAtOk() (*string, bool) {
+	if o == nil || IsNil(o.LastUsedAt) {
+		return nil, false
+	}
+	return o.LastUsedAt, true
+}
+
+// HasLastUsedAt returns a boolean if a field has been set.
+func (o *ApiKey) HasLastUsedAt() bool {
+	if o != nil && !IsNil(o.LastUsedAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetLastUsedAt gets a reference to the given string and assigns it to the LastUsedAt field.
+func (o *ApiKey) SetLastUsedAt(v string) {
+	o.LastUsedAt = &v
+}
+
 // GetName returns the Name field value
 func (o *ApiKey) GetName() string {
 	if o == nil {
@@ -97,6 +166,30 @@ func (o *ApiKey) SetName(v string) {
 	o.Name = v
 }
 
+// GetRole returns the Role field value
+func (o *ApiKey) GetRole() ApikeyApiKeyRole {
+	if o == nil {
+		var ret ApikeyApiKeyRole
+		return ret
+	}
+
+	return o.Role
+}
+
+// GetRoleOk returns a tuple with the Role field value
+// and a boolean to check if the value has been set.
+func (o *ApiKey) GetRoleOk() (*ApikeyApiKeyRole, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return &o.Role, true
+}
+
+// SetRole sets field value
+func (o *ApiKey) SetRole(v ApikeyApiKeyRole) {
+	o.Role = v
+}
+
 // GetType returns the Type field value
 func (o *ApiKey) GetType() ApikeyApiKeyType {
 	if o == nil {
@@ -131,8 +224,15 @@ func (o ApiKey) MarshalJSON() ([]byte, error) {
 
 func (o ApiKey) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.ExpiresAt) {
+		toSerialize["expiresAt"] = o.ExpiresAt
+	}
 	toSerialize["keyHash"] = o.KeyHash
+	if !IsNil(o.LastUsedAt) {
+		toSerialize["lastUsedAt"] = o.LastUsedAt
+	}
 	toSerialize["name"] = o.Name
+	toSerialize["role"] = o.Role
 	toSerialize["type"] = o.Type
 	return toSerialize, nil
 }
@@ -144,6 +244,7 @@ func (o *ApiKey) UnmarshalJSON(data []byte) (err error) {
 	requiredProperties := []string{
 		"keyHash",
 		"name",
+		"role",
 		"type",
 	}
 
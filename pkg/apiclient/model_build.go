@@ -21,16 +21,21 @@ var _ MappedNullable = &Build{}
 
 // Build struct for Build
 type Build struct {
-	BuildConfig *BuildConfig      `json:"buildConfig,omitempty"`
-	CreatedAt   string            `json:"createdAt"`
-	EnvVars     map[string]string `json:"envVars"`
-	Id          string            `json:"id"`
-	Image       string            `json:"image"`
-	PrebuildId  string            `json:"prebuildId"`
-	Repository  GitRepository     `json:"repository"`
-	State       BuildBuildState   `json:"state"`
-	UpdatedAt   string            `json:"updatedAt"`
-	User        string            `json:"user"`
+	BuildConfig   *BuildConfig      `json:"buildConfig,omitempty"`
+	CreatedAt     string            `json:"createdAt"`
+	EnvVars       map[string]string `json:"envVars"`
+	Id            string            `json:"id"`
+	Image         string            `json:"image"`
+	MaxRetries    *int32            `json:"maxRetries,omitempty"`
+	NextRetryAt   *string           `json:"nextRetryAt,omitempty"`
+	PrebuildId    string            `json:"prebuildId"`
+	Priority      int32             `json:"priority"`
+	QueuePosition *int32            `json:"queuePosition,omitempty"`
+	Repository    GitRepository     `json:"repository"`
+	RetryCount    int32             `json:"retryCount"`
+	State         BuildBuildState   `json:"state"`
+	UpdatedAt     string            `json:"updatedAt"`
+	User          string            `json:"user"`
 }
 
 type _Build Build
@@ -39,14 +44,16 @@ type _Build Build
 // This constructor will assign default values to properties that have it defined,
 // and makes sure properties required by API are set, but the set of arguments
 // will change when the set of required properties is changed
-func NewBuild(createdAt string, envVars map[string]string, id string, image string, prebuildId string, repository GitRepository, state BuildBuildState, updatedAt string, user string) *Build {
+func NewBuild(createdAt string, envVars map[string]string, id string, image string, prebuildId string, priority int32, repository GitRepository, retryCount int32, state BuildBuildState, updatedAt string, user string) *Build {
 	this := Build{}
 	this.CreatedAt = createdAt
 	this.EnvVars = envVars
 	this.Id = id
 	this.Image = image
 	this.PrebuildId = prebuildId
+	this.Priority = priority
 	this.Repository = repository
+	this.RetryCount = retryCount
 	this.State = state
 	this.UpdatedAt = updatedAt
 	this.User = user
@@ -189,6 +196,70 @@ func (o *Build) SetImage(v string) {
 	o.Image = v
 }
 
+// GetMaxRetries returns the MaxRetries field value if set, zero value otherwise.
+func (o *Build) GetMaxRetries() int32 {
+	if o == nil || IsNil(o.MaxRetries) {
+		var ret int32
+		return ret
+	}
+	return *o.MaxRetries
+}
+
+// GetMaxRetriesOk returns a tuple with the MaxRetries field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Build) GetMaxRetriesOk() (*int32, bool) {
+	if o == nil || IsNil(o.MaxRetries) {
+		return nil, false
+	}
+	return o.MaxRetries, true
+}
+
+// HasMaxRetries returns a boolean if a field has been set.
+func (o *Build) HasMaxRetries() bool {
+	if o != nil && !IsNil(o.MaxRetries) {
+		return true
+	}
+
+	return false
+}
+
+// SetMaxRetries gets a reference to the given int32 and assigns it to the MaxRetries field.
+func (o *Build) SetMaxRetries(v int32) {
+	o.MaxRetries = &v
+}
+
+// GetNextRetryAt returns the NextRetryAt field value if set, zero value otherwise.
+func (o *Build) GetNextRetryAt() string {
+	if o == nil || IsNil(o.NextRetryAt) {
+		var ret string
+		return ret
+	}
+	return *o.NextRetryAt
+}
+
+// GetNextRetryAtOk returns a tuple with the NextRetryAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Build) GetNextRetryAtOk() (*string, bool) {
+	if o == nil || IsNil(o.NextRetryAt) {
+		return nil, false
+	}
+	return o.NextRetryAt, true
+}
+
+// HasNextRetryAt returns a boolean if a field has been set.
+func (o *Build) HasNextRetryAt() bool {
+	if o != nil && !IsNil(o.NextRetryAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetNextRetryAt gets a reference to the given string and assigns it to the NextRetryAt field.
+func (o *Build) SetNextRetryAt(v string) {
+	o.NextRetryAt = &v
+}
+
 // GetPrebuildId returns the PrebuildId field value
 func (o *Build) GetPrebuildId() string {
 	if o == nil {
@@ -213,6 +284,62 @@ func (o *Build) SetPrebuildId(v string) {
 	o.PrebuildId = v
 }
 
+// GetPriority returns the Priority field value
+func (o *Build) GetPriority() int32 {
+	if o == nil {
+		var ret int32
+		return ret
+	}
+
+	return o.Priority
+}
+
+// GetPriorityOk returns a tuple with the Priority field value
+// and a boolean to check if the value has been set.
+func (o *Build) GetPriorityOk() (*int32, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return &o.Priority, true
+}
+
+// SetPriority sets field value
+func (o *Build) SetPriority(v int32) {
+	o.Priority = v
+}
+
+// GetQueuePosition returns the QueuePosition field value if set, zero value otherwise.
+func (o *Build) GetQueuePosition() int32 {
+	if o == nil || IsNil(o.QueuePosition) {
+		var ret int32
+		return ret
+	}
+	return *o.QueuePosition
+}
+
+// GetQueuePositionOk returns a tuple with the QueuePosition field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Build) GetQueuePositionOk() (*int32, bool) {
+	if o == nil || IsNil(o.QueuePosition) {
+		return nil, false
+	}
+	return o.QueuePosition, true
+}
+
+// HasQueuePosition returns a boolean if a field has been set.
+func (o *Build) HasQueuePosition() bool {
+	if o != nil && !IsNil(o.QueuePosition) {
+		return true
+	}
+
+	return false
+}
+
+// SetQueuePosition gets a reference to the given int32 and assigns it to the QueuePosition field.
+func (o *Build) SetQueuePosition(v int32) {
+	o.QueuePosition = &v
+}
+
 // GetRepository returns the Repository field value
 func (o *Build) GetRepository() GitRepository {
 	if o == nil {
@@ -237,6 +364,30 @@ func (o *Build) SetRepository(v GitRepository) {
 	o.Repository = v
 }
 
+// GetRetryCount returns the RetryCount field value
+func (o *Build) GetRetryCount() int32 {
+	if o == nil {
+		var ret int32
+		return ret
+	}
+
+	return o.RetryCount
+}
+
+// GetRetryCountOk returns a tuple with the RetryCount field value
+// and a boolean to check if the value has been set.
+func (o *Build) GetRetryCountOk() (*int32, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return &o.RetryCount, true
+}
+
+// SetRetryCount sets field value
+func (o *Build) SetRetryCount(v int32) {
+	o.RetryCount = v
+}
+
 // GetState returns the State field value
 func (o *Build) GetState() BuildBuildState {
 	if o == nil {
@@ -326,8 +477,19 @@ func (o Build) ToMap() (map[string]interface{}, error) {
 	toSerialize["envVars"] = o.EnvVars
 	toSerialize["id"] = o.Id
 	toSerialize["image"] = o.Image
+	if !IsNil(o.MaxRetries) {
+		toSerialize["maxRetries"] = o.MaxRetries
+	}
+	if !IsNil(o.NextRetryAt) {
+		toSerialize["nextRetryAt"] = o.NextRetryAt
+	}
 	toSerialize["prebuildId"] = o.PrebuildId
+	toSerialize["priority"] = o.Priority
+	if !IsNil(o.QueuePosition) {
+		toSerialize["queuePosition"] = o.QueuePosition
+	}
 	toSerialize["repository"] = o.Repository
+	toSerialize["retryCount"] = o.RetryCount
 	toSerialize["state"] = o.State
 	toSerialize["updatedAt"] = o.UpdatedAt
 	toSerialize["user"] = o.User
@@ -344,7 +506,9 @@ func (o *Build) UnmarshalJSON(data []byte) (err error) {
 		"id",
 		"image",
 		"prebuildId",
+		"priority",
 		"repository",
+		"retryCount",
 		"state",
 		"updatedAt",
 		"user",
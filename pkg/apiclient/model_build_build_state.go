@@ -27,6 +27,7 @@ const (
 	BuildStatePublished     BuildBuildState = "published"
 	BuildStatePendingDelete BuildBuildState = "pending-delete"
 	BuildStateDeleting      BuildBuildState = "deleting"
+	BuildStateCancelled     BuildBuildState = "cancelled"
 )
 
 // All allowed values of BuildBuildState enum
@@ -38,6 +39,7 @@ var AllowedBuildBuildStateEnumValues = []BuildBuildState{
 	"published",
 	"pending-delete",
 	"deleting",
+	"cancelled",
 }
 
 func (v *BuildBuildState) UnmarshalJSON(src []byte) error {
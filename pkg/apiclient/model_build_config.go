@@ -21,6 +21,7 @@ var _ MappedNullable = &BuildConfig{}
 type BuildConfig struct {
 	CachedBuild  *CachedBuild        `json:"cachedBuild,omitempty"`
 	Devcontainer *DevcontainerConfig `json:"devcontainer,omitempty"`
+	Dockerfile   *DockerfileConfig   `json:"dockerfile,omitempty"`
 }
 
 // NewBuildConfig instantiates a new BuildConfig object
@@ -104,6 +105,38 @@ func (o *BuildConfig) SetDevcontainer(v DevcontainerConfig) {
 	o.Devcontainer = &v
 }
 
+// GetDockerfile returns the Dockerfile field value if set, zero value otherwise.
+func (o *BuildConfig) GetDockerfile() DockerfileConfig {
+	if o == nil || IsNil(o.Dockerfile) {
+		var ret DockerfileConfig
+		return ret
+	}
+	return *o.Dockerfile
+}
+
+// GetDockerfileOk returns a tuple with the Dockerfile field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *BuildConfig) GetDockerfileOk() (*DockerfileConfig, bool) {
+	if o == nil || IsNil(o.Dockerfile) {
+		return nil, false
+	}
+	return o.Dockerfile, true
+}
+
+// HasDockerfile returns a boolean if a field has been set.
+func (o *BuildConfig) HasDockerfile() bool {
+	if o != nil && !IsNil(o.Dockerfile) {
+		return true
+	}
+
+	return false
+}
+
+// SetDockerfile gets a reference to the given DockerfileConfig and assigns it to the Dockerfile field.
+func (o *BuildConfig) SetDockerfile(v DockerfileConfig) {
+	o.Dockerfile = &v
+}
+
 func (o BuildConfig) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -120,6 +153,9 @@ func (o BuildConfig) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Devcontainer) {
 		toSerialize["devcontainer"] = o.Devcontainer
 	}
+	if !IsNil(o.Dockerfile) {
+		toSerialize["dockerfile"] = o.Dockerfile
+	}
 	return toSerialize, nil
 }
 
@@ -24,6 +24,7 @@ type CreatePrebuildDTO struct {
 	Branch         *string  `json:"branch,omitempty"`
 	CommitInterval *int32   `json:"commitInterval,omitempty"`
 	Id             *string  `json:"id,omitempty"`
+	MaxRetries     *int32   `json:"maxRetries,omitempty"`
 	Retention      int32    `json:"retention"`
 	TriggerFiles   []string `json:"triggerFiles,omitempty"`
 }
@@ -144,6 +145,38 @@ func (o *CreatePrebuildDTO) SetId(v string) {
 	o.Id = &v
 }
 
+// GetMaxRetries returns the MaxRetries field value if set, zero value otherwise.
+func (o *CreatePrebuildDTO) GetMaxRetries() int32 {
+	if o == nil || IsNil(o.MaxRetries) {
+		var ret int32
+		return ret
+	}
+	return *o.MaxRetries
+}
+
+// GetMaxRetriesOk returns a tuple with the MaxRetries field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreatePrebuildDTO) GetMaxRetriesOk() (*int32, bool) {
+	if o == nil || IsNil(o.MaxRetries) {
+		return nil, false
+	}
+	return o.MaxRetries, true
+}
+
+// HasMaxRetries returns a boolean if a field has been set.
+func (o *CreatePrebuildDTO) HasMaxRetries() bool {
+	if o != nil && !IsNil(o.MaxRetries) {
+		return true
+	}
+
+	return false
+}
+
+// SetMaxRetries gets a reference to the given int32 and assigns it to the MaxRetries field.
+func (o *CreatePrebuildDTO) SetMaxRetries(v int32) {
+	o.MaxRetries = &v
+}
+
 // GetRetention returns the Retention field value
 func (o *CreatePrebuildDTO) GetRetention() int32 {
 	if o == nil {
@@ -219,6 +252,9 @@ func (o CreatePrebuildDTO) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Id) {
 		toSerialize["id"] = o.Id
 	}
+	if !IsNil(o.MaxRetries) {
+		toSerialize["maxRetries"] = o.MaxRetries
+	}
 	toSerialize["retention"] = o.Retention
 	if !IsNil(o.TriggerFiles) {
 		toSerialize["triggerFiles"] = o.TriggerFiles
@@ -26,6 +26,8 @@ type CreateProjectConfigDTO struct {
 	Image         *string           `json:"image,omitempty"`
 	Name          string            `json:"name"`
 	RepositoryUrl string            `json:"repositoryUrl"`
+	Resources     *ResourceLimits   `json:"resources,omitempty"`
+	Secrets       []string          `json:"secrets,omitempty"`
 	User          *string           `json:"user,omitempty"`
 }
 
@@ -187,6 +189,70 @@ func (o *CreateProjectConfigDTO) SetRepositoryUrl(v string) {
 	o.RepositoryUrl = v
 }
 
+// GetResources returns the Resources field value if set, zero value otherwise.
+func (o *CreateProjectConfigDTO) GetResources() ResourceLimits {
+	if o == nil || IsNil(o.Resources) {
+		var ret ResourceLimits
+		return ret
+	}
+	return *o.Resources
+}
+
+// GetResourcesOk returns a tuple with the Resources field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateProjectConfigDTO) GetResourcesOk() (*ResourceLimits, bool) {
+	if o == nil || IsNil(o.Resources) {
+		return nil, false
+	}
+	return o.Resources, true
+}
+
+// HasResources returns a boolean if a field has been set.
+func (o *CreateProjectConfigDTO) HasResources() bool {
+	if o != nil && !IsNil(o.Resources) {
+		return true
+	}
+
+	return false
+}
+
+// SetResources gets a reference to the given ResourceLimits and assigns it to the Resources field.
+func (o *CreateProjectConfigDTO) SetResources(v ResourceLimits) {
+	o.Resources = &v
+}
+
+// GetSecrets returns the Secrets field value if set, zero value otherwise.
+func (o *CreateProjectConfigDTO) GetSecrets() []string {
+	if o == nil || IsNil(o.Secrets) {
+		var ret []string
+		return ret
+	}
+	return o.Secrets
+}
+
+// GetSecretsOk returns a tuple with the Secrets field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateProjectConfigDTO) GetSecretsOk() ([]string, bool) {
+	if o == nil || IsNil(o.Secrets) {
+		return nil, false
+	}
+	return o.Secrets, true
+}
+
+// HasSecrets returns a boolean if a field has been set.
+func (o *CreateProjectConfigDTO) HasSecrets() bool {
+	if o != nil && !IsNil(o.Secrets) {
+		return true
+	}
+
+	return false
+}
+
+// SetSecrets gets a reference to the given []string and assigns it to the Secrets field.
+func (o *CreateProjectConfigDTO) SetSecrets(v []string) {
+	o.Secrets = v
+}
+
 // GetUser returns the User field value if set, zero value otherwise.
 func (o *CreateProjectConfigDTO) GetUser() string {
 	if o == nil || IsNil(o.User) {
@@ -238,6 +304,12 @@ func (o CreateProjectConfigDTO) ToMap() (map[string]interface{}, error) {
 	}
 	toSerialize["name"] = o.Name
 	toSerialize["repositoryUrl"] = o.RepositoryUrl
+	if !IsNil(o.Resources) {
+		toSerialize["resources"] = o.Resources
+	}
+	if !IsNil(o.Secrets) {
+		toSerialize["secrets"] = o.Secrets
+	}
 	if !IsNil(o.User) {
 		toSerialize["user"] = o.User
 	}
@@ -21,12 +21,16 @@ var _ MappedNullable = &CreateProjectDTO{}
 
 // CreateProjectDTO struct for CreateProjectDTO
 type CreateProjectDTO struct {
-	BuildConfig *BuildConfig           `json:"buildConfig,omitempty"`
-	EnvVars     map[string]string      `json:"envVars"`
-	Image       *string                `json:"image,omitempty"`
-	Name        string                 `json:"name"`
-	Source      CreateProjectSourceDTO `json:"source"`
-	User        *string                `json:"user,omitempty"`
+	BuildConfig *BuildConfig      `json:"buildConfig,omitempty"`
+	EnvVars     map[string]string `json:"envVars"`
+	Image       *string           `json:"image,omitempty"`
+	Name        string            `json:"name"`
+	// Uncommitted changes applied to the repository once it is cloned
+	Patch     *string                `json:"patch,omitempty"`
+	Resources *ResourceLimits        `json:"resources,omitempty"`
+	Secrets   []string               `json:"secrets,omitempty"`
+	Source    CreateProjectSourceDTO `json:"source"`
+	User      *string                `json:"user,omitempty"`
 }
 
 type _CreateProjectDTO CreateProjectDTO
@@ -163,6 +167,102 @@ func (o *CreateProjectDTO) SetName(v string) {
 	o.Name = v
 }
 
+// GetPatch returns the Patch field value if set, zero value otherwise.
+func (o *CreateProjectDTO) GetPatch() string {
+	if o == nil || IsNil(o.Patch) {
+		var ret string
+		return ret
+	}
+	return *o.Patch
+}
+
+// GetPatchOk returns a tuple with the Patch field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateProjectDTO) GetPatchOk() (*string, bool) {
+	if o == nil || IsNil(o.Patch) {
+		return nil, false
+	}
+	return o.Patch, true
+}
+
+// HasPatch returns a boolean if a field has been set.
+func (o *CreateProjectDTO) HasPatch() bool {
+	if o != nil && !IsNil(o.Patch) {
+		return true
+	}
+
+	return false
+}
+
+// SetPatch gets a reference to the given string and assigns it to the Patch field.
+func (o *CreateProjectDTO) SetPatch(v string) {
+	o.Patch = &v
+}
+
+// GetResources returns the Resources field value if set, zero value otherwise.
+func (o *CreateProjectDTO) GetResources() ResourceLimits {
+	if o == nil || IsNil(o.Resources) {
+		var ret ResourceLimits
+		return ret
+	}
+	return *o.Resources
+}
+
+// GetResourcesOk returns a tuple with the Resources field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateProjectDTO) GetResourcesOk() (*ResourceLimits, bool) {
+	if o == nil || IsNil(o.Resources) {
+		return nil, false
+	}
+	return o.Resources, true
+}
+
+// HasResources returns a boolean if a field has been set.
+func (o *CreateProjectDTO) HasResources() bool {
+	if o != nil && !IsNil(o.Resources) {
+		return true
+	}
+
+	return false
+}
+
+// SetResources gets a reference to the given ResourceLimits and assigns it to the Resources field.
+func (o *CreateProjectDTO) SetResources(v ResourceLimits) {
+	o.Resources = &v
+}
+
+// GetSecrets returns the Secrets field value if set, zero value otherwise.
+func (o *CreateProjectDTO) GetSecrets() []string {
+	if o == nil || IsNil(o.Secrets) {
+		var ret []string
+		return ret
+	}
+	return o.Secrets
+}
+
+// GetSecretsOk returns a tuple with the Secrets field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateProjectDTO) GetSecretsOk() ([]string, bool) {
+	if o == nil || IsNil(o.Secrets) {
+		return nil, false
+	}
+	return o.Secrets, true
+}
+
+// HasSecrets returns a boolean if a field has been set.
+func (o *CreateProjectDTO) HasSecrets() bool {
+	if o != nil && !IsNil(o.Secrets) {
+		return true
+	}
+
+	return false
+}
+
+// SetSecrets gets a reference to the given []string and assigns it to the Secrets field.
+func (o *CreateProjectDTO) SetSecrets(v []string) {
+	o.Secrets = v
+}
+
 // GetSource returns the Source field value
 func (o *CreateProjectDTO) GetSource() CreateProjectSourceDTO {
 	if o == nil {
@@ -237,6 +337,15 @@ func (o CreateProjectDTO) ToMap() (map[string]interface{}, error) {
 		toSerialize["image"] = o.Image
 	}
 	toSerialize["name"] = o.Name
+	if !IsNil(o.Patch) {
+		toSerialize["patch"] = o.Patch
+	}
+	if !IsNil(o.Resources) {
+		toSerialize["resources"] = o.Resources
+	}
+	if !IsNil(o.Secrets) {
+		toSerialize["secrets"] = o.Secrets
+	}
 	toSerialize["source"] = o.Source
 	if !IsNil(o.User) {
 		toSerialize["user"] = o.User
@@ -21,10 +21,13 @@ var _ MappedNullable = &CreateWorkspaceDTO{}
 
 // CreateWorkspaceDTO struct for CreateWorkspaceDTO
 type CreateWorkspaceDTO struct {
+	AutoStop *int32             `json:"autoStop,omitempty"`
 	Id       string             `json:"id"`
 	Name     string             `json:"name"`
 	Projects []CreateProjectDTO `json:"projects"`
 	Target   string             `json:"target"`
+	// Minutes after which the workspace is removed
+	Ttl *int32 `json:"ttl,omitempty"`
 }
 
 type _CreateWorkspaceDTO CreateWorkspaceDTO
@@ -50,6 +53,38 @@ func NewCreateWorkspaceDTOWithDefaults() *CreateWorkspaceDTO {
 	return &this
 }
 
+// GetAutoStop returns the AutoStop field value if set, zero value otherwise.
+func (o *CreateWorkspaceDTO) GetAutoStop() int32 {
+	if o == nil || IsNil(o.AutoStop) {
+		var ret int32
+		return ret
+	}
+	return *o.AutoStop
+}
+
+// GetAutoStopOk returns a tuple with the AutoStop field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspaceDTO) GetAutoStopOk() (*int32, bool) {
+	if o == nil || IsNil(o.AutoStop) {
+		return nil, false
+	}
+	return o.AutoStop, true
+}
+
+// HasAutoStop returns a boolean if a field has been set.
+func (o *CreateWorkspaceDTO) HasAutoStop() bool {
+	if o != nil && !IsNil(o.AutoStop) {
+		return true
+	}
+
+	return false
+}
+
+// SetAutoStop gets a reference to the given int32 and assigns it to the AutoStop field.
+func (o *CreateWorkspaceDTO) SetAutoStop(v int32) {
+	o.AutoStop = &v
+}
+
 // GetId returns the Id field value
 func (o *CreateWorkspaceDTO) GetId() string {
 	if o == nil {
@@ -146,6 +181,38 @@ func (o *CreateWorkspaceDTO) SetTarget(v string) {
 	o.Target = v
 }
 
+// GetTtl returns the Ttl field value if set, zero value otherwise.
+func (o *CreateWorkspaceDTO) GetTtl() int32 {
+	if o == nil || IsNil(o.Ttl) {
+		var ret int32
+		return ret
+	}
+	return *o.Ttl
+}
+
+// GetTtlOk returns a tuple with the Ttl field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspaceDTO) GetTtlOk() (*int32, bool) {
+	if o == nil || IsNil(o.Ttl) {
+		return nil, false
+	}
+	return o.Ttl, true
+}
+
+// HasTtl returns a boolean if a field has been set.
+func (o *CreateWorkspaceDTO) HasTtl() bool {
+	if o != nil && !IsNil(o.Ttl) {
+		return true
+	}
+
+	return false
+}
+
+// SetTtl gets a reference to the given int32 and assigns it to the Ttl field.
+func (o *CreateWorkspaceDTO) SetTtl(v int32) {
+	o.Ttl = &v
+}
+
 func (o CreateWorkspaceDTO) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -156,10 +223,16 @@ func (o CreateWorkspaceDTO) MarshalJSON() ([]byte, error) {
 
 func (o CreateWorkspaceDTO) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.AutoStop) {
+		toSerialize["autoStop"] = o.AutoStop
+	}
 	toSerialize["id"] = o.Id
 	toSerialize["name"] = o.Name
 	toSerialize["projects"] = o.Projects
 	toSerialize["target"] = o.Target
+	if !IsNil(o.Ttl) {
+		toSerialize["ttl"] = o.Ttl
+	}
 	return toSerialize, nil
 }
 
@@ -23,8 +23,13 @@ var _ MappedNullable = &GitProvider{}
 type GitProvider struct {
 	BaseApiUrl *string `json:"baseApiUrl,omitempty"`
 	Id         string  `json:"id"`
-	Token      string  `json:"token"`
-	Username   string  `json:"username"`
+	// Set when the token was obtained through OAuth and can be refreshed
+	RefreshToken   *string `json:"refreshToken,omitempty"`
+	Token          string  `json:"token"`
+	TokenExpiresAt *string `json:"tokenExpiresAt,omitempty"`
+	// Scopes granted to the token, as reported by the provider
+	TokenScopes []string `json:"tokenScopes,omitempty"`
+	Username    string   `json:"username"`
 }
 
 type _GitProvider GitProvider
@@ -105,6 +110,38 @@ func (o *GitProvider) SetId(v string) {
 	o.Id = v
 }
 
+// GetRefreshToken returns the RefreshToken field value if set, zero value otherwise.
+func (o *GitProvider) GetRefreshToken() string {
+	if o == nil || IsNil(o.RefreshToken) {
+		var ret string
+		return ret
+	}
+	return *o.RefreshToken
+}
+
+// GetRefreshTokenOk returns a tuple with the RefreshToken field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *GitProvider) GetRefreshTokenOk() (*string, bool) {
+	if o == nil || IsNil(o.RefreshToken) {
+		return nil, false
+	}
+	return o.RefreshToken, true
+}
+
+// HasRefreshToken returns a boolean if a field has been set.
+func (o *GitProvider) HasRefreshToken() bool {
+	if o != nil && !IsNil(o.RefreshToken) {
+		return true
+	}
+
+	return false
+}
+
+// SetRefreshToken gets a reference to the given string and assigns it to the RefreshToken field.
+func (o *GitProvider) SetRefreshToken(v string) {
+	o.RefreshToken = &v
+}
+
 // GetToken returns the Token field value
 func (o *GitProvider) GetToken() string {
 	if o == nil {
@@ -129,6 +166,70 @@ func (o *GitProvider) SetToken(v string) {
 	o.Token = v
 }
 
+// GetTokenExpiresAt returns the TokenExpiresAt field value if set, zero value otherwise.
+func (o *GitProvider) GetTokenExpiresAt() string {
+	if o == nil || IsNil(o.TokenExpiresAt) {
+		var ret string
+		return ret
+	}
+	return *o.TokenExpiresAt
+}
+
+// GetTokenExpiresAtOk returns a tuple with the TokenExpiresAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *GitProvider) GetTokenExpiresAtOk() (*string, bool) {
+	if o == nil || IsNil(o.TokenExpiresAt) {
+		return nil, false
+	}
+	return o.TokenExpiresAt, true
+}
+
+// HasTokenExpiresAt returns a boolean if a field has been set.
+func (o *GitProvider) HasTokenExpiresAt() bool {
+	if o != nil && !IsNil(o.TokenExpiresAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetTokenExpiresAt gets a reference to the given string and assigns it to the TokenExpiresAt field.
+func (o *GitProvider) SetTokenExpiresAt(v string) {
+	o.TokenExpiresAt = &v
+}
+
+// GetTokenScopes returns the TokenScopes field value if set, zero value otherwise.
+func (o *GitProvider) GetTokenScopes() []string {
+	if o == nil || IsNil(o.TokenScopes) {
+		var ret []string
+		return ret
+	}
+	return o.TokenScopes
+}
+
+// GetTokenScopesOk returns a tuple with the TokenScopes field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *GitProvider) GetTokenScopesOk() ([]string, bool) {
+	if o == nil || IsNil(o.TokenScopes) {
+		return nil, false
+	}
+	return o.TokenScopes, true
+}
+
+// HasTokenScopes returns a boolean if a field has been set.
+func (o *GitProvider) HasTokenScopes() bool {
+	if o != nil && !IsNil(o.TokenScopes) {
+		return true
+	}
+
+	return false
+}
+
+// SetTokenScopes gets a reference to the given []string and assigns it to the TokenScopes field.
+func (o *GitProvider) SetTokenScopes(v []string) {
+	o.TokenScopes = v
+}
+
 // GetUsername returns the Username field value
 func (o *GitProvider) GetUsername() string {
 	if o == nil {
@@ -167,7 +268,16 @@ func (o GitProvider) ToMap() (map[string]interface{}, error) {
 		toSerialize["baseApiUrl"] = o.BaseApiUrl
 	}
 	toSerialize["id"] = o.Id
+	if !IsNil(o.RefreshToken) {
+		toSerialize["refreshToken"] = o.RefreshToken
+	}
 	toSerialize["token"] = o.Token
+	if !IsNil(o.TokenExpiresAt) {
+		toSerialize["tokenExpiresAt"] = o.TokenExpiresAt
+	}
+	if !IsNil(o.TokenScopes) {
+		toSerialize["tokenScopes"] = o.TokenScopes
+	}
 	toSerialize["username"] = o.Username
 	return toSerialize, nil
 }
@@ -24,6 +24,7 @@ type PrebuildConfig struct {
 	Branch         string   `json:"branch"`
 	CommitInterval int32    `json:"commitInterval"`
 	Id             string   `json:"id"`
+	MaxRetries     *int32   `json:"maxRetries,omitempty"`
 	Retention      int32    `json:"retention"`
 	TriggerFiles   []string `json:"triggerFiles"`
 }
@@ -124,6 +125,38 @@ func (o *PrebuildConfig) SetId(v string) {
 	o.Id = v
 }
 
+// GetMaxRetries returns the MaxRetries field value if set, zero value otherwise.
+func (o *PrebuildConfig) GetMaxRetries() int32 {
+	if o == nil || IsNil(o.MaxRetries) {
+		var ret int32
+		return ret
+	}
+	return *o.MaxRetries
+}
+
+// GetMaxRetriesOk returns a tuple with the MaxRetries field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *PrebuildConfig) GetMaxRetriesOk() (*int32, bool) {
+	if o == nil || IsNil(o.MaxRetries) {
+		return nil, false
+	}
+	return o.MaxRetries, true
+}
+
+// HasMaxRetries returns a boolean if a field has been set.
+func (o *PrebuildConfig) HasMaxRetries() bool {
+	if o != nil && !IsNil(o.MaxRetries) {
+		return true
+	}
+
+	return false
+}
+
+// SetMaxRetries gets a reference to the given int32 and assigns it to the MaxRetries field.
+func (o *PrebuildConfig) SetMaxRetries(v int32) {
+	o.MaxRetries = &v
+}
+
 // GetRetention returns the Retention field value
 func (o *PrebuildConfig) GetRetention() int32 {
 	if o == nil {
@@ -185,6 +218,9 @@ func (o PrebuildConfig) ToMap() (map[string]interface{}, error) {
 	toSerialize["branch"] = o.Branch
 	toSerialize["commitInterval"] = o.CommitInterval
 	toSerialize["id"] = o.Id
+	if !IsNil(o.MaxRetries) {
+		toSerialize["maxRetries"] = o.MaxRetries
+	}
 	toSerialize["retention"] = o.Retention
 	toSerialize["triggerFiles"] = o.TriggerFiles
 	return toSerialize, nil
@@ -24,6 +24,7 @@ type PrebuildDTO struct {
 	Branch            string   `json:"branch"`
 	CommitInterval    *int32   `json:"commitInterval,omitempty"`
 	Id                string   `json:"id"`
+	MaxRetries        *int32   `json:"maxRetries,omitempty"`
 	ProjectConfigName string   `json:"projectConfigName"`
 	Retention         int32    `json:"retention"`
 	TriggerFiles      []string `json:"triggerFiles,omitempty"`
@@ -132,6 +133,38 @@ func (o *PrebuildDTO) SetId(v string) {
 	o.Id = v
 }
 
+// GetMaxRetries returns the MaxRetries field value if set, zero value otherwise.
+func (o *PrebuildDTO) GetMaxRetries() int32 {
+	if o == nil || IsNil(o.MaxRetries) {
+		var ret int32
+		return ret
+	}
+	return *o.MaxRetries
+}
+
+// GetMaxRetriesOk returns a tuple with the MaxRetries field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *PrebuildDTO) GetMaxRetriesOk() (*int32, bool) {
+	if o == nil || IsNil(o.MaxRetries) {
+		return nil, false
+	}
+	return o.MaxRetries, true
+}
+
+// HasMaxRetries returns a boolean if a field has been set.
+func (o *PrebuildDTO) HasMaxRetries() bool {
+	if o != nil && !IsNil(o.MaxRetries) {
+		return true
+	}
+
+	return false
+}
+
+// SetMaxRetries gets a reference to the given int32 and assigns it to the MaxRetries field.
+func (o *PrebuildDTO) SetMaxRetries(v int32) {
+	o.MaxRetries = &v
+}
+
 // GetProjectConfigName returns the ProjectConfigName field value
 func (o *PrebuildDTO) GetProjectConfigName() string {
 	if o == nil {
@@ -227,6 +260,9 @@ func (o PrebuildDTO) ToMap() (map[string]interface{}, error) {
 		toSerialize["commitInterval"] = o.CommitInterval
 	}
 	toSerialize["id"] = o.Id
+	if !IsNil(o.MaxRetries) {
+		toSerialize["maxRetries"] = o.MaxRetries
+	}
 	toSerialize["projectConfigName"] = o.ProjectConfigName
 	toSerialize["retention"] = o.Retention
 	if !IsNil(o.TriggerFiles) {
@@ -24,12 +24,16 @@ type Project struct {
 	BuildConfig *BuildConfig      `json:"buildConfig,omitempty"`
 	EnvVars     map[string]string `json:"envVars"`
 	Image       string            `json:"image"`
+	Lifecycle   Lifecycle         `json:"lifecycle"`
 	Name        string            `json:"name"`
 	Repository  GitRepository     `json:"repository"`
-	State       *ProjectState     `json:"state,omitempty"`
-	Target      string            `json:"target"`
-	User        string            `json:"user"`
-	WorkspaceId string            `json:"workspaceId"`
+	Resources   *ResourceLimits   `json:"resources,omitempty"`
+	// Names of the secrets injected into the project as environment variables
+	Secrets     []string      `json:"secrets,omitempty"`
+	State       *ProjectState `json:"state,omitempty"`
+	Target      string        `json:"target"`
+	User        string        `json:"user"`
+	WorkspaceId string        `json:"workspaceId"`
 }
 
 type _Project Project
@@ -38,10 +42,11 @@ type _Project Project
 // This constructor will assign default values to properties that have it defined,
 // and makes sure properties required by API are set, but the set of arguments
 // will change when the set of required properties is changed
-func NewProject(envVars map[string]string, image string, name string, repository GitRepository, target string, user string, workspaceId string) *Project {
+func NewProject(envVars map[string]string, image string, lifecycle Lifecycle, name string, repository GitRepository, target string, user string, workspaceId string) *Project {
 	this := Project{}
 	this.EnvVars = envVars
 	this.Image = image
+	this.Lifecycle = lifecycle
 	this.Name = name
 	this.Repository = repository
 	this.Target = target
@@ -138,6 +143,30 @@ func (o *Project) SetImage(v string) {
 	o.Image = v
 }
 
+// GetLifecycle returns the Lifecycle field value
+func (o *Project) GetLifecycle() Lifecycle {
+	if o == nil {
+		var ret Lifecycle
+		return ret
+	}
+
+	return o.Lifecycle
+}
+
+// GetLifecycleOk returns a tuple with the Lifecycle field value
+// and a boolean to check if the value has been set.
+func (o *Project) GetLifecycleOk() (*Lifecycle, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return &o.Lifecycle, true
+}
+
+// SetLifecycle sets field value
+func (o *Project) SetLifecycle(v Lifecycle) {
+	o.Lifecycle = v
+}
+
 // GetName returns the Name field value
 func (o *Project) GetName() string {
 	if o == nil {
@@ -186,6 +215,70 @@ func (o *Project) SetRepository(v GitRepository) {
 	o.Repository = v
 }
 
+// GetResources returns the Resources field value if set, zero value otherwise.
+func (o *Project) GetResources() ResourceLimits {
+	if o == nil || IsNil(o.Resources) {
+		var ret ResourceLimits
+		return ret
+	}
+	return *o.Resources
+}
+
+// GetResourcesOk returns a tuple with the Resources field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Project) GetResourcesOk() (*ResourceLimits, bool) {
+	if o == nil || IsNil(o.Resources) {
+		return nil, false
+	}
+	return o.Resources, true
+}
+
+// HasResources returns a boolean if a field has been set.
+func (o *Project) HasResources() bool {
+	if o != nil && !IsNil(o.Resources) {
+		return true
+	}
+
+	return false
+}
+
+// SetResources gets a reference to the given ResourceLimits and assigns it to the Resources field.
+func (o *Project) SetResources(v ResourceLimits) {
+	o.Resources = &v
+}
+
+// GetSecrets returns the Secrets field value if set, zero value otherwise.
+func (o *Project) GetSecrets() []string {
+	if o == nil || IsNil(o.Secrets) {
+		var ret []string
+		return ret
+	}
+	return o.Secrets
+}
+
+// GetSecretsOk returns a tuple with the Secrets field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Project) GetSecretsOk() ([]string, bool) {
+	if o == nil || IsNil(o.Secrets) {
+		return nil, false
+	}
+	return o.Secrets, true
+}
+
+// HasSecrets returns a boolean if a field has been set.
+func (o *Project) HasSecrets() bool {
+	if o != nil && !IsNil(o.Secrets) {
+		return true
+	}
+
+	return false
+}
+
+// SetSecrets gets a reference to the given []string and assigns it to the Secrets field.
+func (o *Project) SetSecrets(v []string) {
+	o.Secrets = v
+}
+
 // GetState returns the State field value if set, zero value otherwise.
 func (o *Project) GetState() ProjectState {
 	if o == nil || IsNil(o.State) {
@@ -305,8 +398,15 @@ func (o Project) ToMap() (map[string]interface{}, error) {
 	}
 	toSerialize["envVars"] = o.EnvVars
 	toSerialize["image"] = o.Image
+	toSerialize["lifecycle"] = o.Lifecycle
 	toSerialize["name"] = o.Name
 	toSerialize["repository"] = o.Repository
+	if !IsNil(o.Resources) {
+		toSerialize["resources"] = o.Resources
+	}
+	if !IsNil(o.Secrets) {
+		toSerialize["secrets"] = o.Secrets
+	}
 	if !IsNil(o.State) {
 		toSerialize["state"] = o.State
 	}
@@ -323,6 +423,7 @@ func (o *Project) UnmarshalJSON(data []byte) (err error) {
 	requiredProperties := []string{
 		"envVars",
 		"image",
+		"lifecycle",
 		"name",
 		"repository",
 		"target",
@@ -28,6 +28,8 @@ type ProjectConfig struct {
 	Name          string            `json:"name"`
 	Prebuilds     []PrebuildConfig  `json:"prebuilds,omitempty"`
 	RepositoryUrl string            `json:"repositoryUrl"`
+	Resources     *ResourceLimits   `json:"resources,omitempty"`
+	Secrets       []string          `json:"secrets,omitempty"`
 	User          string            `json:"user"`
 }
 
@@ -240,6 +242,70 @@ func (o *ProjectConfig) SetRepositoryUrl(v string) {
 	o.RepositoryUrl = v
 }
 
+// GetResources returns the Resources field value if set, zero value otherwise.
+func (o *ProjectConfig) GetResources() ResourceLimits {
+	if o == nil || IsNil(o.Resources) {
+		var ret ResourceLimits
+		return ret
+	}
+	return *o.Resources
+}
+
+// GetResourcesOk returns a tuple with the Resources field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectConfig) GetResourcesOk() (*ResourceLimits, bool) {
+	if o == nil || IsNil(o.Resources) {
+		return nil, false
+	}
+	return o.Resources, true
+}
+
+// HasResources returns a boolean if a field has been set.
+func (o *ProjectConfig) HasResources() bool {
+	if o != nil && !IsNil(o.Resources) {
+		return true
+	}
+
+	return false
+}
+
+// SetResources gets a reference to the given ResourceLimits and assigns it to the Resources field.
+func (o *ProjectConfig) SetResources(v ResourceLimits) {
+	o.Resources = &v
+}
+
+// GetSecrets returns the Secrets field value if set, zero value otherwise.
+func (o *ProjectConfig) GetSecrets() []string {
+	if o == nil || IsNil(o.Secrets) {
+		var ret []string
+		return ret
+	}
+	return o.Secrets
+}
+
+// GetSecretsOk returns a tuple with the Secrets field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectConfig) GetSecretsOk() ([]string, bool) {
+	if o == nil || IsNil(o.Secrets) {
+		return nil, false
+	}
+	return o.Secrets, true
+}
+
+// HasSecrets returns a boolean if a field has been set.
+func (o *ProjectConfig) HasSecrets() bool {
+	if o != nil && !IsNil(o.Secrets) {
+		return true
+	}
+
+	return false
+}
+
+// SetSecrets gets a reference to the given []string and assigns it to the Secrets field.
+func (o *ProjectConfig) SetSecrets(v []string) {
+	o.Secrets = v
+}
+
 // GetUser returns the User field value
 func (o *ProjectConfig) GetUser() string {
 	if o == nil {
@@ -285,6 +351,12 @@ func (o ProjectConfig) ToMap() (map[string]interface{}, error) {
 		toSerialize["prebuilds"] = o.Prebuilds
 	}
 	toSerialize["repositoryUrl"] = o.RepositoryUrl
+	if !IsNil(o.Resources) {
+		toSerialize["resources"] = o.Resources
+	}
+	if !IsNil(o.Secrets) {
+		toSerialize["secrets"] = o.Secrets
+	}
 	toSerialize["user"] = o.User
 	return toSerialize, nil
 }
@@ -21,9 +21,11 @@ var _ MappedNullable = &ProjectState{}
 
 // ProjectState struct for ProjectState
 type ProjectState struct {
-	GitStatus GitStatus `json:"gitStatus"`
-	UpdatedAt string    `json:"updatedAt"`
-	Uptime    int32     `json:"uptime"`
+	GitStatus    GitStatus       `json:"gitStatus"`
+	LastActivity *string         `json:"lastActivity,omitempty"`
+	Metrics      *ProjectMetrics `json:"metrics,omitempty"`
+	UpdatedAt    string          `json:"updatedAt"`
+	Uptime       int32           `json:"uptime"`
 }
 
 type _ProjectState ProjectState
@@ -72,6 +74,70 @@ func (o *ProjectState) SetGitStatus(v GitStatus) {
 	o.GitStatus = v
 }
 
+// GetLastActivity returns the LastActivity field value if set, zero value otherwise.
+func (o *ProjectState) GetLastActivity() string {
+	if o == nil || IsNil(o.LastActivity) {
+		var ret string
+		return ret
+	}
+	return *o.LastActivity
+}
+
+// GetLastActivityOk returns a tuple with the LastActivity field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectState) GetLastActivityOk() (*string, bool) {
+	if o == nil || IsNil(o.LastActivity) {
+		return nil, false
+	}
+	return o.LastActivity, true
+}
+
+// HasLastActivity returns a boolean if a field has been set.
+func (o *ProjectState) HasLastActivity() bool {
+	if o != nil && !IsNil(o.LastActivity) {
+		return true
+	}
+
+	return false
+}
+
+// SetLastActivity gets a reference to the given string and assigns it to the LastActivity field.
+func (o *ProjectState) SetLastActivity(v string) {
+	o.LastActivity = &v
+}
+
+// GetMetrics returns the Metrics field value if set, zero value otherwise.
+func (o *ProjectState) GetMetrics() ProjectMetrics {
+	if o == nil || IsNil(o.Metrics) {
+		var ret ProjectMetrics
+		return ret
+	}
+	return *o.Metrics
+}
+
+// GetMetricsOk returns a tuple with the Metrics field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectState) GetMetricsOk() (*ProjectMetrics, bool) {
+	if o == nil || IsNil(o.Metrics) {
+		return nil, false
+	}
+	return o.Metrics, true
+}
+
+// HasMetrics returns a boolean if a field has been set.
+func (o *ProjectState) HasMetrics() bool {
+	if o != nil && !IsNil(o.Metrics) {
+		return true
+	}
+
+	return false
+}
+
+// SetMetrics gets a reference to the given ProjectMetrics and assigns it to the Metrics field.
+func (o *ProjectState) SetMetrics(v ProjectMetrics) {
+	o.Metrics = &v
+}
+
 // GetUpdatedAt returns the UpdatedAt field value
 func (o *ProjectState) GetUpdatedAt() string {
 	if o == nil {
@@ -131,6 +197,12 @@ func (o ProjectState) MarshalJSON() ([]byte, error) {
 func (o ProjectState) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
 	toSerialize["gitStatus"] = o.GitStatus
+	if !IsNil(o.LastActivity) {
+		toSerialize["lastActivity"] = o.LastActivity
+	}
+	if !IsNil(o.Metrics) {
+		toSerialize["metrics"] = o.Metrics
+	}
 	toSerialize["updatedAt"] = o.UpdatedAt
 	toSerialize["uptime"] = o.Uptime
 	return toSerialize, nil
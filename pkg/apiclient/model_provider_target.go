@@ -21,7 +21,9 @@ var _ MappedNullable = &ProviderTarget{}
 
 // ProviderTarget struct for ProviderTarget
 type ProviderTarget struct {
-	Name string `json:"name"`
+	// Minutes of inactivity after which projects on the target are stopped, 0 disables auto-stop
+	AutoStop *int32 `json:"autoStop,omitempty"`
+	Name     string `json:"name"`
 	// JSON encoded map of options
 	Options      string               `json:"options"`
 	ProviderInfo ProviderProviderInfo `json:"providerInfo"`
@@ -49,6 +51,38 @@ func NewProviderTargetWithDefaults() *ProviderTarget {
 	return &this
 }
 
+// GetAutoStop returns the AutoStop field value if set, zero value otherwise.
+func (o *ProviderTarget) GetAutoStop() int32 {
+	if o == nil || IsNil(o.AutoStop) {
+		var ret int32
+		return ret
+	}
+	return *o.AutoStop
+}
+
+// GetAutoStopOk returns a tuple with the AutoStop field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProviderTarget) GetAutoStopOk() (*int32, bool) {
+	if o == nil || IsNil(o.AutoStop) {
+		return nil, false
+	}
+	return o.AutoStop, true
+}
+
+// HasAutoStop returns a boolean if a field has been set.
+func (o *ProviderTarget) HasAutoStop() bool {
+	if o != nil && !IsNil(o.AutoStop) {
+		return true
+	}
+
+	return false
+}
+
+// SetAutoStop gets a reference to the given int32 and assigns it to the AutoStop field.
+func (o *ProviderTarget) SetAutoStop(v int32) {
+	o.AutoStop = &v
+}
+
 // GetName returns the Name field value
 func (o *ProviderTarget) GetName() string {
 	if o == nil {
@@ -131,6 +165,9 @@ func (o ProviderTarget) MarshalJSON() ([]byte, error) {
 
 func (o ProviderTarget) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.AutoStop) {
+		toSerialize["autoStop"] = o.AutoStop
+	}
 	toSerialize["name"] = o.Name
 	toSerialize["options"] = o.Options
 	toSerialize["providerInfo"] = o.ProviderInfo
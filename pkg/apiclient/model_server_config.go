@@ -22,8 +22,10 @@ var _ MappedNullable = &ServerConfig{}
 // ServerConfig struct for ServerConfig
 type ServerConfig struct {
 	ApiPort                   int32       `json:"apiPort"`
+	AuditRetentionDays        int32       `json:"auditRetentionDays"`
 	BinariesPath              string      `json:"binariesPath"`
 	BuildImageNamespace       *string     `json:"buildImageNamespace,omitempty"`
+	BuildTimeout              int32       `json:"buildTimeout"`
 	BuilderImage              string      `json:"builderImage"`
 	BuilderRegistryServer     string      `json:"builderRegistryServer"`
 	DefaultProjectImage       string      `json:"defaultProjectImage"`
@@ -34,6 +36,8 @@ type ServerConfig struct {
 	LocalBuilderRegistryImage string      `json:"localBuilderRegistryImage"`
 	LocalBuilderRegistryPort  int32       `json:"localBuilderRegistryPort"`
 	LogFilePath               string      `json:"logFilePath"`
+	MaxBuildRetries           int32       `json:"maxBuildRetries"`
+	MaxConcurrentBuilds       int32       `json:"maxConcurrentBuilds"`
 	ProvidersDir              string      `json:"providersDir"`
 	RegistryUrl               string      `json:"registryUrl"`
 	SamplesIndexUrl           *string     `json:"samplesIndexUrl,omitempty"`
@@ -46,10 +50,12 @@ type _ServerConfig ServerConfig
 // This constructor will assign default values to properties that have it defined,
 // and makes sure properties required by API are set, but the set of arguments
 // will change when the set of required properties is changed
-func NewServerConfig(apiPort int32, binariesPath string, builderImage string, builderRegistryServer string, defaultProjectImage string, defaultProjectUser string, headscalePort int32, id string, localBuilderRegistryImage string, localBuilderRegistryPort int32, logFilePath string, providersDir string, registryUrl string, serverDownloadUrl string) *ServerConfig {
+func NewServerConfig(apiPort int32, auditRetentionDays int32, binariesPath string, buildTimeout int32, builderImage string, builderRegistryServer string, defaultProjectImage string, defaultProjectUser string, headscalePort int32, id string, localBuilderRegistryImage string, localBuilderRegistryPort int32, logFilePath string, maxBuildRetries int32, maxConcurrentBuilds int32, providersDir string, registryUrl string, serverDownloadUrl string) *ServerConfig {
 	this := ServerConfig{}
 	this.ApiPort = apiPort
+	this.AuditRetentionDays = auditRetentionDays
 	this.BinariesPath = binariesPath
+	this.BuildTimeout = buildTimeout
 	this.BuilderImage = builderImage
 	this.BuilderRegistryServer = builderRegistryServer
 	this.DefaultProjectImage = defaultProjectImage
@@ -59,6 +65,8 @@ func NewServerConfig(apiPort int32, binariesPath string, builderImage string, bu
 	this.LocalBuilderRegistryImage = localBuilderRegistryImage
 	this.LocalBuilderRegistryPort = localBuilderRegistryPort
 	this.LogFilePath = logFilePath
+	this.MaxBuildRetries = maxBuildRetries
+	this.MaxConcurrentBuilds = maxConcurrentBuilds
 	this.ProvidersDir = providersDir
 	this.RegistryUrl = registryUrl
 	this.ServerDownloadUrl = serverDownloadUrl
@@ -97,6 +105,30 @@ func (o *ServerConfig) SetApiPort(v int32) {
 	o.ApiPort = v
 }
 
+// GetAuditRetentionDays returns the AuditRetentionDays field value
+func (o *ServerConfig) GetAuditRetentionDays() int32 {
+	if o == nil {
+		var ret int32
+		return ret
+	}
+
+	return o.AuditRetentionDays
+}
+
+// GetAuditRetentionDaysOk returns a tuple with the AuditRetentionDays field value
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetAuditRetentionDaysOk() (*int32, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return &o.AuditRetentionDays, true
+}
+
+// SetAuditRetentionDays sets field value
+func (o *ServerConfig) SetAuditRetentionDays(v int32) {
+	o.AuditRetentionDays = v
+}
+
 // GetBinariesPath returns the BinariesPath field value
 func (o *ServerConfig) GetBinariesPath() string {
 	if o == nil {
@@ -153,6 +185,30 @@ func (o *ServerConfig) SetBuildImageNamespace(v string) {
 	o.BuildImageNamespace = &v
 }
 
+// GetBuildTimeout returns the BuildTimeout field value
+func (o *ServerConfig) GetBuildTimeout() int32 {
+	if o == nil {
+		var ret int32
+		return ret
+	}
+
+	return o.BuildTimeout
+}
+
+// GetBuildTimeoutOk returns a tuple with the BuildTimeout field value
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetBuildTimeoutOk() (*int32, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return &o.BuildTimeout, true
+}
+
+// SetBuildTimeout sets field value
+func (o *ServerConfig) SetBuildTimeout(v int32) {
+	o.BuildTimeout = v
+}
+
 // GetBuilderImage returns the BuilderImage field value
 func (o *ServerConfig) GetBuilderImage() string {
 	if o == nil {
@@ -401,6 +457,54 @@ func (o *ServerConfig) SetLogFilePath(v string) {
 	o.LogFilePath = v
 }
 
+// GetMaxBuildRetries returns the MaxBuildRetries field value
+func (o *ServerConfig) GetMaxBuildRetries() int32 {
+	if o == nil {
+		var ret int32
+		return ret
+	}
+
+	return o.MaxBuildRetries
+}
+
+// GetMaxBuildRetriesOk returns a tuple with the MaxBuildRetries field value
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetMaxBuildRetriesOk() (*int32, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return &o.MaxBuildRetries, true
+}
+
+// SetMaxBuildRetries sets field value
+func (o *ServerConfig) SetMaxBuildRetries(v int32) {
+	o.MaxBuildRetries = v
+}
+
+// GetMaxConcurrentBuilds returns the MaxConcurrentBuilds field value
+func (o *ServerConfig) GetMaxConcurrentBuilds() int32 {
+	if o == nil {
+		var ret int32
+		return ret
+	}
+
+	return o.MaxConcurrentBuilds
+}
+
+// GetMaxConcurrentBuildsOk returns a tuple with the MaxConcurrentBuilds field value
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetMaxConcurrentBuildsOk() (*int32, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return &o.MaxConcurrentBuilds, true
+}
+
+// SetMaxConcurrentBuilds sets field value
+func (o *ServerConfig) SetMaxConcurrentBuilds(v int32) {
+	o.MaxConcurrentBuilds = v
+}
+
 // GetProvidersDir returns the ProvidersDir field value
 func (o *ServerConfig) GetProvidersDir() string {
 	if o == nil {
@@ -516,10 +620,12 @@ func (o ServerConfig) MarshalJSON() ([]byte, error) {
 func (o ServerConfig) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
 	toSerialize["apiPort"] = o.ApiPort
+	toSerialize["auditRetentionDays"] = o.AuditRetentionDays
 	toSerialize["binariesPath"] = o.BinariesPath
 	if !IsNil(o.BuildImageNamespace) {
 		toSerialize["buildImageNamespace"] = o.BuildImageNamespace
 	}
+	toSerialize["buildTimeout"] = o.BuildTimeout
 	toSerialize["builderImage"] = o.BuilderImage
 	toSerialize["builderRegistryServer"] = o.BuilderRegistryServer
 	toSerialize["defaultProjectImage"] = o.DefaultProjectImage
@@ -532,6 +638,8 @@ func (o ServerConfig) ToMap() (map[string]interface{}, error) {
 	toSerialize["localBuilderRegistryImage"] = o.LocalBuilderRegistryImage
 	toSerialize["localBuilderRegistryPort"] = o.LocalBuilderRegistryPort
 	toSerialize["logFilePath"] = o.LogFilePath
+	toSerialize["maxBuildRetries"] = o.MaxBuildRetries
+	toSerialize["maxConcurrentBuilds"] = o.MaxConcurrentBuilds
 	toSerialize["providersDir"] = o.ProvidersDir
 	toSerialize["registryUrl"] = o.RegistryUrl
 	if !IsNil(o.SamplesIndexUrl) {
@@ -547,7 +655,9 @@ func (o *ServerConfig) UnmarshalJSON(data []byte) (err error) {
 	// that every required field exists as a key in the generic map.
 	requiredProperties := []string{
 		"apiPort",
+		"auditRetentionDays",
 		"binariesPath",
+		"buildTimeout",
 		"builderImage",
 		"builderRegistryServer",
 		"defaultProjectImage",
@@ -557,6 +667,8 @@ func (o *ServerConfig) UnmarshalJSON(data []byte) (err error) {
 		"localBuilderRegistryImage",
 		"localBuilderRegistryPort",
 		"logFilePath",
+		"maxBuildRetries",
+		"maxConcurrentBuilds",
 		"providersDir",
 		"registryUrl",
 		"serverDownloadUrl",
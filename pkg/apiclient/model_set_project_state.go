@@ -22,7 +22,12 @@ var _ MappedNullable = &SetProjectState{}
 // SetProjectState struct for SetProjectState
 type SetProjectState struct {
 	GitStatus *GitStatus `json:"gitStatus,omitempty"`
-	Uptime    int32      `json:"uptime"`
+	// Seconds since the last SSH session, port-forward or IDE connection was closed
+	IdleTime *int32          `json:"idleTime,omitempty"`
+	Metrics  *ProjectMetrics `json:"metrics,omitempty"`
+	// Target the reporting agent runs on
+	Target *string `json:"target,omitempty"`
+	Uptime int32   `json:"uptime"`
 }
 
 type _SetProjectState SetProjectState
@@ -77,6 +82,102 @@ func (o *SetProjectState) SetGitStatus(v GitStatus) {
 	o.GitStatus = &v
 }
 
+// GetIdleTime returns the IdleTime field value if set, zero value otherwise.
+func (o *SetProjectState) GetIdleTime() int32 {
+	if o == nil || IsNil(o.IdleTime) {
+		var ret int32
+		return ret
+	}
+	return *o.IdleTime
+}
+
+// GetIdleTimeOk returns a tuple with the IdleTime field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SetProjectState) GetIdleTimeOk() (*int32, bool) {
+	if o == nil || IsNil(o.IdleTime) {
+		return nil, false
+	}
+	return o.IdleTime, true
+}
+
+// HasIdleTime returns a boolean if a field has been set.
+func (o *SetProjectState) HasIdleTime() bool {
+	if o != nil && !IsNil(o.IdleTime) {
+		return true
+	}
+
+	return false
+}
+
+// SetIdleTime gets a reference to the given int32 and assigns it to the IdleTime field.
+func (o *SetProjectState) SetIdleTime(v int32) {
+	o.IdleTime = &v
+}
+
+// GetMetrics returns the Metrics field value if set, zero value otherwise.
+func (o *SetProjectState) GetMetrics() ProjectMetrics {
+	if o == nil || IsNil(o.Metrics) {
+		var ret ProjectMetrics
+		return ret
+	}
+	return *o.Metrics
+}
+
+// GetMetricsOk returns a tuple with the Metrics field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SetProjectState) GetMetricsOk() (*ProjectMetrics, bool) {
+	if o == nil || IsNil(o.Metrics) {
+		return nil, false
+	}
+	return o.Metrics, true
+}
+
+// HasMetrics returns a boolean if a field has been set.
+func (o *SetProjectState) HasMetrics() bool {
+	if o != nil && !IsNil(o.Metrics) {
+		return true
+	}
+
+	return false
+}
+
+// SetMetrics gets a reference to the given ProjectMetrics and assigns it to the Metrics field.
+func (o *SetProjectState) SetMetrics(v ProjectMetrics) {
+	o.Metrics = &v
+}
+
+// GetTarget returns the Target field value if set, zero value otherwise.
+func (o *SetProjectState) GetTarget() string {
+	if o == nil || IsNil(o.Target) {
+		var ret string
+		return ret
+	}
+	return *o.Target
+}
+
+// GetTargetOk returns a tuple with the Target field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SetProjectState) GetTargetOk() (*string, bool) {
+	if o == nil || IsNil(o.Target) {
+		return nil, false
+	}
+	return o.Target, true
+}
+
+// HasTarget returns a boolean if a field has been set.
+func (o *SetProjectState) HasTarget() bool {
+	if o != nil && !IsNil(o.Target) {
+		return true
+	}
+
+	return false
+}
+
+// SetTarget gets a reference to the given string and assigns it to the Target field.
+func (o *SetProjectState) SetTarget(v string) {
+	o.Target = &v
+}
+
 // GetUptime returns the Uptime field value
 func (o *SetProjectState) GetUptime() int32 {
 	if o == nil {
@@ -114,6 +215,15 @@ func (o SetProjectState) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.GitStatus) {
 		toSerialize["gitStatus"] = o.GitStatus
 	}
+	if !IsNil(o.IdleTime) {
+		toSerialize["idleTime"] = o.IdleTime
+	}
+	if !IsNil(o.Metrics) {
+		toSerialize["metrics"] = o.Metrics
+	}
+	if !IsNil(o.Target) {
+		toSerialize["target"] = o.Target
+	}
 	toSerialize["uptime"] = o.Uptime
 	return toSerialize, nil
 }
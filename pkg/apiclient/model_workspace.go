@@ -21,10 +21,15 @@ var _ MappedNullable = &Workspace{}
 
 // Workspace struct for Workspace
 type Workspace struct {
-	Id       string    `json:"id"`
-	Name     string    `json:"name"`
-	Projects []Project `json:"projects"`
-	Target   string    `json:"target"`
+	// Minutes of inactivity after which the workspace projects are stopped, 0 falls back to the target setting
+	AutoStop *int32 `json:"autoStop,omitempty"`
+	// The workspace is removed once it expires, nil means it never expires
+	ExpiresAt *string   `json:"expiresAt,omitempty"`
+	Id        string    `json:"id"`
+	Lifecycle Lifecycle `json:"lifecycle"`
+	Name      string    `json:"name"`
+	Projects  []Project `json:"projects"`
+	Target    string    `json:"target"`
 }
 
 type _Workspace Workspace
@@ -33,9 +38,10 @@ type _Workspace Workspace
 // This constructor will assign default values to properties that have it defined,
 // and makes sure properties required by API are set, but the set of arguments
 // will change when the set of required properties is changed
-func NewWorkspace(id string, name string, projects []Project, target string) *Workspace {
+func NewWorkspace(id string, lifecycle Lifecycle, name string, projects []Project, target string) *Workspace {
 	this := Workspace{}
 	this.Id = id
+	this.Lifecycle = lifecycle
 	this.Name = name
 	this.Projects = projects
 	this.Target = target
@@ -50,6 +56,70 @@ func NewWorkspaceWithDefaults() *Workspace {
 	return &this
 }
 
+// GetAutoStop returns the AutoStop field value if set, zero value otherwise.
+func (o *Workspace) GetAutoStop() int32 {
+	if o == nil || IsNil(o.AutoStop) {
+		var ret int32
+		return ret
+	}
+	return *o.AutoStop
+}
+
+// GetAutoStopOk returns a tuple with the AutoStop field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Workspace) GetAutoStopOk() (*int32, bool) {
+	if o == nil || IsNil(o.AutoStop) {
+		return nil, false
+	}
+	return o.AutoStop, true
+}
+
+// HasAutoStop returns a boolean if a field has been set.
+func (o *Workspace) HasAutoStop() bool {
+	if o != nil && !IsNil(o.AutoStop) {
+		return true
+	}
+
+	return false
+}
+
+// SetAutoStop gets a reference to the given int32 and assigns it to the AutoStop field.
+func (o *Workspace) SetAutoStop(v int32) {
+	o.AutoStop = &v
+}
+
+// GetExpiresAt returns the ExpiresAt field value if set, zero value otherwise.
+func (o *Workspace) GetExpiresAt() string {
+	if o == nil || IsNil(o.ExpiresAt) {
+		var ret string
+		return ret
+	}
+	return *o.ExpiresAt
+}
+
+// GetExpiresAtOk returns a tuple with the ExpiresAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Workspace) GetExpiresAtOk() (*string, bool) {
+	if o == nil || IsNil(o.ExpiresAt) {
+		return nil, false
+	}
+	return o.ExpiresAt, true
+}
+
+// HasExpiresAt returns a boolean if a field has been set.
+func (o *Workspace) HasExpiresAt() bool {
+	if o != nil && !IsNil(o.ExpiresAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetExpiresAt gets a reference to the given string and assigns it to the ExpiresAt field.
+func (o *Workspace) SetExpiresAt(v string) {
+	o.ExpiresAt = &v
+}
+
 // GetId returns the Id field value
 func (o *Workspace) GetId() string {
 	if o == nil {
@@ -74,6 +144,30 @@ func (o *Workspace) SetId(v string) {
 	o.Id = v
 }
 
+// GetLifecycle returns the Lifecycle field value
+func (o *Workspace) GetLifecycle() Lifecycle {
+	if o == nil {
+		var ret Lifecycle
+		return ret
+	}
+
+	return o.Lifecycle
+}
+
+// GetLifecycleOk returns a tuple with the Lifecycle field value
+// and a boolean to check if the value has been set.
+func (o *Workspace) GetLifecycleOk() (*Lifecycle, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return &o.Lifecycle, true
+}
+
+// SetLifecycle sets field value
+func (o *Workspace) SetLifecycle(v Lifecycle) {
+	o.Lifecycle = v
+}
+
 // GetName returns the Name field value
 func (o *Workspace) GetName() string {
 	if o == nil {
@@ -156,7 +250,14 @@ func (o Workspace) MarshalJSON() ([]byte, error) {
 
 func (o Workspace) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.AutoStop) {
+		toSerialize["autoStop"] = o.AutoStop
+	}
+	if !IsNil(o.ExpiresAt) {
+		toSerialize["expiresAt"] = o.ExpiresAt
+	}
 	toSerialize["id"] = o.Id
+	toSerialize["lifecycle"] = o.Lifecycle
 	toSerialize["name"] = o.Name
 	toSerialize["projects"] = o.Projects
 	toSerialize["target"] = o.Target
@@ -169,6 +270,7 @@ func (o *Workspace) UnmarshalJSON(data []byte) (err error) {
 	// that every required field exists as a key in the generic map.
 	requiredProperties := []string{
 		"id",
+		"lifecycle",
 		"name",
 		"projects",
 		"target",
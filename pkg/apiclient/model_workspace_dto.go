@@ -21,11 +21,16 @@ var _ MappedNullable = &WorkspaceDTO{}
 
 // WorkspaceDTO struct for WorkspaceDTO
 type WorkspaceDTO struct {
-	Id       string         `json:"id"`
-	Info     *WorkspaceInfo `json:"info,omitempty"`
-	Name     string         `json:"name"`
-	Projects []Project      `json:"projects"`
-	Target   string         `json:"target"`
+	// Minutes of inactivity after which the workspace projects are stopped, 0 falls back to the target setting
+	AutoStop *int32 `json:"autoStop,omitempty"`
+	// The workspace is removed once it expires, nil means it never expires
+	ExpiresAt *string        `json:"expiresAt,omitempty"`
+	Id        string         `json:"id"`
+	Info      *WorkspaceInfo `json:"info,omitempty"`
+	Lifecycle Lifecycle      `json:"lifecycle"`
+	Name      string         `json:"name"`
+	Projects  []Project      `json:"projects"`
+	Target    string         `json:"target"`
 }
 
 type _WorkspaceDTO WorkspaceDTO
@@ -34,9 +39,10 @@ type _WorkspaceDTO WorkspaceDTO
 // This constructor will assign default values to properties that have it defined,
 // and makes sure properties required by API are set, but the set of arguments
 // will change when the set of required properties is changed
-func NewWorkspaceDTO(id string, name string, projects []Project, target string) *WorkspaceDTO {
+func NewWorkspaceDTO(id string, lifecycle Lifecycle, name string, projects []Project, target string) *WorkspaceDTO {
 	this := WorkspaceDTO{}
 	this.Id = id
+	this.Lifecycle = lifecycle
 	this.Name = name
 	this.Projects = projects
 	this.Target = target
@@ -51,6 +57,70 @@ func NewWorkspaceDTOWithDefaults() *WorkspaceDTO {
 	return &this
 }
 
+// GetAutoStop returns the AutoStop field value if set, zero value otherwise.
+func (o *WorkspaceDTO) GetAutoStop() int32 {
+	if o == nil || IsNil(o.AutoStop) {
+		var ret int32
+		return ret
+	}
+	return *o.AutoStop
+}
+
+// GetAutoStopOk returns a tuple with the AutoStop field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *WorkspaceDTO) GetAutoStopOk() (*int32, bool) {
+	if o == nil || IsNil(o.AutoStop) {
+		return nil, false
+	}
+	return o.AutoStop, true
+}
+
+// HasAutoStop returns a boolean if a field has been set.
+func (o *WorkspaceDTO) HasAutoStop() bool {
+	if o != nil && !IsNil(o.AutoStop) {
+		return true
+	}
+
+	return false
+}
+
+// SetAutoStop gets a reference to the given int32 and assigns it to the AutoStop field.
+func (o *WorkspaceDTO) SetAutoStop(v int32) {
+	o.AutoStop = &v
+}
+
+// GetExpiresAt returns the ExpiresAt field value if set, zero value otherwise.
+func (o *WorkspaceDTO) GetExpiresAt() string {
+	if o == nil || IsNil(o.ExpiresAt) {
+		var ret string
+		return ret
+	}
+	return *o.ExpiresAt
+}
+
+// GetExpiresAtOk returns a tuple with the ExpiresAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *WorkspaceDTO) GetExpiresAtOk() (*string, bool) {
+	if o == nil || IsNil(o.ExpiresAt) {
+		return nil, false
+	}
+	return o.ExpiresAt, true
+}
+
+// HasExpiresAt returns a boolean if a field has been set.
+func (o *WorkspaceDTO) HasExpiresAt() bool {
+	if o != nil && !IsNil(o.ExpiresAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetExpiresAt gets a reference to the given string and assigns it to the ExpiresAt field.
+func (o *WorkspaceDTO) SetExpiresAt(v string) {
+	o.ExpiresAt = &v
+}
+
 // GetId returns the Id field value
 func (o *WorkspaceDTO) GetId() string {
 	if o == nil {
@@ -107,6 +177,30 @@ func (o *WorkspaceDTO) SetInfo(v WorkspaceInfo) {
 	o.Info = &v
 }
 
+// GetLifecycle returns the Lifecycle field value
+func (o *WorkspaceDTO) GetLifecycle() Lifecycle {
+	if o == nil {
+		var ret Lifecycle
+		return ret
+	}
+
+	return o.Lifecycle
+}
+
+// GetLifecycleOk returns a tuple with the Lifecycle field value
+// and a boolean to check if the value has been set.
+func (o *WorkspaceDTO) GetLifecycleOk() (*Lifecycle, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return &o.Lifecycle, true
+}
+
+// SetLifecycle sets field value
+func (o *WorkspaceDTO) SetLifecycle(v Lifecycle) {
+	o.Lifecycle = v
+}
+
 // GetName returns the Name field value
 func (o *WorkspaceDTO) GetName() string {
 	if o == nil {
@@ -189,10 +283,17 @@ func (o WorkspaceDTO) MarshalJSON() ([]byte, error) {
 
 func (o WorkspaceDTO) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.AutoStop) {
+		toSerialize["autoStop"] = o.AutoStop
+	}
+	if !IsNil(o.ExpiresAt) {
+		toSerialize["expiresAt"] = o.ExpiresAt
+	}
 	toSerialize["id"] = o.Id
 	if !IsNil(o.Info) {
 		toSerialize["info"] = o.Info
 	}
+	toSerialize["lifecycle"] = o.Lifecycle
 	toSerialize["name"] = o.Name
 	toSerialize["projects"] = o.Projects
 	toSerialize["target"] = o.Target
@@ -205,6 +306,7 @@ func (o *WorkspaceDTO) UnmarshalJSON(data []byte) (err error) {
 	// that every required field exists as a key in the generic map.
 	requiredProperties := []string{
 		"id",
+		"lifecycle",
 		"name",
 		"projects",
 		"target",
@@ -3,6 +3,8 @@
 
 package apikey
 
+import "time"
+
 type ApiKeyType string
 
 const (
@@ -14,6 +16,14 @@ const (
 type ApiKey struct {
 	KeyHash string     `json:"keyHash" validate:"required"`
 	Type    ApiKeyType `json:"type" validate:"required"`
+	Role    ApiKeyRole `json:"role" validate:"required"`
 	// Project or client name
 	Name string `json:"name" validate:"required"`
+	// The key is rejected after this time. Keys without an expiry never expire
+	ExpiresAt  *time.Time `json:"expiresAt,omitempty" validate:"optional"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty" validate:"optional"`
 } // @name ApiKey
+
+func (k *ApiKey) IsExpired() bool {
+	return k.ExpiresAt != nil && !time.Now().Before(*k.ExpiresAt)
+}
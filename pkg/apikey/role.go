@@ -0,0 +1,66 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package apikey
+
+import (
+	"errors"
+	"slices"
+)
+
+type ApiKeyRole string
+
+const (
+	ApiKeyRoleAdmin     ApiKeyRole = "admin"
+	ApiKeyRoleDeveloper ApiKeyRole = "developer"
+	ApiKeyRoleReadOnly  ApiKeyRole = "read-only"
+	// Assigned to the keys the server generates for workspaces and projects
+	ApiKeyRoleProject ApiKeyRole = "project"
+)
+
+type Permission string
+
+const (
+	PermissionRead Permission = "read"
+	// Create, start, stop and remove workspaces and their projects
+	PermissionWriteWorkspaces Permission = "write:workspaces"
+	// Generate network keys used to connect to projects
+	PermissionConnect Permission = "connect"
+	// Report the state of a project
+	PermissionReportState Permission = "report-state"
+	// Change the server configuration and the resources shared by all workspaces
+	PermissionAdmin Permission = "admin"
+)
+
+var rolePermissions = map[ApiKeyRole][]Permission{
+	ApiKeyRoleAdmin:     {PermissionRead, PermissionWriteWorkspaces, PermissionConnect, PermissionAdmin},
+	ApiKeyRoleDeveloper: {PermissionRead, PermissionWriteWorkspaces, PermissionConnect},
+	ApiKeyRoleReadOnly:  {PermissionRead},
+	// Keys generated for workspaces and projects can only read their own workspace
+	ApiKeyRoleProject: {PermissionConnect, PermissionReportState},
+}
+
+var ErrInvalidRole = errors.New("invalid API key role")
+
+func (r ApiKeyRole) HasPermission(permission Permission) bool {
+	return slices.Contains(rolePermissions[r], permission)
+}
+
+// IsValidFor returns true if keys of the given type can be assigned the role
+func (r ApiKeyRole) IsValidFor(keyType ApiKeyType) bool {
+	if _, ok := rolePermissions[r]; !ok {
+		return false
+	}
+
+	return (keyType == ApiKeyTypeClient) != (r == ApiKeyRoleProject)
+}
+
+// GetDefaultRole returns the role of keys that were generated without one.
+// Client keys default to admin to keep the access they had before roles were introduced
+func GetDefaultRole(keyType ApiKeyType) ApiKeyRole {
+	if keyType == ApiKeyTypeClient {
+		return ApiKeyRoleAdmin
+	}
+
+	return ApiKeyRoleProject
+}
@@ -0,0 +1,36 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package apikey
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHasPermission(t *testing.T) {
+	require.True(t, ApiKeyRoleAdmin.HasPermission(PermissionAdmin))
+	require.False(t, ApiKeyRoleAdmin.HasPermission(PermissionReportState))
+
+	require.True(t, ApiKeyRoleDeveloper.HasPermission(PermissionWriteWorkspaces))
+	require.False(t, ApiKeyRoleDeveloper.HasPermission(PermissionAdmin))
+
+	require.True(t, ApiKeyRoleReadOnly.HasPermission(PermissionRead))
+	require.False(t, ApiKeyRoleReadOnly.HasPermission(PermissionWriteWorkspaces))
+	require.False(t, ApiKeyRoleReadOnly.HasPermission(PermissionConnect))
+
+	require.True(t, ApiKeyRoleProject.HasPermission(PermissionReportState))
+	require.False(t, ApiKeyRoleProject.HasPermission(PermissionWriteWorkspaces))
+	require.False(t, ApiKeyRoleProject.HasPermission(PermissionRead))
+
+	require.False(t, ApiKeyRole("unknown").HasPermission(PermissionRead))
+}
+
+func TestIsValidFor(t *testing.T) {
+	require.True(t, ApiKeyRoleDeveloper.IsValidFor(ApiKeyTypeClient))
+	require.False(t, ApiKeyRoleProject.IsValidFor(ApiKeyTypeClient))
+	require.True(t, ApiKeyRoleProject.IsValidFor(ApiKeyTypeWorkspace))
+	require.False(t, ApiKeyRoleAdmin.IsValidFor(ApiKeyTypeProject))
+	require.False(t, ApiKeyRole("owner").IsValidFor(ApiKeyTypeClient))
+}
@@ -11,12 +11,19 @@ type Store interface {
 	FindByName(name string) (*ApiKey, error)
 	Save(apiKey *ApiKey) error
 	Delete(apiKey *ApiKey) error
+	// Rotate saves the replaced key and its replacement in a single transaction
+	Rotate(replacedKey *ApiKey, newKey *ApiKey) error
 }
 
 var (
 	ErrApiKeyNotFound = errors.New("api key not found")
+	ErrApiKeyExpired  = errors.New("api key expired")
 )
 
 func IsApiKeyNotFound(err error) bool {
 	return err.Error() == ErrApiKeyNotFound.Error()
 }
+
+func IsApiKeyExpired(err error) bool {
+	return err.Error() == ErrApiKeyExpired.Error()
+}
@@ -0,0 +1,35 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/apikey"
+)
+
+type Result string
+
+const (
+	ResultSuccess Result = "success"
+	ResultFailure Result = "failure"
+)
+
+// Key name of the records of requests that were not authenticated
+const AnonymousKeyName = "anonymous"
+
+type Record struct {
+	Id      string            `json:"id" validate:"required"`
+	KeyName string            `json:"keyName" validate:"required"`
+	KeyType apikey.ApiKeyType `json:"keyType" validate:"required"`
+	Method  string            `json:"method" validate:"required"`
+	// Route template, e.g. /workspace/:workspaceId
+	Route string `json:"route" validate:"required"`
+	// Request path with the resource identifiers, e.g. /workspace/my-workspace
+	Resource   string    `json:"resource" validate:"required"`
+	StatusCode int       `json:"statusCode" validate:"required"`
+	Result     Result    `json:"result" validate:"required"`
+	Error      string    `json:"error,omitempty" validate:"optional"`
+	Timestamp  time.Time `json:"timestamp" validate:"required"`
+} // @name AuditRecord
@@ -0,0 +1,28 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"errors"
+	"time"
+)
+
+type Filter struct {
+	Since   *time.Time
+	KeyName *string
+	// Matches records whose resource starts with the given path
+	Resource *string
+}
+
+type Store interface {
+	// List returns the records that match the filter, newest first
+	List(filter *Filter) ([]*Record, error)
+	Save(record *Record) error
+	// DeleteBefore removes the records older than the timestamp and returns how many were removed
+	DeleteBefore(timestamp time.Time) (int, error)
+}
+
+var (
+	ErrInvalidRetention = errors.New("retention must be positive")
+)
@@ -22,19 +22,25 @@ const (
 	BuildStatePublished     BuildState = "published"
 	BuildStatePendingDelete BuildState = "pending-delete"
 	BuildStateDeleting      BuildState = "deleting"
+	BuildStateCancelled     BuildState = "cancelled"
 )
 
 type Build struct {
-	Id          string                     `json:"id" validate:"required"`
-	State       BuildState                 `json:"state" validate:"required"`
-	Image       string                     `json:"image" validate:"required"`
-	User        string                     `json:"user" validate:"required"`
-	BuildConfig *buildconfig.BuildConfig   `json:"buildConfig" validate:"optional"`
-	Repository  *gitprovider.GitRepository `json:"repository" validate:"required"`
-	EnvVars     map[string]string          `json:"envVars" validate:"required"`
-	PrebuildId  string                     `json:"prebuildId" validate:"required"`
-	CreatedAt   time.Time                  `json:"createdAt" validate:"required"`
-	UpdatedAt   time.Time                  `json:"updatedAt" validate:"required"`
+	Id            string                     `json:"id" validate:"required"`
+	State         BuildState                 `json:"state" validate:"required"`
+	Image         string                     `json:"image" validate:"required"`
+	User          string                     `json:"user" validate:"required"`
+	BuildConfig   *buildconfig.BuildConfig   `json:"buildConfig" validate:"optional"`
+	Repository    *gitprovider.GitRepository `json:"repository" validate:"required"`
+	EnvVars       map[string]string          `json:"envVars" validate:"required"`
+	PrebuildId    string                     `json:"prebuildId" validate:"required"`
+	Priority      int                        `json:"priority" validate:"required"`
+	QueuePosition *int                       `json:"queuePosition,omitempty" validate:"optional"`
+	RetryCount    int                        `json:"retryCount" validate:"required"`
+	MaxRetries    *int                       `json:"maxRetries,omitempty" validate:"optional"`
+	NextRetryAt   *time.Time                 `json:"nextRetryAt,omitempty" validate:"optional"`
+	CreatedAt     time.Time                  `json:"createdAt" validate:"required"`
+	UpdatedAt     time.Time                  `json:"updatedAt" validate:"required"`
 } // @name Build
 
 func (b *Build) Compare(other *Build) (bool, error) {
@@ -73,6 +79,13 @@ func (b *Build) GetBuildHash() (string, error) {
 			return "", err
 		}
 	}
+	if b.BuildConfig != nil && b.BuildConfig.Dockerfile != nil {
+		dockerfileJson, err := json.Marshal(b.BuildConfig.Dockerfile)
+		if err != nil {
+			return "", err
+		}
+		buildJson = append(buildJson, dockerfileJson...)
+	}
 	envVarsJson, err := json.Marshal(b.EnvVars)
 	if err != nil {
 		return "", err
@@ -4,6 +4,7 @@
 package build
 
 import (
+	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
@@ -13,14 +14,16 @@ import (
 )
 
 type IBuilder interface {
-	Build(build Build) (string, string, error)
+	// Build builds the image; cancelling ctx stops the build
+	Build(ctx context.Context, build Build) (string, string, error)
 	CleanUp() error
-	Publish(build Build) error
+	Publish(ctx context.Context, build Build) error
 	GetImageName(build Build) (string, error)
 }
 
 type Builder struct {
 	id                  string
+	buildId             string
 	projectDir          string
 	image               string
 	containerRegistry   *containerregistry.ContainerRegistry
@@ -16,14 +16,26 @@ type BuilderType string
 
 var (
 	BuilderTypeDevcontainer BuilderType = "devcontainer"
+	BuilderTypeDockerfile   BuilderType = "dockerfile"
 	BuilderTypeImage        BuilderType = "image"
 )
 
+// DetectProjectBuilderType returns the builder type of the project. If the build config sets neither
+// a devcontainer nor a Dockerfile, it is detected from the project files and recorded on the build config.
+// Projects without a build config use their image
 func DetectProjectBuilderType(buildConfig *buildconfig.BuildConfig, projectDir string, sshClient *ssh.Client) (BuilderType, error) {
-	if buildConfig != nil && buildConfig.Devcontainer != nil {
+	if buildConfig == nil {
+		return BuilderTypeImage, nil
+	}
+
+	if buildConfig.Devcontainer != nil {
 		return BuilderTypeDevcontainer, nil
 	}
 
+	if buildConfig.Dockerfile != nil {
+		return BuilderTypeDockerfile, nil
+	}
+
 	if sshClient != nil {
 		if _, err := sshClient.ReadFile(path.Join(projectDir, ".devcontainer/devcontainer.json")); err == nil {
 			buildConfig.Devcontainer = &buildconfig.DevcontainerConfig{
@@ -37,6 +49,12 @@ func DetectProjectBuilderType(buildConfig *buildconfig.BuildConfig, projectDir s
 			}
 			return BuilderTypeDevcontainer, nil
 		}
+		if _, err := sshClient.ReadFile(path.Join(projectDir, "Dockerfile")); err == nil {
+			buildConfig.Dockerfile = &buildconfig.DockerfileConfig{
+				FilePath: "Dockerfile",
+			}
+			return BuilderTypeDockerfile, nil
+		}
 	} else {
 		if devcontainerFilePath, pathError := findDevcontainerConfigFilePath(projectDir); pathError == nil {
 			buildConfig.Devcontainer = &buildconfig.DevcontainerConfig{
@@ -45,6 +63,14 @@ func DetectProjectBuilderType(buildConfig *buildconfig.BuildConfig, projectDir s
 
 			return BuilderTypeDevcontainer, nil
 		}
+
+		if isDockerfile, err := fileExists(filepath.Join(projectDir, "Dockerfile")); isDockerfile && err == nil {
+			buildConfig.Dockerfile = &buildconfig.DockerfileConfig{
+				FilePath: "Dockerfile",
+			}
+
+			return BuilderTypeDockerfile, nil
+		}
 	}
 
 	return BuilderTypeImage, nil
@@ -0,0 +1,90 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package detect_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/build/detect"
+	"github.com/daytonaio/daytona/pkg/workspace/project/buildconfig"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectProjectBuilderType(t *testing.T) {
+	tests := []struct {
+		name                 string
+		files                []string
+		buildConfig          *buildconfig.BuildConfig
+		expectedType         detect.BuilderType
+		expectedDockerfile   string
+		expectedDevcontainer string
+	}{
+		{
+			name:         "no build config",
+			files:        []string{"Dockerfile"},
+			buildConfig:  nil,
+			expectedType: detect.BuilderTypeImage,
+		},
+		{
+			name:         "no build files",
+			buildConfig:  &buildconfig.BuildConfig{},
+			expectedType: detect.BuilderTypeImage,
+		},
+		{
+			name:               "Dockerfile",
+			files:              []string{"Dockerfile"},
+			buildConfig:        &buildconfig.BuildConfig{},
+			expectedType:       detect.BuilderTypeDockerfile,
+			expectedDockerfile: "Dockerfile",
+		},
+		{
+			name:                 "devcontainer takes precedence over Dockerfile",
+			files:                []string{"Dockerfile", ".devcontainer/devcontainer.json"},
+			buildConfig:          &buildconfig.BuildConfig{},
+			expectedType:         detect.BuilderTypeDevcontainer,
+			expectedDevcontainer: ".devcontainer/devcontainer.json",
+		},
+		{
+			name:                 "root devcontainer file",
+			files:                []string{".devcontainer.json"},
+			buildConfig:          &buildconfig.BuildConfig{},
+			expectedType:         detect.BuilderTypeDevcontainer,
+			expectedDevcontainer: ".devcontainer.json",
+		},
+		{
+			name:  "configured Dockerfile",
+			files: []string{".devcontainer.json"},
+			buildConfig: &buildconfig.BuildConfig{
+				Dockerfile: &buildconfig.DockerfileConfig{FilePath: "docker/Dockerfile.dev"},
+			},
+			expectedType:       detect.BuilderTypeDockerfile,
+			expectedDockerfile: "docker/Dockerfile.dev",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projectDir := t.TempDir()
+			for _, file := range tt.files {
+				filePath := filepath.Join(projectDir, file)
+				require.Nil(t, os.MkdirAll(filepath.Dir(filePath), 0755))
+				require.Nil(t, os.WriteFile(filePath, []byte{}, 0644))
+			}
+
+			builderType, err := detect.DetectProjectBuilderType(tt.buildConfig, projectDir, nil)
+			require.Nil(t, err)
+			require.Equal(t, tt.expectedType, builderType)
+
+			if tt.expectedDockerfile != "" {
+				require.Equal(t, tt.expectedDockerfile, tt.buildConfig.Dockerfile.FilePath)
+			}
+
+			if tt.expectedDevcontainer != "" {
+				require.Equal(t, tt.expectedDevcontainer, tt.buildConfig.Devcontainer.FilePath)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ package build
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 
@@ -13,6 +14,12 @@ import (
 	"github.com/daytonaio/daytona/pkg/logs"
 	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/client"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	buildIdLabel        = "daytona.build.id"
+	builderBuildIdLabel = "daytona.builder.build.id"
 )
 
 type BuildOutcome struct {
@@ -27,24 +34,47 @@ type DevcontainerBuilder struct {
 	builderDockerPort uint16
 }
 
-func (b *DevcontainerBuilder) Build(build Build) (string, string, error) {
+func (b *DevcontainerBuilder) Build(ctx context.Context, build Build) (string, string, error) {
 	builderType, err := detect.DetectProjectBuilderType(build.BuildConfig, b.projectDir, nil)
 	if err != nil {
 		return "", "", err
 	}
 
+	// Automatic build configs are only resolved after the repository is cloned
+	if builderType == detect.BuilderTypeDockerfile {
+		dockerfileBuilder := &DockerfileBuilder{Builder: b.Builder}
+		return dockerfileBuilder.buildDockerfile(ctx, build)
+	}
+
 	if builderType != detect.BuilderTypeDevcontainer {
 		return "", "", fmt.Errorf("failed to detect devcontainer config")
 	}
 
-	return b.buildDevcontainer(build)
+	return b.buildDevcontainer(ctx, build)
 }
 
+// CleanUp removes any containers left behind by the build, e.g. when the build was interrupted, and the project directory
 func (b *DevcontainerBuilder) CleanUp() error {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return errors.Join(err, os.RemoveAll(b.projectDir))
+	}
+
+	dockerClient := docker.NewDockerClient(docker.DockerClientConfig{
+		ApiClient: cli,
+	})
+
+	for _, label := range []string{builderBuildIdLabel, buildIdLabel} {
+		err = dockerClient.RemoveContainersByLabel(label, b.buildId)
+		if err != nil {
+			return errors.Join(err, os.RemoveAll(b.projectDir))
+		}
+	}
+
 	return os.RemoveAll(b.projectDir)
 }
 
-func (b *DevcontainerBuilder) Publish(build Build) error {
+func (b *DevcontainerBuilder) Publish(ctx context.Context, build Build) error {
 	buildLogger := b.loggerFactory.CreateBuildLogger(build.Id, logs.LogSourceBuilder)
 	defer buildLogger.Close()
 
@@ -57,10 +87,10 @@ func (b *DevcontainerBuilder) Publish(build Build) error {
 		ApiClient: cli,
 	})
 
-	return dockerClient.PushImage(build.Image, b.containerRegistry, buildLogger)
+	return dockerClient.PushImage(ctx, build.Image, b.containerRegistry, buildLogger)
 }
 
-func (b *DevcontainerBuilder) buildDevcontainer(build Build) (string, string, error) {
+func (b *DevcontainerBuilder) buildDevcontainer(ctx context.Context, build Build) (string, string, error) {
 	buildLogger := b.loggerFactory.CreateBuildLogger(build.Id, logs.LogSourceBuilder)
 	defer buildLogger.Close()
 
@@ -73,13 +103,27 @@ func (b *DevcontainerBuilder) buildDevcontainer(build Build) (string, string, er
 		ApiClient: cli,
 	})
 
+	// The devcontainer CLI runs inside the build containers, so removing them stops the build
+	stopCancellation := context.AfterFunc(ctx, func() {
+		for _, label := range []string{builderBuildIdLabel, buildIdLabel} {
+			err := dockerClient.RemoveContainersByLabel(label, b.buildId)
+			if err != nil {
+				log.Error(err)
+			}
+		}
+	})
+	defer stopCancellation()
+
 	containerId, remoteUser, err := dockerClient.CreateFromDevcontainer(docker.CreateDevcontainerOptions{
 		BuildConfig:       build.BuildConfig,
 		ProjectName:       build.Id,
 		ContainerRegistry: b.containerRegistry,
 		Prebuild:          true,
 		IdLabels: map[string]string{
-			"daytona.build.id": build.Id,
+			buildIdLabel: build.Id,
+		},
+		HelperLabels: map[string]string{
+			builderBuildIdLabel: build.Id,
 		},
 		ProjectDir: b.projectDir,
 		LogWriter:  buildLogger,
@@ -96,7 +140,7 @@ func (b *DevcontainerBuilder) buildDevcontainer(build Build) (string, string, er
 		return b.defaultProjectImage, b.defaultProjectUser, err
 	}
 
-	_, err = cli.ContainerCommit(context.Background(), containerId, container.CommitOptions{
+	_, err = cli.ContainerCommit(ctx, containerId, container.CommitOptions{
 		Reference: imageName,
 	})
 	if err != nil {
@@ -0,0 +1,84 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/daytonaio/daytona/pkg/build/detect"
+	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/docker/docker/client"
+)
+
+type DockerfileBuilder struct {
+	*Builder
+}
+
+func (b *DockerfileBuilder) Build(ctx context.Context, build Build) (string, string, error) {
+	builderType, err := detect.DetectProjectBuilderType(build.BuildConfig, b.projectDir, nil)
+	if err != nil {
+		return "", "", err
+	}
+
+	if builderType != detect.BuilderTypeDockerfile {
+		return "", "", fmt.Errorf("failed to detect Dockerfile config")
+	}
+
+	return b.buildDockerfile(ctx, build)
+}
+
+func (b *DockerfileBuilder) CleanUp() error {
+	return os.RemoveAll(b.projectDir)
+}
+
+func (b *DockerfileBuilder) Publish(ctx context.Context, build Build) error {
+	buildLogger := b.loggerFactory.CreateBuildLogger(build.Id, logs.LogSourceBuilder)
+	defer buildLogger.Close()
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return err
+	}
+
+	dockerClient := docker.NewDockerClient(docker.DockerClientConfig{
+		ApiClient: cli,
+	})
+
+	return dockerClient.PushImage(ctx, build.Image, b.containerRegistry, buildLogger)
+}
+
+func (b *DockerfileBuilder) buildDockerfile(ctx context.Context, build Build) (string, string, error) {
+	buildLogger := b.loggerFactory.CreateBuildLogger(build.Id, logs.LogSourceBuilder)
+	defer buildLogger.Close()
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return b.defaultProjectImage, b.defaultProjectUser, err
+	}
+
+	dockerClient := docker.NewDockerClient(docker.DockerClientConfig{
+		ApiClient: cli,
+	})
+
+	imageName, err := b.GetImageName(build)
+	if err != nil {
+		return b.defaultProjectImage, b.defaultProjectUser, err
+	}
+
+	remoteUser, err := dockerClient.BuildFromDockerfile(ctx, docker.BuildFromDockerfileOptions{
+		ProjectDir:        b.projectDir,
+		ImageName:         imageName,
+		BuildConfig:       build.BuildConfig,
+		LogWriter:         buildLogger,
+		ContainerRegistry: b.containerRegistry,
+	})
+	if err != nil {
+		return b.defaultProjectImage, b.defaultProjectUser, err
+	}
+
+	return imageName, string(remoteUser), nil
+}
@@ -0,0 +1,76 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package build_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	t_build "github.com/daytonaio/daytona/internal/testing/build"
+	"github.com/daytonaio/daytona/pkg/build"
+	"github.com/daytonaio/daytona/pkg/containerregistry"
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/workspace/project/buildconfig"
+	"github.com/stretchr/testify/require"
+)
+
+func newDockerfileBuild(dockerfile *buildconfig.DockerfileConfig) build.Build {
+	return build.Build{
+		Id:          "dockerfile-build",
+		State:       build.BuildStatePendingRun,
+		BuildConfig: &buildconfig.BuildConfig{Dockerfile: dockerfile},
+		Repository: &gitprovider.GitRepository{
+			Url: "https://github.com/daytonaio/daytona",
+			Sha: "sha",
+		},
+		EnvVars: map[string]string{},
+	}
+}
+
+func newDockerfileBuilder(t *testing.T, b build.Build, projectDir string) build.IBuilder {
+	buildLogsDir := t.TempDir()
+
+	factory := build.NewBuilderFactory(build.BuilderFactoryConfig{
+		BuildStore:        t_build.NewInMemoryBuildStore(),
+		ContainerRegistry: &containerregistry.ContainerRegistry{Server: "registry.io"},
+		LoggerFactory:     logs.NewLoggerFactory(nil, &buildLogsDir),
+	})
+
+	builder, err := factory.Create(b, projectDir)
+	require.Nil(t, err)
+
+	return builder
+}
+
+func TestFactoryCreatesDockerfileBuilder(t *testing.T) {
+	builder := newDockerfileBuilder(t, newDockerfileBuild(&buildconfig.DockerfileConfig{FilePath: "Dockerfile"}), t.TempDir())
+
+	require.IsType(t, &build.DockerfileBuilder{}, builder)
+}
+
+func TestDockerfileBuilderRejectsDockerfileOutsideContext(t *testing.T) {
+	b := newDockerfileBuild(&buildconfig.DockerfileConfig{
+		FilePath: "../Dockerfile",
+		Context:  ".",
+	})
+
+	builder := newDockerfileBuilder(t, b, t.TempDir())
+
+	_, _, err := builder.Build(context.Background(), b)
+	require.ErrorContains(t, err, "must be located inside the build context")
+}
+
+func TestDockerfileBuilderCleanUp(t *testing.T) {
+	projectDir := t.TempDir()
+	require.Nil(t, os.WriteFile(projectDir+"/Dockerfile", []byte("FROM alpine"), 0644))
+
+	builder := newDockerfileBuilder(t, newDockerfileBuild(&buildconfig.DockerfileConfig{FilePath: "Dockerfile"}), projectDir)
+
+	require.Nil(t, builder.CleanUp())
+
+	_, err := os.Stat(projectDir)
+	require.True(t, os.IsNotExist(err))
+}
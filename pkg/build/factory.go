@@ -50,8 +50,11 @@ func NewBuilderFactory(config BuilderFactoryConfig) IBuilderFactory {
 }
 
 func (f *BuilderFactory) Create(build Build, projectDir string) (IBuilder, error) {
-	// TODO: Implement factory logic after adding prebuilds and other builder types
-	return f.newDevcontainerBuilder(projectDir)
+	if build.BuildConfig != nil && build.BuildConfig.Dockerfile != nil {
+		return f.newDockerfileBuilder(build.Id, projectDir), nil
+	}
+
+	return f.newDevcontainerBuilder(build.Id, projectDir)
 }
 
 func (f *BuilderFactory) CheckExistingBuild(b Build) (*Build, error) {
@@ -72,28 +75,39 @@ func (f *BuilderFactory) CheckExistingBuild(b Build) (*Build, error) {
 	return build, nil
 }
 
-func (f *BuilderFactory) newDevcontainerBuilder(projectDir string) (*DevcontainerBuilder, error) {
+func (f *BuilderFactory) newDevcontainerBuilder(buildId, projectDir string) (*DevcontainerBuilder, error) {
 	builderDockerPort, err := ports.GetAvailableEphemeralPort()
 	if err != nil {
 		return nil, err
 	}
 
-	id := stringid.GenerateRandomID()
-	id = stringid.TruncateID(id)
-	id = fmt.Sprintf("%s-%s", "devcontainer-builder", id)
-
 	return &DevcontainerBuilder{
-		Builder: &Builder{
-			id:                  id,
-			projectDir:          projectDir,
-			image:               f.image,
-			containerRegistry:   f.containerRegistry,
-			buildImageNamespace: f.buildImageNamespace,
-			buildStore:          f.buildStore,
-			loggerFactory:       f.loggerFactory,
-			defaultProjectImage: f.defaultProjectImage,
-			defaultProjectUser:  f.defaultProjectUser,
-		},
+		Builder:           f.newBuilder("devcontainer-builder", buildId, projectDir),
 		builderDockerPort: builderDockerPort,
 	}, nil
 }
+
+func (f *BuilderFactory) newDockerfileBuilder(buildId, projectDir string) *DockerfileBuilder {
+	return &DockerfileBuilder{
+		Builder: f.newBuilder("dockerfile-builder", buildId, projectDir),
+	}
+}
+
+func (f *BuilderFactory) newBuilder(idPrefix, buildId, projectDir string) *Builder {
+	id := stringid.GenerateRandomID()
+	id = stringid.TruncateID(id)
+	id = fmt.Sprintf("%s-%s", idPrefix, id)
+
+	return &Builder{
+		id:                  id,
+		buildId:             buildId,
+		projectDir:          projectDir,
+		image:               f.image,
+		containerRegistry:   f.containerRegistry,
+		buildImageNamespace: f.buildImageNamespace,
+		buildStore:          f.buildStore,
+		loggerFactory:       f.loggerFactory,
+		defaultProjectImage: f.defaultProjectImage,
+		defaultProjectUser:  f.defaultProjectUser,
+	}
+}
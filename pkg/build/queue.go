@@ -0,0 +1,50 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"sort"
+)
+
+const (
+	// Builds triggered automatically, e.g. by prebuild webhook events
+	BuildPriorityLow = 0
+	// Builds triggered by a user
+	BuildPriorityHigh = 10
+)
+
+// GetBuildQueue returns the pending builds in the order in which they should run.
+// Higher priority builds run first and builds with the same priority run in the order they were created.
+func GetBuildQueue(builds []*Build) []*Build {
+	queue := []*Build{}
+	for _, b := range builds {
+		if b.State == BuildStatePendingRun {
+			queue = append(queue, b)
+		}
+	}
+
+	sort.SliceStable(queue, func(i, j int) bool {
+		if queue[i].Priority != queue[j].Priority {
+			return queue[i].Priority > queue[j].Priority
+		}
+		return queue[i].CreatedAt.Before(queue[j].CreatedAt)
+	})
+
+	return queue
+}
+
+// SetQueuePositions sets the 1-based queue position of every pending build in builds based on the full build queue
+func SetQueuePositions(builds []*Build, queue []*Build) {
+	positions := make(map[string]int, len(queue))
+	for i, b := range queue {
+		positions[b.Id] = i + 1
+	}
+
+	for _, b := range builds {
+		b.QueuePosition = nil
+		if position, ok := positions[b.Id]; ok {
+			b.QueuePosition = &position
+		}
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package build_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/build"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBuildQueue(t *testing.T) {
+	now := time.Now()
+
+	oldPrebuild := &build.Build{Id: "old-prebuild", State: build.BuildStatePendingRun, Priority: build.BuildPriorityLow, CreatedAt: now.Add(-time.Hour)}
+	newPrebuild := &build.Build{Id: "new-prebuild", State: build.BuildStatePendingRun, Priority: build.BuildPriorityLow, CreatedAt: now}
+	manual := &build.Build{Id: "manual", State: build.BuildStatePendingRun, Priority: build.BuildPriorityHigh, CreatedAt: now.Add(time.Minute)}
+	running := &build.Build{Id: "running", State: build.BuildStateRunning, Priority: build.BuildPriorityHigh, CreatedAt: now.Add(-2 * time.Hour)}
+
+	builds := []*build.Build{newPrebuild, running, oldPrebuild, manual}
+
+	queue := build.GetBuildQueue(builds)
+	require.Equal(t, []*build.Build{manual, oldPrebuild, newPrebuild}, queue)
+
+	build.SetQueuePositions(builds, queue)
+	require.Equal(t, 1, *manual.QueuePosition)
+	require.Equal(t, 2, *oldPrebuild.QueuePosition)
+	require.Equal(t, 3, *newPrebuild.QueuePosition)
+	require.Nil(t, running.QueuePosition)
+}
@@ -5,9 +5,12 @@ package build
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"path/filepath"
+	"slices"
 	"sync"
+	"time"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/daytonaio/daytona/pkg/containerregistry"
@@ -15,6 +18,7 @@ import (
 	"github.com/daytonaio/daytona/pkg/git"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/metrics"
 	"github.com/daytonaio/daytona/pkg/scheduler"
 	"github.com/daytonaio/daytona/pkg/telemetry"
 	"github.com/docker/docker/client"
@@ -32,22 +36,45 @@ type BuildRunnerInstanceConfig struct {
 	BuilderFactory    IBuilderFactory
 	LoggerFactory     logs.LoggerFactory
 	BasePath          string
-	TelemetryEnabled  bool
-	TelemetryService  telemetry.TelemetryService
+	// Maximum duration of a single build; zero disables the timeout
+	BuildTimeout time.Duration
+	// Maximum number of builds running at the same time; zero disables the limit
+	MaxConcurrentBuilds uint32
+	// Number of times a failed build is retried unless the build overrides it
+	MaxBuildRetries  uint32
+	TelemetryEnabled bool
+	TelemetryService telemetry.TelemetryService
 }
 
+// Interval at which a running build checks whether it has been cancelled
+const cancellationPollInterval = time.Second
+
+// States of the builds the runner works on. The runner does not overwrite builds
+// that left these states in the meantime, e.g. because they were cancelled
+var runnableBuildStates = []BuildState{BuildStatePendingRun, BuildStateRunning}
+
+const (
+	retryBaseBackoff = 30 * time.Second
+	retryMaxBackoff  = 30 * time.Minute
+)
+
 type BuildRunner struct {
-	Id                string
-	scheduler         scheduler.IScheduler
-	runInterval       string
-	containerRegistry *containerregistry.ContainerRegistry
-	gitProviderStore  GitProviderStore
-	buildStore        Store
-	builderFactory    IBuilderFactory
-	loggerFactory     logs.LoggerFactory
-	basePath          string
-	telemetryEnabled  bool
-	telemetryService  telemetry.TelemetryService
+	Id                  string
+	scheduler           scheduler.IScheduler
+	runInterval         string
+	containerRegistry   *containerregistry.ContainerRegistry
+	gitProviderStore    GitProviderStore
+	buildStore          Store
+	builderFactory      IBuilderFactory
+	loggerFactory       logs.LoggerFactory
+	basePath            string
+	buildTimeout        time.Duration
+	maxConcurrentBuilds uint32
+	maxBuildRetries     uint32
+	activeBuilds        map[string]bool
+	activeBuildsMutex   sync.Mutex
+	telemetryEnabled    bool
+	telemetryService    telemetry.TelemetryService
 }
 
 type BuildProcessConfig struct {
@@ -65,17 +92,21 @@ type GitProviderStore interface {
 
 func NewBuildRunner(config BuildRunnerInstanceConfig) *BuildRunner {
 	runner := &BuildRunner{
-		Id:                config.BuildRunnerId,
-		scheduler:         config.Scheduler,
-		runInterval:       config.Interval,
-		containerRegistry: config.ContainerRegistry,
-		gitProviderStore:  config.GitProviderStore,
-		buildStore:        config.BuildStore,
-		builderFactory:    config.BuilderFactory,
-		loggerFactory:     config.LoggerFactory,
-		basePath:          config.BasePath,
-		telemetryEnabled:  config.TelemetryEnabled,
-		telemetryService:  config.TelemetryService,
+		Id:                  config.BuildRunnerId,
+		scheduler:           config.Scheduler,
+		runInterval:         config.Interval,
+		containerRegistry:   config.ContainerRegistry,
+		gitProviderStore:    config.GitProviderStore,
+		buildStore:          config.BuildStore,
+		builderFactory:      config.BuilderFactory,
+		loggerFactory:       config.LoggerFactory,
+		basePath:            config.BasePath,
+		buildTimeout:        config.BuildTimeout,
+		maxConcurrentBuilds: config.MaxConcurrentBuilds,
+		maxBuildRetries:     config.MaxBuildRetries,
+		activeBuilds:        map[string]bool{},
+		telemetryEnabled:    config.TelemetryEnabled,
+		telemetryService:    config.TelemetryService,
 	}
 
 	return runner
@@ -109,65 +140,111 @@ func (r *BuildRunner) RunBuilds() {
 	}
 
 	var wg sync.WaitGroup
-	for _, b := range builds {
-		if b.State == BuildStatePendingRun {
-			wg.Add(1)
+	for _, b := range GetBuildQueue(builds) {
+		if b.NextRetryAt != nil && b.NextRetryAt.After(time.Now()) {
+			continue
+		}
 
-			if b.BuildConfig == nil {
-				return
-			}
+		if !r.acquireBuildSlot(b.Id) {
+			continue
+		}
 
-			buildLogger := r.loggerFactory.CreateBuildLogger(b.Id, logs.LogSourceBuilder)
-			defer buildLogger.Close()
+		if !r.startBuild(b, builds, &wg) {
+			r.releaseBuildSlot(b.Id)
+		}
+	}
 
-			projectDir := filepath.Join(r.basePath, b.Id, "project")
+	wg.Wait()
+}
 
-			builder, err := r.builderFactory.Create(*b, projectDir)
-			if err != nil {
-				r.handleBuildError(*b, builder, err, buildLogger)
-				return
-			}
+// startBuild returns true if the build process was started
+func (r *BuildRunner) startBuild(b *Build, builds []*Build, wg *sync.WaitGroup) bool {
+	if b.BuildConfig == nil {
+		return false
+	}
 
-			cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
-			if err != nil {
-				log.Error(err)
-				return
-			}
+	buildLogger := r.loggerFactory.CreateBuildLogger(b.Id, logs.LogSourceBuilder)
 
-			imageName, err := builder.GetImageName(*b)
-			if err != nil {
-				r.handleBuildError(*b, builder, err, buildLogger)
-				return
-			}
+	projectDir := filepath.Join(r.basePath, b.Id, "project")
 
-			_, _, err = cli.ImageInspectWithRaw(context.Background(), imageName)
-			if err == nil {
-				b.State = BuildStatePublished
-				err = r.buildStore.Save(b)
-				if err != nil {
-					r.handleBuildError(*b, builder, err, buildLogger)
-					return
-				}
-				return
-			}
+	builder, err := r.builderFactory.Create(*b, projectDir)
+	if err != nil {
+		r.handleBuildError(*b, builder, err, buildLogger)
+		buildLogger.Close()
+		return false
+	}
 
-			b.BuildConfig.CachedBuild = GetCachedBuild(b, builds)
-
-			go r.RunBuildProcess(BuildProcessConfig{
-				Builder:     builder,
-				BuildLogger: buildLogger,
-				Build:       b,
-				ProjectDir:  projectDir,
-				GitService: &git.Service{
-					ProjectDir: projectDir,
-					LogWriter:  buildLogger,
-				},
-				Wg: &wg,
-			})
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		log.Error(err)
+		buildLogger.Close()
+		return false
+	}
+
+	imageName, err := builder.GetImageName(*b)
+	if err != nil {
+		r.handleBuildError(*b, builder, err, buildLogger)
+		buildLogger.Close()
+		return false
+	}
+
+	_, _, err = cli.ImageInspectWithRaw(context.Background(), imageName)
+	if err == nil {
+		b.State = BuildStatePublished
+		err = r.buildStore.SaveIfState(b, runnableBuildStates)
+		if err != nil {
+			r.handleBuildError(*b, builder, err, buildLogger)
 		}
+		buildLogger.Close()
+		return false
 	}
 
-	wg.Wait()
+	b.BuildConfig.CachedBuild = GetCachedBuild(b, builds)
+
+	wg.Add(1)
+	go func() {
+		defer r.releaseBuildSlot(b.Id)
+		defer buildLogger.Close()
+
+		r.RunBuildProcess(BuildProcessConfig{
+			Builder:     builder,
+			BuildLogger: buildLogger,
+			Build:       b,
+			ProjectDir:  projectDir,
+			GitService: &git.Service{
+				ProjectDir: projectDir,
+				LogWriter:  buildLogger,
+			},
+			Wg: wg,
+		})
+	}()
+
+	return true
+}
+
+// acquireBuildSlot reserves one of the concurrent build slots for the build.
+// Returns false if the build is already running or all slots are taken.
+func (r *BuildRunner) acquireBuildSlot(buildId string) bool {
+	r.activeBuildsMutex.Lock()
+	defer r.activeBuildsMutex.Unlock()
+
+	if r.activeBuilds[buildId] {
+		return false
+	}
+
+	if r.maxConcurrentBuilds > 0 && len(r.activeBuilds) >= int(r.maxConcurrentBuilds) {
+		return false
+	}
+
+	r.activeBuilds[buildId] = true
+	return true
+}
+
+func (r *BuildRunner) releaseBuildSlot(buildId string) {
+	r.activeBuildsMutex.Lock()
+	defer r.activeBuildsMutex.Unlock()
+
+	delete(r.activeBuilds, buildId)
 }
 
 func (r *BuildRunner) DeleteBuilds() {
@@ -221,22 +298,53 @@ func (r *BuildRunner) DeleteBuilds() {
 	wg.Wait()
 }
 
+// RunBuildProcess runs the build until it completes, is cancelled or exceeds the build timeout.
+// Cancelled and timed out builds are stopped and their builder resources are cleaned up.
 func (r *BuildRunner) RunBuildProcess(config BuildProcessConfig) {
 	if config.Wg != nil {
 		defer config.Wg.Done()
 	}
 
+	ctx, cancel := context.WithCancel(context.Background())
+	if r.buildTimeout > 0 {
+		ctx, cancel = context.WithTimeout(context.Background(), r.buildTimeout)
+	}
+	defer cancel()
+
+	go r.watchBuildCancellation(ctx, cancel, config.Build.Id)
+
+	startTime := time.Now()
+
+	// The clone and the builder stop when ctx is done, so the build process returns once interrupted
+	if r.runBuildProcess(ctx, config) {
+		outcome := metrics.BuildOutcomeSuccess
+		if config.Build.State != BuildStatePublished {
+			outcome = metrics.BuildOutcomeError
+		}
+		metrics.ObserveBuild(outcome, time.Since(startTime))
+		return
+	}
+
+	outcome := metrics.BuildOutcomeCancelled
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		outcome = metrics.BuildOutcomeTimeout
+	}
+	metrics.ObserveBuild(outcome, time.Since(startTime))
+	r.handleBuildInterrupted(ctx, config)
+}
+
+// runBuildProcess returns false if the build was interrupted before completing
+func (r *BuildRunner) runBuildProcess(ctx context.Context, config BuildProcessConfig) bool {
 	config.Build.State = BuildStateRunning
-	err := r.buildStore.Save(config.Build)
+	config.Build.NextRetryAt = nil
+	err := r.saveBuild(ctx, config.Build)
 	if err != nil {
-		r.handleBuildError(*config.Build, config.Builder, err, config.BuildLogger)
-		return
+		return r.handleBuildProcessError(ctx, config, err)
 	}
 
 	gitProvider, err := r.gitProviderStore.GetConfigForUrl(config.Build.Repository.Url)
 	if err != nil && !gitprovider.IsGitProviderNotFound(err) {
-		r.handleBuildError(*config.Build, config.Builder, err, config.BuildLogger)
-		return
+		return r.handleBuildProcessError(ctx, config, err)
 	}
 
 	var auth *http.BasicAuth
@@ -246,38 +354,37 @@ func (r *BuildRunner) RunBuildProcess(config BuildProcessConfig) {
 		auth.Password = gitProvider.Token
 	}
 
-	err = config.GitService.CloneRepository(config.Build.Repository, auth)
+	err = config.GitService.CloneRepository(ctx, config.Build.Repository, auth)
 	if err != nil {
-		r.handleBuildError(*config.Build, config.Builder, err, config.BuildLogger)
-		return
+		return r.handleBuildProcessError(ctx, config, err)
+	}
+
+	if ctx.Err() != nil {
+		return false
 	}
 
-	image, user, err := config.Builder.Build(*config.Build)
+	image, user, err := config.Builder.Build(ctx, *config.Build)
 	if err != nil {
-		r.handleBuildError(*config.Build, config.Builder, err, config.BuildLogger)
-		return
+		return r.handleBuildProcessError(ctx, config, err)
 	}
 
 	config.Build.Image = image
 	config.Build.User = user
 	config.Build.State = BuildStateSuccess
-	err = r.buildStore.Save(config.Build)
+	err = r.saveBuild(ctx, config.Build)
 	if err != nil {
-		r.handleBuildError(*config.Build, config.Builder, err, config.BuildLogger)
-		return
+		return r.handleBuildProcessError(ctx, config, err)
 	}
 
-	err = config.Builder.Publish(*config.Build)
+	err = config.Builder.Publish(ctx, *config.Build)
 	if err != nil {
-		r.handleBuildError(*config.Build, config.Builder, err, config.BuildLogger)
-		return
+		return r.handleBuildProcessError(ctx, config, err)
 	}
 
 	config.Build.State = BuildStatePublished
-	err = r.buildStore.Save(config.Build)
+	err = r.saveBuild(ctx, config.Build)
 	if err != nil {
-		r.handleBuildError(*config.Build, config.Builder, err, config.BuildLogger)
-		return
+		return r.handleBuildProcessError(ctx, config, err)
 	}
 
 	err = config.Builder.CleanUp()
@@ -291,6 +398,133 @@ func (r *BuildRunner) RunBuildProcess(config BuildProcessConfig) {
 	if r.telemetryEnabled {
 		r.logTelemetry(context.Background(), *config.Build, err)
 	}
+
+	return true
+}
+
+// saveBuild prevents an interrupted build process from overwriting the state set on interruption.
+// Builds cancelled before the runner notices are not overwritten either
+func (r *BuildRunner) saveBuild(ctx context.Context, b *Build) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	return r.buildStore.SaveIfState(b, runnableBuildStates)
+}
+
+func (r *BuildRunner) handleBuildProcessError(ctx context.Context, config BuildProcessConfig, err error) bool {
+	// Errors caused by tearing down an interrupted build are handled on interruption
+	if ctx.Err() != nil || IsBuildStateChanged(err) {
+		return false
+	}
+
+	r.handleBuildRunError(*config.Build, config.Builder, err, config.BuildLogger)
+	return true
+}
+
+func (r *BuildRunner) watchBuildCancellation(ctx context.Context, cancel context.CancelFunc, buildId string) {
+	ticker := time.NewTicker(cancellationPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			b, err := r.buildStore.Find(&Filter{
+				Id: &buildId,
+			})
+			if err != nil {
+				continue
+			}
+
+			if b.State == BuildStateCancelled {
+				cancel()
+				return
+			}
+		}
+	}
+}
+
+func (r *BuildRunner) handleBuildInterrupted(ctx context.Context, config BuildProcessConfig) {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		r.handleBuildRunError(*config.Build, config.Builder, fmt.Errorf("build timed out after %s", r.buildTimeout), config.BuildLogger)
+		return
+	}
+
+	var errMsg string
+	errMsg += "################################################\n"
+	errMsg += fmt.Sprintf("#### BUILD CANCELLED FOR %s\n", config.Build.Id)
+	errMsg += "################################################\n"
+
+	b := *config.Build
+	b.State = BuildStateCancelled
+	err := r.buildStore.SaveIfState(&b, slices.Concat(runnableBuildStates, []BuildState{BuildStateCancelled}))
+	if err != nil {
+		errMsg += fmt.Sprintf("Error saving build: %s\n", err.Error())
+	}
+
+	if config.Builder != nil {
+		cleanupErr := config.Builder.CleanUp()
+		if cleanupErr != nil {
+			errMsg += fmt.Sprintf("Error cleaning up build: %s\n", cleanupErr.Error())
+		}
+	}
+
+	config.BuildLogger.Write([]byte(errMsg + "\n"))
+
+	if r.telemetryEnabled {
+		r.logTelemetry(context.Background(), b, err)
+	}
+}
+
+// handleBuildRunError re-queues the failed build with an exponential backoff until it runs out of retries
+func (r *BuildRunner) handleBuildRunError(b Build, builder IBuilder, err error, buildLogger logs.Logger) {
+	maxRetries := int(r.maxBuildRetries)
+	if b.MaxRetries != nil {
+		maxRetries = *b.MaxRetries
+	}
+
+	if b.RetryCount >= maxRetries {
+		r.handleBuildError(b, builder, err, buildLogger)
+		return
+	}
+
+	backoff := GetRetryBackoff(b.RetryCount)
+	nextRetryAt := time.Now().Add(backoff)
+
+	var errMsg string
+	errMsg += "################################################\n"
+	errMsg += fmt.Sprintf("#### BUILD FAILED FOR %s: %s\n", b.Id, err.Error())
+	errMsg += fmt.Sprintf("#### RETRYING IN %s (RETRY %d OF %d)\n", backoff, b.RetryCount+1, maxRetries)
+	errMsg += "################################################\n"
+
+	b.State = BuildStatePendingRun
+	b.RetryCount++
+	b.NextRetryAt = &nextRetryAt
+	err = r.buildStore.SaveIfState(&b, runnableBuildStates)
+	if err != nil {
+		errMsg += fmt.Sprintf("Error saving build: %s\n", err.Error())
+	}
+
+	if builder != nil {
+		cleanupErr := builder.CleanUp()
+		if cleanupErr != nil {
+			errMsg += fmt.Sprintf("Error cleaning up build: %s\n", cleanupErr.Error())
+		}
+	}
+
+	buildLogger.Write([]byte(errMsg + "\n"))
+}
+
+// GetRetryBackoff returns the delay before the next retry of a build that has already been retried retryCount times
+func GetRetryBackoff(retryCount int) time.Duration {
+	backoff := retryBaseBackoff
+	for i := 0; i < retryCount && backoff < retryMaxBackoff; i++ {
+		backoff *= 2
+	}
+
+	return min(backoff, retryMaxBackoff)
 }
 
 func (r *BuildRunner) handleBuildError(b Build, builder IBuilder, err error, buildLogger logs.Logger) {
@@ -300,7 +534,8 @@ func (r *BuildRunner) handleBuildError(b Build, builder IBuilder, err error, bui
 	errMsg += "################################################\n"
 
 	b.State = BuildStateError
-	err = r.buildStore.Save(&b)
+	// Builds that were cancelled in the meantime keep their state
+	err = r.buildStore.SaveIfState(&b, slices.Concat(runnableBuildStates, []BuildState{BuildStatePendingDelete, BuildStateDeleting}))
 	if err != nil {
 		errMsg += fmt.Sprintf("Error saving build: %s\n", err.Error())
 	}
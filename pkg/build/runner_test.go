@@ -4,12 +4,16 @@
 package build_test
 
 import (
+	"context"
+	"errors"
 	"testing"
+	"time"
 
 	t_build "github.com/daytonaio/daytona/internal/testing/build"
 	git_mocks "github.com/daytonaio/daytona/internal/testing/git/mocks"
 	logger_mocks "github.com/daytonaio/daytona/internal/testing/logger/mocks"
 	"github.com/daytonaio/daytona/internal/testing/server/workspaces/mocks"
+	"github.com/daytonaio/daytona/internal/util"
 	"github.com/daytonaio/daytona/pkg/build"
 	t_gitprovider "github.com/daytonaio/daytona/pkg/build/mocks"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
@@ -35,7 +39,7 @@ type BuildRunnerTestSuite struct {
 	loggerFactory              logs.LoggerFactory
 	mockBuildStore             build.Store
 	mockGitProviderConfigStore t_gitprovider.MockGitProviderConfigStore
-	Runner                     build.BuildRunner
+	Runner                     *build.BuildRunner
 }
 
 func NewBuildRunnerTestSuite() *BuildRunnerTestSuite {
@@ -54,7 +58,7 @@ func TestBuildRunner(t *testing.T) {
 	logTempDir := t.TempDir()
 	s.loggerFactory = logs.NewLoggerFactory(nil, &logTempDir)
 
-	s.Runner = *build.NewBuildRunner(build.BuildRunnerInstanceConfig{
+	s.Runner = build.NewBuildRunner(build.BuildRunnerInstanceConfig{
 		Interval:         "0 */5 * * * *",
 		Scheduler:        &s.mockScheduler,
 		BuildRunnerId:    "1",
@@ -103,24 +107,24 @@ func (s *BuildRunnerTestSuite) TestRun() {
 func (s *BuildRunnerTestSuite) TestRunBuildProcess() {
 	pendingBuild := *mocks.MockBuild
 	s.mockGitProviderConfigStore.On("GetConfigForUrl", pendingBuild.Repository.Url).Return(&gitProviderConfig, nil)
-	s.mockGitService.On("CloneRepository", pendingBuild.Repository, &http.BasicAuth{
+	s.mockGitService.On("CloneRepository", mock.Anything, pendingBuild.Repository, &http.BasicAuth{
 		Username: gitProviderConfig.Username,
 	}).Return(nil)
 
 	mockGitService := git_mocks.NewMockGitService()
-	mockGitService.On("CloneRepository", pendingBuild.Repository, &http.BasicAuth{
+	mockGitService.On("CloneRepository", mock.Anything, pendingBuild.Repository, &http.BasicAuth{
 		Username: gitProviderConfig.Username,
 	}).Return(nil)
 
 	runningBuild := *mocks.MockBuild
 	runningBuild.State = build.BuildStateRunning
-	s.mockBuilder.On("Build", runningBuild).Return("image", "user", nil)
+	s.mockBuilder.On("Build", mock.Anything, runningBuild).Return("image", "user", nil)
 
 	successBuild := *mocks.MockBuild
 	successBuild.State = build.BuildStateSuccess
 	successBuild.Image = "image"
 	successBuild.User = "user"
-	s.mockBuilder.On("Publish", successBuild).Return(nil)
+	s.mockBuilder.On("Publish", mock.Anything, successBuild).Return(nil)
 
 	s.mockBuilder.On("CleanUp").Return(nil)
 
@@ -144,3 +148,195 @@ func (s *BuildRunnerTestSuite) TestRunBuildProcess() {
 	s.Require().Equal(mocks.MockBuild.User, "user")
 	s.Require().Equal(mocks.MockBuild.State, build.BuildStatePublished)
 }
+
+func (s *BuildRunnerTestSuite) TestRunBuildProcess_Cancelled() {
+	pendingBuild := *mocks.MockBuild
+	pendingBuild.Id = "2"
+	pendingBuild.State = build.BuildStatePendingRun
+	s.Require().NoError(s.mockBuildStore.Save(&pendingBuild))
+
+	s.mockGitProviderConfigStore.On("GetConfigForUrl", pendingBuild.Repository.Url).Return(&gitProviderConfig, nil)
+
+	var cloneErr error
+
+	mockGitService := git_mocks.NewMockGitService()
+	mockGitService.On("CloneRepository", mock.Anything, pendingBuild.Repository, mock.Anything).Run(func(args mock.Arguments) {
+		cancelledBuild := pendingBuild
+		cancelledBuild.State = build.BuildStateCancelled
+		err := s.mockBuildStore.Save(&cancelledBuild)
+		s.Require().NoError(err)
+
+		// The clone is only stopped by cancelling its context
+		ctx := args.Get(0).(context.Context)
+		<-ctx.Done()
+		cloneErr = ctx.Err()
+	}).Return(context.Canceled)
+
+	mockBuilder := mocks.MockBuilder{}
+	mockBuilder.On("CleanUp").Return(nil)
+
+	mockLogger := logger_mocks.NewMockLogger()
+	mockLogger.On("Write", mock.Anything).Return(0, nil)
+
+	s.Runner.RunBuildProcess(build.BuildProcessConfig{
+		Builder:     &mockBuilder,
+		BuildLogger: mockLogger,
+		Build:       &pendingBuild,
+		ProjectDir:  "",
+		GitService:  mockGitService,
+		Wg:          nil,
+	})
+
+	mockBuilder.AssertExpectations(s.T())
+	mockBuilder.AssertNotCalled(s.T(), "Build", mock.Anything, mock.Anything)
+	s.Require().ErrorIs(cloneErr, context.Canceled)
+
+	b, err := s.mockBuildStore.Find(&build.Filter{
+		Id: &pendingBuild.Id,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(build.BuildStateCancelled, b.State)
+}
+
+func (s *BuildRunnerTestSuite) TestRunBuildProcess_CancelledBeforeSave() {
+	pendingBuild := *mocks.MockBuild
+	pendingBuild.Id = "5"
+	pendingBuild.State = build.BuildStatePendingRun
+	s.Require().NoError(s.mockBuildStore.Save(&pendingBuild))
+
+	s.mockGitProviderConfigStore.On("GetConfigForUrl", pendingBuild.Repository.Url).Return(&gitProviderConfig, nil)
+
+	mockGitService := git_mocks.NewMockGitService()
+	mockGitService.On("CloneRepository", mock.Anything, pendingBuild.Repository, mock.Anything).Return(nil)
+
+	// The build is cancelled while the runner still works on its own copy
+	mockBuilder := mocks.MockBuilder{}
+	mockBuilder.On("Build", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		cancelledBuild := pendingBuild
+		cancelledBuild.State = build.BuildStateCancelled
+		s.Require().NoError(s.mockBuildStore.Save(&cancelledBuild))
+	}).Return("image", "user", nil)
+	mockBuilder.On("CleanUp").Return(nil)
+
+	mockLogger := logger_mocks.NewMockLogger()
+	mockLogger.On("Write", mock.Anything).Return(0, nil)
+
+	s.Runner.RunBuildProcess(build.BuildProcessConfig{
+		Builder:     &mockBuilder,
+		BuildLogger: mockLogger,
+		Build:       &pendingBuild,
+		GitService:  mockGitService,
+	})
+
+	mockBuilder.AssertNotCalled(s.T(), "Publish", mock.Anything, mock.Anything)
+
+	b, err := s.mockBuildStore.Find(&build.Filter{
+		Id: &pendingBuild.Id,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(build.BuildStateCancelled, b.State)
+}
+
+func (s *BuildRunnerTestSuite) TestRunBuildProcess_TimeoutStopsBuilder() {
+	timedOutBuild := *mocks.MockBuild
+	timedOutBuild.Id = "4"
+	timedOutBuild.State = build.BuildStatePendingRun
+	timedOutBuild.MaxRetries = util.Pointer(0)
+	s.Require().NoError(s.mockBuildStore.Save(&timedOutBuild))
+
+	s.mockGitProviderConfigStore.On("GetConfigForUrl", timedOutBuild.Repository.Url).Return(&gitProviderConfig, nil)
+
+	mockGitService := git_mocks.NewMockGitService()
+	mockGitService.On("CloneRepository", mock.Anything, timedOutBuild.Repository, mock.Anything).Return(nil)
+
+	var buildErr error
+
+	mockBuilder := mocks.MockBuilder{}
+	mockBuilder.On("Build", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		ctx := args.Get(0).(context.Context)
+		<-ctx.Done()
+		buildErr = ctx.Err()
+	}).Return("", "", context.DeadlineExceeded)
+	mockBuilder.On("CleanUp").Return(nil)
+
+	mockLogger := logger_mocks.NewMockLogger()
+	mockLogger.On("Write", mock.Anything).Return(0, nil)
+
+	runner := build.NewBuildRunner(build.BuildRunnerInstanceConfig{
+		BuildStore:       s.mockBuildStore,
+		GitProviderStore: &s.mockGitProviderConfigStore,
+		LoggerFactory:    s.loggerFactory,
+		BuildTimeout:     100 * time.Millisecond,
+	})
+
+	runner.RunBuildProcess(build.BuildProcessConfig{
+		Builder:     &mockBuilder,
+		BuildLogger: mockLogger,
+		Build:       &timedOutBuild,
+		GitService:  mockGitService,
+	})
+
+	s.Require().ErrorIs(buildErr, context.DeadlineExceeded)
+	mockBuilder.AssertNotCalled(s.T(), "Publish", mock.Anything, mock.Anything)
+
+	b, err := s.mockBuildStore.Find(&build.Filter{
+		Id: &timedOutBuild.Id,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(build.BuildStateError, b.State)
+}
+
+func (s *BuildRunnerTestSuite) TestRunBuildProcess_Retry() {
+	failingBuild := *mocks.MockBuild
+	failingBuild.Id = "3"
+	failingBuild.State = build.BuildStatePendingRun
+	failingBuild.MaxRetries = util.Pointer(1)
+	s.Require().NoError(s.mockBuildStore.Save(&failingBuild))
+
+	s.mockGitProviderConfigStore.On("GetConfigForUrl", failingBuild.Repository.Url).Return(&gitProviderConfig, nil)
+
+	mockGitService := git_mocks.NewMockGitService()
+	mockGitService.On("CloneRepository", mock.Anything, failingBuild.Repository, mock.Anything).Return(nil)
+
+	mockBuilder := mocks.MockBuilder{}
+	mockBuilder.On("Build", mock.Anything, mock.Anything).Return("", "", errors.New("registry unavailable"))
+	mockBuilder.On("CleanUp").Return(nil)
+
+	mockLogger := logger_mocks.NewMockLogger()
+	mockLogger.On("Write", mock.Anything).Return(0, nil)
+
+	runBuildProcess := func() *build.Build {
+		s.Runner.RunBuildProcess(build.BuildProcessConfig{
+			Builder:     &mockBuilder,
+			BuildLogger: mockLogger,
+			Build:       &failingBuild,
+			ProjectDir:  "",
+			GitService:  mockGitService,
+			Wg:          nil,
+		})
+
+		b, err := s.mockBuildStore.Find(&build.Filter{
+			Id: &failingBuild.Id,
+		})
+		s.Require().NoError(err)
+		return b
+	}
+
+	b := runBuildProcess()
+	s.Require().Equal(build.BuildStatePendingRun, b.State)
+	s.Require().Equal(1, b.RetryCount)
+	s.Require().NotNil(b.NextRetryAt)
+	s.Require().True(b.NextRetryAt.After(time.Now()))
+
+	failingBuild = *b
+	b = runBuildProcess()
+	s.Require().Equal(build.BuildStateError, b.State)
+	s.Require().Equal(1, b.RetryCount)
+}
+
+func (s *BuildRunnerTestSuite) TestGetRetryBackoff() {
+	s.Require().Equal(30*time.Second, build.GetRetryBackoff(0))
+	s.Require().Equal(time.Minute, build.GetRetryBackoff(1))
+	s.Require().Equal(4*time.Minute, build.GetRetryBackoff(3))
+	s.Require().Equal(30*time.Minute, build.GetRetryBackoff(20))
+}
@@ -13,17 +13,25 @@ type Store interface {
 	Find(filter *Filter) (*Build, error)
 	List(filter *Filter) ([]*Build, error)
 	Save(build *Build) error
+	// SaveIfState saves the build only if its stored state is one of the given states.
+	// It returns ErrBuildStateChanged otherwise, e.g. when the build was cancelled in the meantime
+	SaveIfState(build *Build, states []BuildState) error
 	Delete(id string) error
 }
 
 var (
-	ErrBuildNotFound = errors.New("build not found")
+	ErrBuildNotFound     = errors.New("build not found")
+	ErrBuildStateChanged = errors.New("build state changed")
 )
 
 func IsBuildNotFound(err error) bool {
 	return err.Error() == ErrBuildNotFound.Error()
 }
 
+func IsBuildStateChanged(err error) bool {
+	return err.Error() == ErrBuildStateChanged.Error()
+}
+
 type Filter struct {
 	Id            *string
 	States        *[]BuildState
@@ -11,9 +11,12 @@ import (
 	"path/filepath"
 
 	"github.com/daytonaio/daytona/pkg/agent"
+	"github.com/daytonaio/daytona/pkg/agent/activity"
 	"github.com/daytonaio/daytona/pkg/agent/config"
+	"github.com/daytonaio/daytona/pkg/agent/metrics"
 	"github.com/daytonaio/daytona/pkg/agent/ssh"
 	"github.com/daytonaio/daytona/pkg/agent/tailscale"
+	"github.com/daytonaio/daytona/pkg/agent/toolbox"
 	"github.com/daytonaio/daytona/pkg/git"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
 	log "github.com/sirupsen/logrus"
@@ -63,9 +66,12 @@ var AgentCmd = &cobra.Command{
 			LogWriter:         gitLogWriter,
 		}
 
+		activityTracker := activity.NewTracker()
+
 		sshServer := &ssh.Server{
 			ProjectDir:        c.ProjectDir,
 			DefaultProjectDir: os.Getenv("HOME"),
+			ActivityTracker:   activityTracker,
 		}
 
 		tailscaleHostname := project.GetProjectHostname(c.WorkspaceId, c.ProjectName)
@@ -80,6 +86,7 @@ var AgentCmd = &cobra.Command{
 			Server:           c.Server,
 			TelemetryEnabled: telemetryEnabled,
 			ClientId:         c.ClientId,
+			ActivityTracker:  activityTracker,
 		}
 
 		agent := agent.Agent{
@@ -87,7 +94,10 @@ var AgentCmd = &cobra.Command{
 			Git:              git,
 			Ssh:              sshServer,
 			Tailscale:        tailscaleServer,
+			Toolbox:          &toolbox.Server{Git: git},
 			LogWriter:        agentLogWriter,
+			ActivityTracker:  activityTracker,
+			MetricsCollector: metrics.NewCollector(c.ProjectDir),
 			TelemetryEnabled: telemetryEnabled,
 		}
 
@@ -19,4 +19,5 @@ func init() {
 	ApiKeyCmd.AddCommand(GenerateCmd)
 	ApiKeyCmd.AddCommand(revokeCmd)
 	ApiKeyCmd.AddCommand(listCmd)
+	ApiKeyCmd.AddCommand(rotateCmd)
 }
@@ -5,6 +5,7 @@ package apikey
 
 import (
 	"context"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -16,6 +17,9 @@ import (
 	view "github.com/daytonaio/daytona/pkg/views/server/apikey"
 )
 
+var roleFlag string
+var expiresInFlag time.Duration
+
 var GenerateCmd = &cobra.Command{
 	Use:     "generate [NAME]",
 	Short:   "Generate a new API key",
@@ -47,9 +51,17 @@ var GenerateCmd = &cobra.Command{
 			}
 		}
 
-		key, _, err := apiClient.ApiKeyAPI.GenerateApiKey(ctx, keyName).Execute()
+		req := apiClient.ApiKeyAPI.GenerateApiKey(ctx, keyName)
+		if roleFlag != "" {
+			req = req.Role(roleFlag)
+		}
+		if expiresInFlag > 0 {
+			req = req.ExpiresAt(time.Now().Add(expiresInFlag).Format(time.RFC3339))
+		}
+
+		key, res, err := req.Execute()
 		if err != nil {
-			log.Fatal(apiclient_util.HandleErrorResponse(nil, err))
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
 		}
 
 		serverConfig, _, err := apiClient.ServerAPI.GetConfigExecute(apiclient.ApiGetConfigRequest{})
@@ -66,3 +78,8 @@ var GenerateCmd = &cobra.Command{
 		view.Render(key, apiUrl)
 	},
 }
+
+func init() {
+	GenerateCmd.Flags().StringVar(&roleFlag, "role", "", "Role of the API key: admin, developer (workspace management only) or read-only. Defaults to admin")
+	GenerateCmd.Flags().DurationVar(&expiresInFlag, "expires-in", 0, "Duration after which the key expires (e.g. 720h). The key never expires if omitted")
+}
@@ -0,0 +1,120 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package apikey
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/apikeys"
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/common"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/daytonaio/daytona/pkg/views/server/apikey"
+)
+
+var gracePeriodFlag time.Duration
+
+var rotateCmd = &cobra.Command{
+	Use:   "rotate [NAME]",
+	Short: "Replace an API key with a new one",
+	Long:  "Replace an API key with a new one. The replaced key stays valid for the grace period so that its users can switch to the new key",
+	Args:  cobra.RangeArgs(0, 1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+
+		c, err := config.GetConfig()
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		var selectedApiKey *apiclient.ApiKey
+
+		apiKeyList, res, err := apiClient.ApiKeyAPI.ListClientApiKeys(ctx).Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+
+		if len(args) == 1 {
+			for _, apiKey := range apiKeyList {
+				if apiKey.Name == args[0] {
+					selectedApiKey = &apiKey
+					break
+				}
+			}
+		} else {
+			selectedApiKey, err = apikey.GetApiKeyFromPrompt(apiKeyList, "Select an API key to rotate", false)
+			if err != nil {
+				if common.IsCtrlCAbort(err) {
+					return
+				} else {
+					log.Fatal(err)
+				}
+			}
+		}
+
+		if selectedApiKey == nil {
+			log.Fatal("No API key selected")
+		}
+
+		req := apiClient.ApiKeyAPI.RotateApiKey(ctx, selectedApiKey.Name).GracePeriod(gracePeriodFlag.String())
+		if expiresInFlag > 0 {
+			req = req.ExpiresAt(time.Now().Add(expiresInFlag).Format(time.RFC3339))
+		}
+
+		key, res, err := req.Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+
+		// The active profile would otherwise lose access once the grace period ends
+		if apikeys.EqualsKeyHashFromApi(activeProfile.Api.Key, selectedApiKey.KeyHash) {
+			activeProfile.Api.Key = key
+			err = c.EditProfile(activeProfile)
+			if err != nil {
+				log.Fatal(err)
+			}
+			views.RenderInfoMessageBold(fmt.Sprintf("The active profile now uses the new key for '%s'", selectedApiKey.Name))
+		}
+
+		if gracePeriodFlag > 0 {
+			views.RenderInfoMessage(fmt.Sprintf("The replaced key stays valid for %s", util.FormatUptime(int32(gracePeriodFlag.Seconds()))))
+		}
+
+		serverConfig, res, err := apiClient.ServerAPI.GetConfig(ctx).Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+
+		if serverConfig.Frps == nil {
+			log.Fatal("frps config is missing")
+		}
+
+		apiUrl := util.GetFrpcApiUrl(serverConfig.Frps.Protocol, serverConfig.Id, serverConfig.Frps.Domain)
+
+		apikey.Render(key, apiUrl)
+	},
+}
+
+func init() {
+	rotateCmd.Flags().DurationVar(&gracePeriodFlag, "grace-period", 24*time.Hour, "Duration for which the replaced key stays valid. 0 revokes it immediately")
+	rotateCmd.Flags().DurationVar(&expiresInFlag, "expires-in", 0, "Duration after which the new key expires (e.g. 720h). The key never expires if omitted")
+}
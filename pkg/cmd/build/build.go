@@ -20,5 +20,6 @@ func init() {
 	BuildCmd.AddCommand(buildInfoCmd)
 	BuildCmd.AddCommand(buildRunCmd)
 	BuildCmd.AddCommand(buildDeleteCmd)
+	BuildCmd.AddCommand(buildCancelCmd)
 	BuildCmd.AddCommand(buildLogsCmd)
 }
@@ -0,0 +1,64 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
+	"github.com/spf13/cobra"
+)
+
+var buildCancelCmd = &cobra.Command{
+	Use:   "cancel [BUILD]",
+	Short: "Cancel a pending or running build",
+	Args:  cobra.RangeArgs(0, 1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+		var buildId string
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if len(args) == 0 {
+			buildList, res, err := apiClient.BuildAPI.ListBuilds(ctx).Execute()
+			if err != nil {
+				log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+			}
+
+			var activeBuilds []apiclient.Build
+			for _, b := range buildList {
+				if b.State == apiclient.BuildStatePendingRun || b.State == apiclient.BuildStateRunning {
+					activeBuilds = append(activeBuilds, b)
+				}
+			}
+
+			if len(activeBuilds) == 0 {
+				views.RenderInfoMessage("There are no pending or running builds")
+				return
+			}
+
+			build := selection.GetBuildFromPrompt(activeBuilds, "Cancel")
+			if build == nil {
+				return
+			}
+			buildId = build.Id
+		} else {
+			buildId = args[0]
+		}
+
+		res, err := apiClient.BuildAPI.CancelBuild(ctx, buildId).Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+		views.RenderInfoMessage(fmt.Sprintf("Build %s cancelled", buildId))
+	},
+}
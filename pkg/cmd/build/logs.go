@@ -100,6 +100,7 @@ func waitForBuildToComplete(buildId string, apiClient *apiclient.APIClient) (*bo
 		completedStates := []apiclient.BuildBuildState{
 			apiclient.BuildStatePublished,
 			apiclient.BuildStateError,
+			apiclient.BuildStateCancelled,
 		}
 
 		if slices.Contains(completedStates, build.State) {
@@ -32,6 +32,16 @@ var buildRunCmd = &cobra.Command{
 			log.Fatal(err)
 		}
 
+		if retryFlag != "" {
+			res, err := apiClient.BuildAPI.RetryBuild(ctx, retryFlag).Execute()
+			if err != nil {
+				log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+			}
+
+			views.RenderViewBuildLogsMessage(retryFlag)
+			return
+		}
+
 		projectConfigList, res, err := apiClient.ProjectConfigAPI.ListProjectConfigs(ctx).Execute()
 		if err != nil {
 			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
@@ -96,3 +106,9 @@ func CreateBuild(apiClient *apiclient.APIClient, projectConfig *apiclient.Projec
 
 	return buildId, nil
 }
+
+var retryFlag string
+
+func init() {
+	buildRunCmd.Flags().StringVar(&retryFlag, "retry", "", "Re-queue a failed or cancelled build with the given ID instead of creating a new build")
+}
@@ -22,9 +22,11 @@ import (
 	. "github.com/daytonaio/daytona/pkg/cmd/profiledata/env"
 	. "github.com/daytonaio/daytona/pkg/cmd/projectconfig"
 	. "github.com/daytonaio/daytona/pkg/cmd/provider"
+	. "github.com/daytonaio/daytona/pkg/cmd/secret"
 	. "github.com/daytonaio/daytona/pkg/cmd/server"
 	. "github.com/daytonaio/daytona/pkg/cmd/target"
 	. "github.com/daytonaio/daytona/pkg/cmd/telemetry"
+	. "github.com/daytonaio/daytona/pkg/cmd/webhook"
 	. "github.com/daytonaio/daytona/pkg/cmd/workspace"
 	"github.com/daytonaio/daytona/pkg/common"
 	"github.com/daytonaio/daytona/pkg/posthogservice"
@@ -58,6 +60,8 @@ func Execute() {
 	rootCmd.AddCommand(ServerCmd)
 	rootCmd.AddCommand(ApiKeyCmd)
 	rootCmd.AddCommand(ContainerRegistryCmd)
+	rootCmd.AddCommand(WebhookCmd)
+	rootCmd.AddCommand(SecretCmd)
 	rootCmd.AddCommand(ProviderCmd)
 	rootCmd.AddCommand(TargetCmd)
 	rootCmd.AddCommand(ideCmd)
@@ -68,6 +72,18 @@ func Execute() {
 	rootCmd.AddCommand(GitProviderCmd)
 	rootCmd.AddCommand(StartCmd)
 	rootCmd.AddCommand(StopCmd)
+	rootCmd.AddCommand(ExtendCmd)
+	rootCmd.AddCommand(AddProjectCmd)
+	rootCmd.AddCommand(RemoveProjectCmd)
+	rootCmd.AddCommand(ApplyCmd)
+	rootCmd.AddCommand(DiffCmd)
+	rootCmd.AddCommand(CloneCmd)
+	rootCmd.AddCommand(MigrateCmd)
+	rootCmd.AddCommand(SnapshotCmd)
+	rootCmd.AddCommand(RestoreCmd)
+	rootCmd.AddCommand(SnapshotsCmd)
+	rootCmd.AddCommand(DeleteSnapshotCmd)
+	rootCmd.AddCommand(TopCmd)
 	rootCmd.AddCommand(InfoCmd)
 	rootCmd.AddCommand(PrebuildCmd)
 	rootCmd.AddCommand(BuildCmd)
@@ -5,11 +5,14 @@ package gitprovider
 
 import (
 	"context"
+	"fmt"
 
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
 	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/views"
 	gitprovider_view "github.com/daytonaio/daytona/pkg/views/gitprovider"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	"github.com/pkg/browser"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
@@ -26,6 +29,11 @@ var GitProviderAddCmd = &cobra.Command{
 			log.Fatal(err)
 		}
 
+		if oauthFlag {
+			addGitProviderWithOAuth(ctx, apiClient)
+			return
+		}
+
 		setGitProviderConfig := apiclient.SetGitProviderConfig{}
 		setGitProviderConfig.BaseApiUrl = new(string)
 		setGitProviderConfig.Username = new(string)
@@ -44,3 +52,51 @@ var GitProviderAddCmd = &cobra.Command{
 		views.RenderInfoMessage("Git provider has been registered")
 	},
 }
+
+var oauthFlag bool
+
+func addGitProviderWithOAuth(ctx context.Context, apiClient *apiclient.APIClient) {
+	var gitProviderId string
+	startOAuthDeviceFlow := apiclient.StartOAuthDeviceFlow{
+		BaseApiUrl: new(string),
+	}
+
+	gitprovider_view.OAuthGitProviderSelectionView(&gitProviderId, startOAuthDeviceFlow.BaseApiUrl)
+
+	if gitProviderId == "" {
+		return
+	}
+
+	if *startOAuthDeviceFlow.BaseApiUrl == "" {
+		startOAuthDeviceFlow.BaseApiUrl = nil
+	}
+
+	deviceCode, res, err := apiClient.GitProviderAPI.StartOAuthDeviceFlow(ctx, gitProviderId).Request(startOAuthDeviceFlow).Execute()
+	if err != nil {
+		log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+	}
+
+	views.RenderInfoMessage(fmt.Sprintf("Open %s and enter the code %s to authorize Daytona", deviceCode.VerificationUri, deviceCode.UserCode))
+
+	err = browser.OpenURL(deviceCode.VerificationUri)
+	if err != nil {
+		log.Debug(err)
+	}
+
+	err = views_util.WithSpinner("Waiting for authorization", func() error {
+		res, err = apiClient.GitProviderAPI.CompleteOAuthDeviceFlow(ctx, gitProviderId).Request(apiclient.CompleteOAuthDeviceFlow{
+			BaseApiUrl: startOAuthDeviceFlow.BaseApiUrl,
+			DeviceCode: *deviceCode,
+		}).Execute()
+		return err
+	})
+	if err != nil {
+		log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+	}
+
+	views.RenderInfoMessage("Git provider has been registered")
+}
+
+func init() {
+	GitProviderAddCmd.Flags().BoolVar(&oauthFlag, "oauth", false, "Authorize with the Git provider through the OAuth device flow instead of a personal access token")
+}
@@ -6,9 +6,11 @@ package gitprovider
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
-	"github.com/daytonaio/daytona/internal/util/apiclient"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/cmd/format"
 	"github.com/daytonaio/daytona/pkg/views"
 	gitprovider_view "github.com/daytonaio/daytona/pkg/views/gitprovider"
@@ -21,14 +23,14 @@ var gitProviderListCmd = &cobra.Command{
 	Aliases: []string{"ls"},
 	Short:   "Lists your registered Git providers",
 	Run: func(cmd *cobra.Command, args []string) {
-		apiClient, err := apiclient.GetApiClient(nil)
+		apiClient, err := apiclient_util.GetApiClient(nil)
 		if err != nil {
 			log.Fatal(err)
 		}
 
 		gitProviders, res, err := apiClient.GitProviderAPI.ListGitProviders(context.Background()).Execute()
 		if err != nil {
-			log.Fatal(apiclient.HandleErrorResponse(res, err))
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
 		}
 
 		if len(gitProviders) == 0 {
@@ -44,11 +46,18 @@ var gitProviderListCmd = &cobra.Command{
 		for _, gitProvider := range gitProviders {
 			for _, supportedProvider := range supportedProviders {
 				if gitProvider.Id == supportedProvider.Id {
+					tokenStatus := "token health unknown"
+					tokenHealth, _, err := apiClient.GitProviderAPI.GetTokenHealth(context.Background(), gitProvider.Id).Execute()
+					if err == nil {
+						tokenStatus = getTokenStatus(tokenHealth)
+					}
+
 					gitProviderViewList = append(gitProviderViewList,
 						gitprovider_view.GitProviderView{
-							Id:       gitProvider.Id,
-							Name:     supportedProvider.Name,
-							Username: gitProvider.Username,
+							Id:          gitProvider.Id,
+							Name:        supportedProvider.Name,
+							Username:    gitProvider.Username,
+							TokenStatus: tokenStatus,
 						},
 					)
 				}
@@ -62,11 +71,43 @@ var gitProviderListCmd = &cobra.Command{
 		}
 
 		for _, gitProviderView := range gitProviderViewList {
-			views.RenderListLine(fmt.Sprintf("%s (%s)", gitProviderView.Name, gitProviderView.Username))
+			views.RenderListLine(fmt.Sprintf("%s (%s) - %s", gitProviderView.Name, gitProviderView.Username, gitProviderView.TokenStatus))
 		}
 	},
 }
 
+func getTokenStatus(tokenHealth *apiclient.TokenHealth) string {
+	expiresAt := ""
+	if tokenHealth.ExpiresAt != nil {
+		t, err := time.Parse(time.RFC3339, *tokenHealth.ExpiresAt)
+		if err == nil {
+			expiresAt = t.Local().Format(time.DateTime)
+		}
+	}
+
+	switch tokenHealth.Status {
+	case apiclient.TokenStatusExpiring:
+		return fmt.Sprintf("token expires on %s", expiresAt)
+	case apiclient.TokenStatusExpired:
+		return fmt.Sprintf("token expired on %s", expiresAt)
+	case apiclient.TokenStatusInvalid:
+		if tokenHealth.Error != nil {
+			return fmt.Sprintf("token invalid: %s", *tokenHealth.Error)
+		}
+		return "token invalid"
+	}
+
+	if tokenHealth.Refreshable {
+		return "token valid, refreshed automatically"
+	}
+
+	if expiresAt != "" {
+		return fmt.Sprintf("token valid until %s", expiresAt)
+	}
+
+	return "token valid"
+}
+
 func init() {
 	format.RegisterFormatFlag(gitProviderListCmd)
 }
@@ -101,9 +101,19 @@ var prebuildAddCmd = &cobra.Command{
 
 		}
 
+		var maxRetries *int32
+		if prebuildAddView.MaxRetries != "" {
+			retries, err := strconv.Atoi(prebuildAddView.MaxRetries)
+			if err != nil {
+				log.Fatal("max retries must be a number")
+			}
+			maxRetries = util.Pointer(int32(retries))
+		}
+
 		newPrebuild := apiclient.CreatePrebuildDTO{
-			Branch:    &prebuildAddView.Branch,
-			Retention: int32(retention),
+			Branch:     &prebuildAddView.Branch,
+			Retention:  int32(retention),
+			MaxRetries: maxRetries,
 		}
 
 		if commitInterval != 0 {
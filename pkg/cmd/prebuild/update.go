@@ -79,6 +79,9 @@ var prebuildUpdateCmd = &cobra.Command{
 
 		prebuildAddView.Branch = prebuild.Branch
 		prebuildAddView.Retention = strconv.Itoa(int(prebuild.Retention))
+		if prebuild.MaxRetries != nil {
+			prebuildAddView.MaxRetries = strconv.Itoa(int(*prebuild.MaxRetries))
+		}
 		prebuildAddView.ProjectConfigName = prebuild.ProjectConfigName
 
 		if prebuild.CommitInterval != nil {
@@ -105,10 +108,20 @@ var prebuildUpdateCmd = &cobra.Command{
 			log.Fatal("retention must be a number")
 		}
 
+		var maxRetries *int32
+		if prebuildAddView.MaxRetries != "" {
+			retries, err := strconv.Atoi(prebuildAddView.MaxRetries)
+			if err != nil {
+				log.Fatal("max retries must be a number")
+			}
+			maxRetries = util.Pointer(int32(retries))
+		}
+
 		newPrebuild := apiclient.CreatePrebuildDTO{
-			Id:        &prebuild.Id,
-			Branch:    &prebuildAddView.Branch,
-			Retention: int32(retention),
+			Id:         &prebuild.Id,
+			Branch:     &prebuildAddView.Branch,
+			Retention:  int32(retention),
+			MaxRetries: maxRetries,
 		}
 
 		if commitInterval != 0 {
@@ -82,6 +82,7 @@ func RunProjectConfigAddFlow(apiClient *apiclient.APIClient, gitProviders []apic
 		Image:                &apiServerConfig.DefaultProjectImage,
 		ImageUser:            &apiServerConfig.DefaultProjectUser,
 		DevcontainerFilePath: create.DEVCONTAINER_FILEPATH,
+		DockerfilePath:       create.DOCKERFILE_FILEPATH,
 	}
 
 	createDtos, err = workspace_util.GetProjectsCreationDataFromPrompt(workspace_util.ProjectsDataPromptConfig{
@@ -139,6 +140,8 @@ func RunProjectConfigAddFlow(apiClient *apiclient.APIClient, gitProviders []apic
 		User:          createDtos[0].User,
 		RepositoryUrl: createDtos[0].Source.Repository.Url,
 		EnvVars:       createDtos[0].EnvVars,
+		Secrets:       createDtos[0].Secrets,
+		Resources:     createDtos[0].Resources,
 	}
 
 	res, err = apiClient.ProjectConfigAPI.SetProjectConfig(ctx).ProjectConfig(newProjectConfig).Execute()
@@ -150,11 +153,13 @@ func RunProjectConfigAddFlow(apiClient *apiclient.APIClient, gitProviders []apic
 		BuildConfig:   newProjectConfig.BuildConfig,
 		Default:       false,
 		EnvVars:       newProjectConfig.EnvVars,
+		Secrets:       newProjectConfig.Secrets,
 		Image:         *newProjectConfig.Image,
 		Name:          newProjectConfig.Name,
 		Prebuilds:     nil,
 		RepositoryUrl: newProjectConfig.RepositoryUrl,
 		User:          *newProjectConfig.User,
+		Resources:     newProjectConfig.Resources,
 	}, nil
 }
 
@@ -163,6 +168,10 @@ func processCmdArgument(argument string, apiClient *apiclient.APIClient, ctx con
 		return nil, fmt.Errorf("can't set devcontainer file path if builder is not set to %s", views_util.DEVCONTAINER)
 	}
 
+	if *projectConfigurationFlags.Builder != "" && *projectConfigurationFlags.Builder != views_util.DOCKERFILE && workspace_util.CheckAnyDockerfileFlagSet(projectConfigurationFlags) {
+		return nil, fmt.Errorf("can't set Dockerfile options if builder is not set to %s", views_util.DOCKERFILE)
+	}
+
 	apiServerConfig, res, err := apiClient.ServerAPI.GetConfig(context.Background()).Execute()
 	if err != nil {
 		return nil, apiclient_util.HandleErrorResponse(res, err)
@@ -202,6 +211,8 @@ func processCmdArgument(argument string, apiClient *apiclient.APIClient, ctx con
 		User:          project.User,
 		RepositoryUrl: repoUrl,
 		EnvVars:       project.EnvVars,
+		Secrets:       project.Secrets,
+		Resources:     project.Resources,
 	}
 
 	if newProjectConfig.Image == nil {
@@ -238,13 +249,21 @@ func getExistingProjectConfigNames(apiClient *apiclient.APIClient) []string {
 var nameFlag string
 
 var projectConfigurationFlags = workspace_util.ProjectConfigurationFlags{
-	Builder:          new(views_util.BuildChoice),
-	CustomImage:      new(string),
-	CustomImageUser:  new(string),
-	Branch:           new(string),
-	DevcontainerPath: new(string),
-	EnvVars:          new([]string),
-	Manual:           new(bool),
+	Builder:             new(views_util.BuildChoice),
+	CustomImage:         new(string),
+	CustomImageUser:     new(string),
+	Branch:              new(string),
+	DevcontainerPath:    new(string),
+	DockerfilePath:      new(string),
+	DockerfileContext:   new(string),
+	DockerfileTarget:    new(string),
+	DockerfileBuildArgs: new([]string),
+	EnvVars:             new([]string),
+	Secrets:             new([]string),
+	Cpus:                new(float64),
+	Memory:              new(uint64),
+	Disk:                new(uint64),
+	Manual:              new(bool),
 }
 
 func init() {
@@ -66,9 +66,10 @@ var projectConfigUpdateCmd = &cobra.Command{
 		}
 
 		projectDefaults := &views_util.ProjectConfigDefaults{
-			BuildChoice: views_util.AUTOMATIC,
-			Image:       &projectConfig.Image,
-			ImageUser:   &projectConfig.User,
+			BuildChoice:    views_util.AUTOMATIC,
+			Image:          &projectConfig.Image,
+			ImageUser:      &projectConfig.User,
+			DockerfilePath: create.DOCKERFILE_FILEPATH,
 		}
 
 		if projectConfig.BuildConfig != nil && projectConfig.BuildConfig.Devcontainer != nil {
@@ -87,6 +88,7 @@ var projectConfigUpdateCmd = &cobra.Command{
 			User:          createDto[0].User,
 			RepositoryUrl: createDto[0].Source.Repository.Url,
 			EnvVars:       createDto[0].EnvVars,
+			Secrets:       projectConfig.Secrets,
 		}
 
 		res, err = apiClient.ProjectConfigAPI.SetProjectConfig(ctx).ProjectConfig(newProjectConfig).Execute()
@@ -103,7 +103,7 @@ var purgeCmd = &cobra.Command{
 		if err != nil {
 			log.Fatal(err)
 		}
-		buildRunner, err := server_cmd.GetBuildRunner(serverConfig, buildRunnerConfig, telemetryService)
+		buildRunner, err := server_cmd.GetBuildRunner(serverConfig, buildRunnerConfig, telemetryService, server.EventBus)
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -0,0 +1,50 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secret
+
+import (
+	"context"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	"github.com/daytonaio/daytona/pkg/views"
+	secret_view "github.com/daytonaio/daytona/pkg/views/secret"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var secretListCmd = &cobra.Command{
+	Use:     "list",
+	Short:   "List secrets",
+	Aliases: []string{"ls"},
+	Args:    cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		secrets, res, err := apiClient.SecretAPI.ListSecrets(context.Background()).Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(secrets)
+			formattedData.Print()
+			return
+		}
+
+		if len(secrets) == 0 {
+			views.RenderInfoMessage("No secrets found. Set a new secret by running 'daytona secret set'")
+			return
+		}
+
+		secret_view.ListSecrets(secrets)
+	},
+}
+
+func init() {
+	format.RegisterFormatFlag(secretListCmd)
+}
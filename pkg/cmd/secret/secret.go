@@ -0,0 +1,22 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secret
+
+import (
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/spf13/cobra"
+)
+
+var SecretCmd = &cobra.Command{
+	Use:     "secret",
+	Aliases: []string{"secrets"},
+	Short:   "Manage secrets injected into projects",
+	GroupID: util.SERVER_GROUP,
+}
+
+func init() {
+	SecretCmd.AddCommand(secretSetCmd)
+	SecretCmd.AddCommand(secretListCmd)
+	SecretCmd.AddCommand(secretDeleteCmd)
+}
@@ -0,0 +1,57 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secret
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	secret_view "github.com/daytonaio/daytona/pkg/views/secret"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+var secretSetCmd = &cobra.Command{
+	Use:   "set NAME [VALUE]",
+	Short: "Create a secret or replace its value",
+	Long:  "Create a secret or replace its value. The value is read from standard input or prompted for if it is not passed as an argument, which keeps it out of the shell history",
+	Args:  cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		name := args[0]
+
+		var value string
+		if len(args) == 2 {
+			value = args[1]
+		} else if !term.IsTerminal(int(os.Stdin.Fd())) {
+			input, err := io.ReadAll(os.Stdin)
+			if err != nil {
+				log.Fatal(err)
+			}
+			value = strings.TrimSuffix(string(input), "\n")
+		} else {
+			secret_view.SecretValueView(name, &value)
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		res, err := apiClient.SecretAPI.SetSecret(context.Background(), name).Secret(apiclient.SetSecretDTO{
+			Value: value,
+		}).Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Secret %s set successfully", name))
+	},
+}
@@ -0,0 +1,91 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	"github.com/daytonaio/daytona/pkg/views"
+	audit_view "github.com/daytonaio/daytona/pkg/views/server/audit"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var auditSinceFlag string
+var auditKeyFlag string
+var auditResourceFlag string
+
+var auditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "List the audit log of mutating API requests",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		req := apiClient.AuditAPI.ListAuditRecords(context.Background())
+
+		if auditSinceFlag != "" {
+			since, err := parseSince(auditSinceFlag)
+			if err != nil {
+				log.Fatal(err)
+			}
+			req = req.Since(since.Format(time.RFC3339))
+		}
+
+		if auditKeyFlag != "" {
+			req = req.Key(auditKeyFlag)
+		}
+
+		if auditResourceFlag != "" {
+			req = req.Resource(auditResourceFlag)
+		}
+
+		records, res, err := req.Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(records)
+			formattedData.Print()
+			return
+		}
+
+		if len(records) == 0 {
+			views.RenderInfoMessage("No audit records found")
+			return
+		}
+
+		audit_view.ListAuditRecords(records)
+	},
+}
+
+// parseSince accepts either a duration relative to now, e.g. 24h, or an RFC 3339 timestamp
+func parseSince(value string) (time.Time, error) {
+	duration, err := time.ParseDuration(value)
+	if err == nil {
+		return time.Now().Add(-duration), nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid value for --since: %s, expected a duration (e.g. 24h) or an RFC 3339 timestamp", value)
+	}
+
+	return t, nil
+}
+
+func init() {
+	auditCmd.Flags().StringVar(&auditSinceFlag, "since", "", "Only show records from this duration ago (e.g. 24h) or RFC 3339 timestamp onwards")
+	auditCmd.Flags().StringVar(&auditKeyFlag, "key", "", "Only show records of the API key with this name")
+	auditCmd.Flags().StringVar(&auditResourceFlag, "resource", "", "Only show records whose resource path starts with this value (e.g. /workspace/my-workspace)")
+	format.RegisterFormatFlag(auditCmd)
+}
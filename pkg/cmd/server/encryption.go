@@ -0,0 +1,125 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"path/filepath"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/pkg/db"
+	"github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/encryption"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/views"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var rotateEncryptionKeyCmd = &cobra.Command{
+	Use:   "rotate-encryption-key",
+	Short: "Re-encrypt the secrets stored by the server with a new key",
+	Long:  fmt.Sprintf("Re-encrypt the secrets stored by the server with a new key. The server must be stopped.\nIf the key is provided through %s, the variable has to be updated with the new key before the server is started again", encryption.KeyEnvVar),
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		err := rotateEncryptionKey()
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		views.RenderInfoMessage("Encryption key rotated")
+	},
+}
+
+func getEncryptionKeyFilePath() (string, error) {
+	configDir, err := config.GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(configDir, "encryption.key"), nil
+}
+
+// loadEncryptionKeyring returns the keyring that encrypts the secrets stored in the database
+// and whether its keys come from the environment instead of the key file
+func loadEncryptionKeyring() (*encryption.Keyring, bool, error) {
+	keyFilePath, err := getEncryptionKeyFilePath()
+	if err != nil {
+		return nil, false, err
+	}
+
+	keys, fromEnv, err := encryption.LoadKeys(keyFilePath)
+	if err != nil {
+		return nil, false, err
+	}
+
+	keyring, err := encryption.NewKeyring(keys...)
+	return keyring, fromEnv, err
+}
+
+func rotateEncryptionKey() error {
+	c, err := server.GetConfig()
+	if err != nil {
+		return err
+	}
+
+	// A running server would keep encrypting secrets with the previous key
+	conn, err := net.Dial("tcp", fmt.Sprintf(":%d", c.ApiPort))
+	if err == nil {
+		conn.Close()
+		return errors.New("the server is running, stop it with 'daytona server stop' before rotating the encryption key")
+	}
+
+	keyFilePath, err := getEncryptionKeyFilePath()
+	if err != nil {
+		return err
+	}
+
+	currentKeys, fromEnv, err := encryption.LoadKeys(keyFilePath)
+	if err != nil {
+		return err
+	}
+
+	newKey, err := encryption.GenerateKey()
+	if err != nil {
+		return err
+	}
+
+	keys := append([][]byte{newKey}, currentKeys...)
+
+	// The previous keys are kept until all secrets are re-encrypted so that none become unreadable if the rotation is interrupted
+	if !fromEnv {
+		err = encryption.WriteKeyFile(keyFilePath, keys...)
+		if err != nil {
+			return err
+		}
+	}
+
+	keyring, err := encryption.NewKeyring(keys...)
+	if err != nil {
+		return err
+	}
+
+	dto.SetEncryptionKeyring(keyring)
+
+	dbPath, err := getDbPath()
+	if err != nil {
+		return err
+	}
+
+	err = db.ReencryptSecrets(db.GetSQLiteConnection(dbPath))
+	if err != nil {
+		return fmt.Errorf("failed to re-encrypt secrets: %w", err)
+	}
+
+	// The new key is only shown once all secrets are encrypted with it
+	if fromEnv {
+		views.RenderInfoMessageBold(fmt.Sprintf("Set %s to the following value before starting the server:\n\n%s", encryption.KeyEnvVar, encryption.EncodeKey(newKey)))
+		return nil
+	}
+
+	return encryption.WriteKeyFile(keyFilePath, newKey)
+}
@@ -21,20 +21,28 @@ import (
 	"github.com/daytonaio/daytona/pkg/build"
 	"github.com/daytonaio/daytona/pkg/containerregistry"
 	"github.com/daytonaio/daytona/pkg/db"
+	"github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/logs"
 	"github.com/daytonaio/daytona/pkg/posthogservice"
 	"github.com/daytonaio/daytona/pkg/provider/manager"
 	"github.com/daytonaio/daytona/pkg/provisioner"
+	"github.com/daytonaio/daytona/pkg/scheduler"
+	secret_backend "github.com/daytonaio/daytona/pkg/secret/backend"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/daytonaio/daytona/pkg/server/apikeys"
+	"github.com/daytonaio/daytona/pkg/server/auditlog"
 	"github.com/daytonaio/daytona/pkg/server/builds"
 	"github.com/daytonaio/daytona/pkg/server/containerregistries"
+	"github.com/daytonaio/daytona/pkg/server/events"
 	"github.com/daytonaio/daytona/pkg/server/gitproviders"
 	"github.com/daytonaio/daytona/pkg/server/headscale"
 	"github.com/daytonaio/daytona/pkg/server/profiledata"
 	"github.com/daytonaio/daytona/pkg/server/projectconfig"
 	"github.com/daytonaio/daytona/pkg/server/providertargets"
 	"github.com/daytonaio/daytona/pkg/server/registry"
+	"github.com/daytonaio/daytona/pkg/server/secrets"
+	"github.com/daytonaio/daytona/pkg/server/webhooks"
 	"github.com/daytonaio/daytona/pkg/server/workspaces"
 	"github.com/daytonaio/daytona/pkg/telemetry"
 	"github.com/daytonaio/daytona/pkg/views"
@@ -42,6 +50,7 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"gorm.io/gorm"
 )
 
 var ServeCmd = &cobra.Command{
@@ -106,7 +115,7 @@ var ServeCmd = &cobra.Command{
 			log.Fatal(err)
 		}
 
-		buildRunner, err := GetBuildRunner(c, buildRunnerConfig, telemetryService)
+		buildRunner, err := GetBuildRunner(c, buildRunnerConfig, telemetryService, server.EventBus)
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -160,15 +169,12 @@ func GetInstance(c *server.Config, configDir string, telemetryService telemetry.
 	if err != nil {
 		return nil, err
 	}
-	loggerFactory := logs.NewLoggerFactory(&wsLogsDir, &buildLogsDir)
 
-	dbPath, err := getDbPath()
+	dbConnection, err := getDbConnection()
 	if err != nil {
 		return nil, err
 	}
 
-	dbConnection := db.GetSQLiteConnection(dbPath)
-
 	apiKeyStore, err := db.NewApiKeyStore(dbConnection)
 	if err != nil {
 		return nil, err
@@ -177,10 +183,12 @@ func GetInstance(c *server.Config, configDir string, telemetryService telemetry.
 	if err != nil {
 		return nil, err
 	}
-	buildStore, err := db.NewBuildStore(dbConnection)
+	dbBuildStore, err := db.NewBuildStore(dbConnection)
 	if err != nil {
 		log.Fatal(err)
 	}
+	eventBus := events.NewEventBus()
+	buildStore := events.NewBuildStore(dbBuildStore, eventBus)
 	projectConfigStore, err := db.NewProjectConfigStore(dbConnection)
 	if err != nil {
 		return nil, err
@@ -201,6 +209,52 @@ func GetInstance(c *server.Config, configDir string, telemetryService telemetry.
 	if err != nil {
 		return nil, err
 	}
+	webhookStore, err := db.NewWebhookStore(dbConnection)
+	if err != nil {
+		return nil, err
+	}
+	webhookDeliveryStore, err := db.NewWebhookDeliveryStore(dbConnection)
+	if err != nil {
+		return nil, err
+	}
+	snapshotStore, err := db.NewSnapshotStore(dbConnection)
+	if err != nil {
+		return nil, err
+	}
+	auditStore, err := db.NewAuditStore(dbConnection)
+	if err != nil {
+		return nil, err
+	}
+	secretStore, err := db.NewSecretStore(dbConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.EncryptPlaintextSecrets(dbConnection)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt existing secrets: %w", err)
+	}
+
+	err = db.RemoveServerEnvVars(dbConnection)
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove stored server env vars: %w", err)
+	}
+
+	secretService := secrets.NewSecretService(secrets.SecretServiceConfig{
+		SecretStore: secretStore,
+	})
+
+	secretResolver := secret_backend.NewResolver(secret_backend.ResolverConfig{
+		Backends: map[string]secret_backend.Backend{
+			secret_backend.SchemeVault: secret_backend.NewVaultBackend(secret_backend.VaultBackendConfig{
+				Address:   os.Getenv("VAULT_ADDR"),
+				Token:     os.Getenv("VAULT_TOKEN"),
+				Namespace: os.Getenv("VAULT_NAMESPACE"),
+			}),
+		},
+	})
+
+	loggerFactory := logs.NewMaskingLoggerFactory(logs.NewLoggerFactory(&wsLogsDir, &buildLogsDir), secretService.Values, secretResolver.ResolvedValues)
 
 	headscaleServer := headscale.NewHeadscaleServer(&headscale.HeadscaleServerConfig{
 		ServerId:      c.Id,
@@ -225,6 +279,7 @@ func GetInstance(c *server.Config, configDir string, telemetryService telemetry.
 
 	gitProviderService := gitproviders.NewGitProviderService(gitproviders.GitProviderServiceConfig{
 		ConfigStore: gitProviderConfigStore,
+		OAuthApps:   getGitProviderOAuthApps(),
 	})
 
 	prebuildWebhookEndpoint := fmt.Sprintf("%s%s", util.GetFrpcApiUrl(c.Frps.Protocol, c.Id, c.Frps.Domain), constants.WEBHOOK_EVENT_ROUTE)
@@ -294,8 +349,11 @@ func GetInstance(c *server.Config, configDir string, telemetryService telemetry.
 	workspaceService := workspaces.NewWorkspaceService(workspaces.WorkspaceServiceConfig{
 		WorkspaceStore:           workspaceStore,
 		TargetStore:              providerTargetStore,
+		SnapshotStore:            snapshotStore,
 		ApiKeyService:            apiKeyService,
 		GitProviderService:       gitProviderService,
+		SecretService:            secretService,
+		SecretResolver:           secretResolver,
 		ContainerRegistryService: containerRegistryService,
 		BuildService:             buildService,
 		ProjectConfigService:     projectConfigService,
@@ -306,12 +364,68 @@ func GetInstance(c *server.Config, configDir string, telemetryService telemetry.
 		Provisioner:              provisioner,
 		LoggerFactory:            loggerFactory,
 		TelemetryService:         telemetryService,
+		EventBus:                 eventBus,
+		ProjectNetwork:           headscaleServer,
+	})
+
+	autoStopper := scheduler.NewAutoStopper(scheduler.AutoStopperConfig{
+		Interval:       scheduler.DEFAULT_AUTO_STOP_INTERVAL,
+		Scheduler:      build.NewCronScheduler(),
+		WorkspaceStore: workspaceStore,
+		TargetStore:    providerTargetStore,
+		ProjectStopper: workspaceService,
+	})
+
+	err = autoStopper.Start()
+	if err != nil {
+		return nil, err
+	}
+
+	expiryEnforcer := scheduler.NewExpiryEnforcer(scheduler.ExpiryEnforcerConfig{
+		Interval:         scheduler.DEFAULT_EXPIRY_INTERVAL,
+		Scheduler:        build.NewCronScheduler(),
+		WorkspaceStore:   workspaceStore,
+		WorkspaceRemover: workspaceService,
+		WarningPeriod:    scheduler.DEFAULT_EXPIRY_WARNING_PERIOD,
+		ApiKeyPurger:     apiKeyService,
 	})
 
+	err = expiryEnforcer.Start()
+	if err != nil {
+		return nil, err
+	}
+
 	profileDataService := profiledata.NewProfileDataService(profiledata.ProfileDataServiceConfig{
 		ProfileDataStore: profileDataStore,
 	})
 
+	webhookService := webhooks.NewWebhookService(webhooks.WebhookServiceConfig{
+		WebhookStore:  webhookStore,
+		DeliveryStore: webhookDeliveryStore,
+		EventBus:      eventBus,
+	})
+
+	err = webhookService.Start()
+	if err != nil {
+		return nil, err
+	}
+
+	auditLogService := auditlog.NewAuditLogService(auditlog.AuditLogServiceConfig{
+		AuditStore: auditStore,
+	})
+
+	auditPruner := scheduler.NewAuditPruner(scheduler.AuditPrunerConfig{
+		Interval:       scheduler.DEFAULT_AUDIT_PRUNE_INTERVAL,
+		Scheduler:      build.NewCronScheduler(),
+		AuditLogPruner: auditLogService,
+		Retention:      time.Duration(c.AuditRetentionDays) * 24 * time.Hour,
+	})
+
+	err = auditPruner.Start()
+	if err != nil {
+		return nil, err
+	}
+
 	return server.GetInstance(&server.ServerInstanceConfig{
 		Config:                   *c,
 		TailscaleServer:          headscaleServer,
@@ -326,22 +440,34 @@ func GetInstance(c *server.Config, configDir string, telemetryService telemetry.
 		ProviderManager:          providerManager,
 		ProfileDataService:       profileDataService,
 		TelemetryService:         telemetryService,
+		EventBus:                 eventBus,
+		WebhookService:           webhookService,
+		AuditLogService:          auditLogService,
+		SecretService:            secretService,
 	}), nil
 }
 
-func GetBuildRunner(c *server.Config, buildRunnerConfig *build.Config, telemetryService telemetry.TelemetryService) (*build.BuildRunner, error) {
+func GetBuildRunner(c *server.Config, buildRunnerConfig *build.Config, telemetryService telemetry.TelemetryService, eventBus events.IEventBus) (*build.BuildRunner, error) {
 	logsDir, err := build.GetBuildLogsDir()
 	if err != nil {
 		return nil, err
 	}
-	loggerFactory := logs.NewLoggerFactory(nil, &logsDir)
 
-	dbPath, err := getDbPath()
+	dbConnection, err := getDbConnection()
+	if err != nil {
+		return nil, err
+	}
+
+	secretStore, err := db.NewSecretStore(dbConnection)
 	if err != nil {
 		return nil, err
 	}
 
-	dbConnection := db.GetSQLiteConnection(dbPath)
+	secretService := secrets.NewSecretService(secrets.SecretServiceConfig{
+		SecretStore: secretStore,
+	})
+
+	loggerFactory := logs.NewMaskingLoggerFactory(logs.NewLoggerFactory(nil, &logsDir), secretService.Values)
 
 	gitProviderConfigStore, err := db.NewGitProviderConfigStore(dbConnection)
 	if err != nil {
@@ -350,12 +476,14 @@ func GetBuildRunner(c *server.Config, buildRunnerConfig *build.Config, telemetry
 
 	gitProviderService := gitproviders.NewGitProviderService(gitproviders.GitProviderServiceConfig{
 		ConfigStore: gitProviderConfigStore,
+		OAuthApps:   getGitProviderOAuthApps(),
 	})
 
-	buildStore, err := db.NewBuildStore(dbConnection)
+	dbBuildStore, err := db.NewBuildStore(dbConnection)
 	if err != nil {
 		return nil, err
 	}
+	buildStore := events.NewBuildStore(dbBuildStore, eventBus)
 
 	buildImageNamespace := c.BuildImageNamespace
 	if buildImageNamespace != "" {
@@ -399,16 +527,19 @@ func GetBuildRunner(c *server.Config, buildRunnerConfig *build.Config, telemetry
 	})
 
 	return build.NewBuildRunner(build.BuildRunnerInstanceConfig{
-		Interval:          buildRunnerConfig.Interval,
-		Scheduler:         build.NewCronScheduler(),
-		BuildRunnerId:     buildRunnerConfig.Id,
-		ContainerRegistry: builderRegistry,
-		GitProviderStore:  gitProviderService,
-		BuildStore:        buildStore,
-		BuilderFactory:    builderFactory,
-		LoggerFactory:     loggerFactory,
-		BasePath:          filepath.Join(configDir, "builds"),
-		TelemetryService:  telemetryService,
+		Interval:            buildRunnerConfig.Interval,
+		Scheduler:           build.NewCronScheduler(),
+		BuildRunnerId:       buildRunnerConfig.Id,
+		ContainerRegistry:   builderRegistry,
+		GitProviderStore:    gitProviderService,
+		BuildStore:          buildStore,
+		BuilderFactory:      builderFactory,
+		LoggerFactory:       loggerFactory,
+		BasePath:            filepath.Join(configDir, "builds"),
+		BuildTimeout:        time.Duration(c.BuildTimeout) * time.Minute,
+		MaxConcurrentBuilds: c.MaxConcurrentBuilds,
+		MaxBuildRetries:     c.MaxBuildRetries,
+		TelemetryService:    telemetryService,
 	}), nil
 }
 
@@ -436,6 +567,28 @@ func printServerStartedMessage(c *server.Config, runAsDaemon bool) {
 	started_view.Render(c.ApiPort, util.GetFrpcApiUrl(c.Frps.Protocol, c.Id, c.Frps.Domain), runAsDaemon)
 }
 
+// OAuth app credentials are read from the environment, e.g. DAYTONA_GITHUB_OAUTH_CLIENT_ID
+// and DAYTONA_GITHUB_OAUTH_CLIENT_SECRET, so that they are not exposed through the server config
+func getGitProviderOAuthApps() map[string]gitprovider.OAuthApp {
+	oauthApps := map[string]gitprovider.OAuthApp{}
+
+	for _, gitProviderId := range gitprovider.OAuthProviderIds {
+		envPrefix := fmt.Sprintf("DAYTONA_%s_OAUTH_", strings.ToUpper(strings.ReplaceAll(gitProviderId, "-", "_")))
+
+		clientId := os.Getenv(envPrefix + "CLIENT_ID")
+		if clientId == "" {
+			continue
+		}
+
+		oauthApps[gitProviderId] = gitprovider.OAuthApp{
+			ClientId:     clientId,
+			ClientSecret: os.Getenv(envPrefix + "CLIENT_SECRET"),
+		}
+	}
+
+	return oauthApps
+}
+
 func getDbPath() (string, error) {
 	configDir, err := config.GetConfigDir()
 	if err != nil {
@@ -450,6 +603,23 @@ func getDbPath() (string, error) {
 	return filepath.Join(configDir, "db"), nil
 }
 
+// getDbConnection opens the server database with the encryption keyring set
+func getDbConnection() (*gorm.DB, error) {
+	dbPath, err := getDbPath()
+	if err != nil {
+		return nil, err
+	}
+
+	keyring, _, err := loadEncryptionKeyring()
+	if err != nil {
+		return nil, err
+	}
+
+	dto.SetEncryptionKeyring(keyring)
+
+	return db.GetSQLiteConnection(dbPath), nil
+}
+
 func setDefaultConfig(server *server.Server, apiPort uint32) error {
 	existingConfig, err := config.GetConfig()
 	if err != nil && !config.IsNotExist(err) {
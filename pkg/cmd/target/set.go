@@ -5,6 +5,7 @@ package target
 
 import (
 	"context"
+	"time"
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
 	internal_util "github.com/daytonaio/daytona/internal/util"
@@ -104,6 +105,14 @@ var TargetSetCmd = &cobra.Command{
 			Version: selectedProvider.Version,
 		}
 
+		if cmd.Flags().Changed("auto-stop") {
+			autoStop, err := internal_util.DurationToMinutes(autoStopFlag)
+			if err != nil {
+				log.Fatal(err)
+			}
+			selectedTarget.AutoStop = &autoStop
+		}
+
 		res, err = client.TargetAPI.SetTarget(context.Background()).Target(*selectedTarget).Execute()
 		if err != nil {
 			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
@@ -112,3 +121,9 @@ var TargetSetCmd = &cobra.Command{
 		views.RenderInfoMessage("Target set successfully")
 	},
 }
+
+var autoStopFlag time.Duration
+
+func init() {
+	TargetSetCmd.Flags().DurationVar(&autoStopFlag, "auto-stop", 0, "Stop projects on the target after they have been idle for the given duration (e.g. '30m'); 0 disables auto-stop")
+}
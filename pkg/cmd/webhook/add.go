@@ -0,0 +1,73 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	webhook_view "github.com/daytonaio/daytona/pkg/views/webhook"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var webhookAddCmd = &cobra.Command{
+	Use:     "add [URL]",
+	Aliases: []string{"new", "create"},
+	Short:   "Add an outbound webhook",
+	Args:    cobra.RangeArgs(0, 1),
+	Run: func(cmd *cobra.Command, args []string) {
+		var webhookUrl string
+		eventTypes := []apiclient.EventType{}
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		for _, e := range eventsFlag {
+			eventType, err := apiclient.NewEventTypeFromValue(e)
+			if err != nil {
+				log.Fatal(err)
+			}
+			eventTypes = append(eventTypes, *eventType)
+		}
+
+		if len(args) == 1 {
+			webhookUrl = args[0]
+		} else {
+			webhook_view.WebhookCreationView(&webhookUrl, &eventTypes)
+		}
+
+		createWebhookDto := apiclient.CreateWebhookDTO{
+			Url:    webhookUrl,
+			Events: eventTypes,
+		}
+		if secretFlag != "" {
+			createWebhookDto.Secret = &secretFlag
+		}
+
+		webhook, res, err := apiClient.WebhookAPI.CreateWebhook(context.Background()).Webhook(createWebhookDto).Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Webhook %s added successfully", webhook.Id))
+
+		if secretFlag == "" && webhook.Secret != nil {
+			views.RenderInfoMessageBold(fmt.Sprintf("Signing secret: %s\nStore it now - it will not be shown again. Payloads are signed with HMAC-SHA256 in the X-Daytona-Signature header.", *webhook.Secret))
+		}
+	},
+}
+
+var eventsFlag []string
+var secretFlag string
+
+func init() {
+	webhookAddCmd.Flags().StringArrayVarP(&eventsFlag, "event", "e", []string{}, "Event type to subscribe to. Can be specified multiple times; subscribes to all events except project state updates if omitted")
+	webhookAddCmd.Flags().StringVar(&secretFlag, "secret", "", "Secret used to sign payloads. Generated by the server if omitted")
+}
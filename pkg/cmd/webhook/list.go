@@ -0,0 +1,50 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	"github.com/daytonaio/daytona/pkg/views"
+	webhook_view "github.com/daytonaio/daytona/pkg/views/webhook"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var webhookListCmd = &cobra.Command{
+	Use:     "list",
+	Short:   "List outbound webhooks",
+	Aliases: []string{"ls"},
+	Args:    cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		webhooks, res, err := apiClient.WebhookAPI.ListWebhooks(context.Background()).Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(webhooks)
+			formattedData.Print()
+			return
+		}
+
+		if len(webhooks) == 0 {
+			views.RenderInfoMessage("No webhooks found. Add a new webhook by running 'daytona webhook add'")
+			return
+		}
+
+		webhook_view.ListWebhooks(webhooks)
+	},
+}
+
+func init() {
+	format.RegisterFormatFlag(webhookListCmd)
+}
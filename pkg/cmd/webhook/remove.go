@@ -0,0 +1,71 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/common"
+	"github.com/daytonaio/daytona/pkg/views"
+	webhook_view "github.com/daytonaio/daytona/pkg/views/webhook"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var webhookRemoveCmd = &cobra.Command{
+	Use:     "remove [ID]",
+	Aliases: []string{"rm", "delete"},
+	Short:   "Remove an outbound webhook",
+	Args:    cobra.RangeArgs(0, 1),
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		webhookId, err := getWebhookId(apiClient, args, "Select a webhook to remove")
+		if err != nil {
+			if common.IsCtrlCAbort(err) {
+				return
+			}
+			log.Fatal(err)
+		}
+		if webhookId == "" {
+			views.RenderInfoMessage("No webhooks found")
+			return
+		}
+
+		res, err := apiClient.WebhookAPI.DeleteWebhook(context.Background(), webhookId).Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+
+		views.RenderInfoMessage("Webhook removed successfully")
+	},
+}
+
+// getWebhookId returns the webhook ID passed as an argument or prompts the user to select one
+func getWebhookId(apiClient *apiclient.APIClient, args []string, title string) (string, error) {
+	if len(args) == 1 {
+		return args[0], nil
+	}
+
+	webhooks, res, err := apiClient.WebhookAPI.ListWebhooks(context.Background()).Execute()
+	if err != nil {
+		return "", apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	if len(webhooks) == 0 {
+		return "", nil
+	}
+
+	webhook, err := webhook_view.GetWebhookFromPrompt(webhooks, title)
+	if err != nil {
+		return "", err
+	}
+
+	return webhook.Id, nil
+}
@@ -0,0 +1,54 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/common"
+	"github.com/daytonaio/daytona/pkg/views"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var webhookTestCmd = &cobra.Command{
+	Use:   "test [ID]",
+	Short: "Send a test event to an outbound webhook",
+	Args:  cobra.RangeArgs(0, 1),
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		webhookId, err := getWebhookId(apiClient, args, "Select a webhook to test")
+		if err != nil {
+			if common.IsCtrlCAbort(err) {
+				return
+			}
+			log.Fatal(err)
+		}
+		if webhookId == "" {
+			views.RenderInfoMessage("No webhooks found")
+			return
+		}
+
+		delivery, res, err := apiClient.WebhookAPI.TestWebhook(context.Background(), webhookId).Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+
+		if !delivery.Success {
+			errorMessage := ""
+			if delivery.Error != nil {
+				errorMessage = *delivery.Error
+			}
+			log.Fatalf("Test delivery failed: %s", errorMessage)
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Test event delivered successfully (status code %d)", delivery.StatusCode))
+	},
+}
@@ -0,0 +1,23 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/spf13/cobra"
+)
+
+var WebhookCmd = &cobra.Command{
+	Use:     "webhook",
+	Aliases: []string{"webhooks"},
+	Short:   "Manage outbound webhooks",
+	GroupID: util.SERVER_GROUP,
+}
+
+func init() {
+	WebhookCmd.AddCommand(webhookAddCmd)
+	WebhookCmd.AddCommand(webhookListCmd)
+	WebhookCmd.AddCommand(webhookRemoveCmd)
+	WebhookCmd.AddCommand(webhookTestCmd)
+}
@@ -0,0 +1,99 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	workspace_util "github.com/daytonaio/daytona/pkg/cmd/workspace/util"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var AddProjectCmd = &cobra.Command{
+	Use:     "add-project [WORKSPACE] REPOSITORY_URL|PROJECT_CONFIG",
+	Short:   "Add a project to an existing workspace",
+	GroupID: util.WORKSPACE_GROUP,
+	Args:    cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		var workspaceId string
+		var projects []apiclient.CreateProjectDTO
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if len(args) == 1 {
+			workspaceList, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Execute()
+			if err != nil {
+				log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+			}
+
+			workspace := selection.GetWorkspaceFromPrompt(workspaceList, "Add a project to")
+			if workspace == nil {
+				return
+			}
+			workspaceId = workspace.Name
+		} else {
+			workspaceId = args[0]
+		}
+
+		profileData, res, err := apiClient.ProfileAPI.GetProfileData(ctx).Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+
+		existingProjectConfigName, err := processCmdArgument(args[len(args)-1], apiClient, &projects, ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if existingProjectConfigName != nil {
+			views.RenderInfoMessage(fmt.Sprintf("Using detected project config '%s'", *existingProjectConfigName))
+		}
+
+		projectDto := projects[0]
+		if profileData != nil && profileData.EnvVars != nil {
+			projectDto.EnvVars = util.MergeEnvVars(profileData.EnvVars, projectDto.EnvVars)
+		} else {
+			projectDto.EnvVars = util.MergeEnvVars(projectDto.EnvVars)
+		}
+
+		var project *apiclient.Project
+		err = views_util.WithSpinner("Adding project", func() error {
+			project, res, err = apiClient.WorkspaceAPI.AddProject(ctx, workspaceId).Project(projectDto).Execute()
+			if err != nil {
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+			return nil
+		})
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Project '%s' has been added to workspace '%s'", project.Name, workspaceId))
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) >= 1 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		return getWorkspaceNameCompletions()
+	},
+}
+
+func init() {
+	AddProjectCmd.Flags().BoolVar(&blankFlag, "blank", false, "Create a blank project without using existing configurations")
+
+	workspace_util.AddProjectConfigurationFlags(AddProjectCmd, projectConfigurationFlags, false)
+}
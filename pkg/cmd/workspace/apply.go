@@ -0,0 +1,185 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/charmbracelet/huh"
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/views"
+	logs_view "github.com/daytonaio/daytona/pkg/views/logs"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	"github.com/docker/docker/pkg/stringid"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var dryRunFlag bool
+
+var ApplyCmd = &cobra.Command{
+	Use:     "apply",
+	Short:   "Create or update a workspace from a definition file",
+	Long:    "Create the workspace described in the definition file or bring the existing workspace with the same name in line with it. Projects that differ from their definition are recreated. Removing or recreating projects requires confirmation.",
+	GroupID: util.WORKSPACE_GROUP,
+	Args:    cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		desired, existing, plan, err := getWorkspacePlan(ctx, apiClient, definitionFileFlag)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		renderWorkspacePlan(desired, plan)
+
+		if plan.IsEmpty() || dryRunFlag {
+			return
+		}
+
+		if plan.IsDestructive() && !yesFlag {
+			form := huh.NewForm(
+				huh.NewGroup(
+					huh.NewConfirm().
+						Title(fmt.Sprintf("Apply the changes to workspace %s?", desired.Name)).
+						Description("Removed and recreated projects lose their uncommitted changes").
+						Value(&yesFlag),
+				),
+			).WithTheme(views.GetCustomTheme())
+
+			err := form.Run()
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			if !yesFlag {
+				fmt.Println("Operation canceled.")
+				return
+			}
+		}
+
+		if plan.Create {
+			err = applyCreateWorkspace(ctx, apiClient, desired)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			views.RenderInfoMessage(fmt.Sprintf("Workspace '%s' has been created", desired.Name))
+			return
+		}
+
+		for _, project := range plan.Add {
+			err = applyAddProject(ctx, apiClient, existing.Id, project)
+			if err != nil {
+				log.Fatal(err)
+			}
+		}
+
+		for _, change := range plan.Change {
+			err = applyRemoveProject(ctx, apiClient, existing.Id, change.Project.Name)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			err = applyAddProject(ctx, apiClient, existing.Id, change.Project)
+			if err != nil {
+				log.Fatal(err)
+			}
+		}
+
+		for _, projectName := range plan.Remove {
+			err = applyRemoveProject(ctx, apiClient, existing.Id, projectName)
+			if err != nil {
+				log.Fatal(err)
+			}
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Workspace '%s' has been updated", desired.Name))
+	},
+}
+
+func init() {
+	ApplyCmd.Flags().StringVarP(&definitionFileFlag, "file", "f", "", "Path to the workspace definition file (YAML or JSON)")
+	ApplyCmd.Flags().BoolVarP(&yesFlag, "yes", "y", false, "Apply changes that remove or recreate projects without prompt")
+	ApplyCmd.Flags().BoolVar(&dryRunFlag, "dry-run", false, "Only print the changes that would be applied")
+	err := ApplyCmd.MarkFlagRequired("file")
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func applyCreateWorkspace(ctx context.Context, apiClient *apiclient.APIClient, createWorkspaceDto *apiclient.CreateWorkspaceDTO) error {
+	c, err := config.GetConfig()
+	if err != nil {
+		return err
+	}
+
+	activeProfile, err := c.GetActiveProfile()
+	if err != nil {
+		return err
+	}
+
+	if createWorkspaceDto.Target == "" {
+		target, err := getTarget(activeProfile.Name)
+		if err != nil {
+			return err
+		}
+		createWorkspaceDto.Target = target.Name
+	}
+
+	createWorkspaceDto.Id = stringid.TruncateID(stringid.GenerateRandomID())
+
+	projectNames := []string{}
+	for _, project := range createWorkspaceDto.Projects {
+		projectNames = append(projectNames, project.Name)
+	}
+
+	logs_view.CalculateLongestPrefixLength(projectNames)
+
+	logs_view.DisplayLogEntry(logs.LogEntry{
+		Msg: "Request submitted\n",
+	}, logs_view.WORKSPACE_INDEX)
+
+	logsContext, stopLogs := context.WithCancel(context.Background())
+	defer stopLogs()
+
+	go apiclient_util.ReadWorkspaceLogs(logsContext, activeProfile, createWorkspaceDto.Id, projectNames)
+
+	_, res, err := apiClient.WorkspaceAPI.CreateWorkspace(ctx).Workspace(*createWorkspaceDto).Execute()
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	return nil
+}
+
+func applyAddProject(ctx context.Context, apiClient *apiclient.APIClient, workspaceId string, project apiclient.CreateProjectDTO) error {
+	return views_util.WithSpinner(fmt.Sprintf("Adding project %s", project.Name), func() error {
+		_, res, err := apiClient.WorkspaceAPI.AddProject(ctx, workspaceId).Project(project).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+		return nil
+	})
+}
+
+func applyRemoveProject(ctx context.Context, apiClient *apiclient.APIClient, workspaceId string, projectName string) error {
+	return views_util.WithSpinner(fmt.Sprintf("Removing project %s", projectName), func() error {
+		res, err := apiClient.WorkspaceAPI.RemoveProject(ctx, workspaceId, projectName).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,121 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/views"
+	logs_view "github.com/daytonaio/daytona/pkg/views/logs"
+	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
+	"github.com/docker/docker/pkg/stringid"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var cloneNameFlag string
+var withChangesFlag bool
+
+var CloneCmd = &cobra.Command{
+	Use:     "clone [WORKSPACE]",
+	Short:   "Create a copy of a workspace",
+	Long:    "Create a new workspace on the same target with the same project repositories, branches, build configs and env vars as an existing workspace.",
+	GroupID: util.WORKSPACE_GROUP,
+	Args:    cobra.RangeArgs(0, 1),
+	Run: func(cmd *cobra.Command, args []string) {
+		var workspace *apiclient.WorkspaceDTO
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		c, err := config.GetConfig()
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if len(args) == 0 {
+			workspaceList, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Execute()
+			if err != nil {
+				log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+			}
+
+			workspace = selection.GetWorkspaceFromPrompt(workspaceList, "Clone")
+			if workspace == nil {
+				return
+			}
+		} else {
+			var res *http.Response
+			workspace, res, err = apiClient.WorkspaceAPI.GetWorkspace(ctx, args[0]).Execute()
+			if err != nil {
+				log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+			}
+		}
+
+		name := cloneNameFlag
+		if name == "" {
+			name = fmt.Sprintf("%s-clone", workspace.Name)
+		}
+
+		cloneWorkspaceDto := apiclient.CloneWorkspaceDTO{
+			Id:   stringid.TruncateID(stringid.GenerateRandomID()),
+			Name: name,
+		}
+
+		if withChangesFlag {
+			cloneWorkspaceDto.WithChanges = &withChangesFlag
+		}
+
+		projectNames := []string{}
+		for _, project := range workspace.Projects {
+			projectNames = append(projectNames, project.Name)
+		}
+
+		logs_view.CalculateLongestPrefixLength(projectNames)
+
+		logs_view.DisplayLogEntry(logs.LogEntry{
+			Msg: "Request submitted\n",
+		}, logs_view.WORKSPACE_INDEX)
+
+		logsContext, stopLogs := context.WithCancel(context.Background())
+		defer stopLogs()
+
+		go apiclient_util.ReadWorkspaceLogs(logsContext, activeProfile, cloneWorkspaceDto.Id, projectNames)
+
+		_, res, err := apiClient.WorkspaceAPI.CloneWorkspace(ctx, workspace.Id).Workspace(cloneWorkspaceDto).Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+
+		stopLogs()
+
+		views.RenderInfoMessage(fmt.Sprintf("Workspace '%s' has been cloned to '%s'", workspace.Name, name))
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) >= 1 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		return getWorkspaceNameCompletions()
+	},
+}
+
+func init() {
+	CloneCmd.Flags().StringVarP(&cloneNameFlag, "name", "n", "", "Specify the name of the new workspace (defaults to '<WORKSPACE>-clone')")
+	CloneCmd.Flags().BoolVar(&withChangesFlag, "with-changes", false, "Carry over the uncommitted changes of each project. The projects are cloned at their current commit, which must be pushed")
+}
@@ -151,12 +151,30 @@ var CreateCmd = &cobra.Command{
 		logsContext, stopLogs := context.WithCancel(context.Background())
 		go apiclient_util.ReadWorkspaceLogs(logsContext, activeProfile, id, projectNames)
 
-		createdWorkspace, res, err := apiClient.WorkspaceAPI.CreateWorkspace(ctx).Workspace(apiclient.CreateWorkspaceDTO{
+		createWorkspaceDto := apiclient.CreateWorkspaceDTO{
 			Id:       id,
 			Name:     workspaceName,
 			Target:   target.Name,
 			Projects: projects,
-		}).Execute()
+		}
+
+		if cmd.Flags().Changed("auto-stop") {
+			autoStop, err := util.DurationToMinutes(autoStopFlag)
+			if err != nil {
+				log.Fatal(err)
+			}
+			createWorkspaceDto.AutoStop = &autoStop
+		}
+
+		if ttlFlag > 0 {
+			ttl, err := util.DurationToMinutes(ttlFlag)
+			if err != nil {
+				log.Fatal(err)
+			}
+			createWorkspaceDto.Ttl = &ttl
+		}
+
+		createdWorkspace, res, err := apiClient.WorkspaceAPI.CreateWorkspace(ctx).Workspace(createWorkspaceDto).Execute()
 		if err != nil {
 			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
 		}
@@ -224,15 +242,25 @@ var targetNameFlag string
 var codeFlag bool
 var blankFlag bool
 var multiProjectFlag bool
+var autoStopFlag time.Duration
+var ttlFlag time.Duration
 
 var projectConfigurationFlags = workspace_util.ProjectConfigurationFlags{
-	Builder:          new(views_util.BuildChoice),
-	CustomImage:      new(string),
-	CustomImageUser:  new(string),
-	Branch:           new(string),
-	DevcontainerPath: new(string),
-	EnvVars:          new([]string),
-	Manual:           new(bool),
+	Builder:             new(views_util.BuildChoice),
+	CustomImage:         new(string),
+	CustomImageUser:     new(string),
+	Branch:              new(string),
+	DevcontainerPath:    new(string),
+	DockerfilePath:      new(string),
+	DockerfileContext:   new(string),
+	DockerfileTarget:    new(string),
+	DockerfileBuildArgs: new([]string),
+	EnvVars:             new([]string),
+	Secrets:             new([]string),
+	Cpus:                new(float64),
+	Memory:              new(uint64),
+	Disk:                new(uint64),
+	Manual:              new(bool),
 }
 
 func init() {
@@ -250,6 +278,8 @@ func init() {
 	CreateCmd.Flags().BoolVar(&blankFlag, "blank", false, "Create a blank project without using existing configurations")
 	CreateCmd.Flags().BoolVarP(&codeFlag, "code", "c", false, "Open the workspace in the IDE after workspace creation")
 	CreateCmd.Flags().BoolVar(&multiProjectFlag, "multi-project", false, "Workspace with multiple projects/repos")
+	CreateCmd.Flags().DurationVar(&autoStopFlag, "auto-stop", 0, "Stop projects after they have been idle for the given duration (e.g. '30m'); defaults to the target setting")
+	CreateCmd.Flags().DurationVar(&ttlFlag, "ttl", 0, "Remove the workspace after the given duration (e.g. '72h')")
 
 	workspace_util.AddProjectConfigurationFlags(CreateCmd, projectConfigurationFlags, true)
 }
@@ -301,6 +331,7 @@ func processPrompting(apiClient *apiclient.APIClient, workspaceName *string, pro
 		Image:                &apiServerConfig.DefaultProjectImage,
 		ImageUser:            &apiServerConfig.DefaultProjectUser,
 		DevcontainerFilePath: create.DEVCONTAINER_FILEPATH,
+		DockerfilePath:       create.DOCKERFILE_FILEPATH,
 	}
 
 	*projects, err = workspace_util.GetProjectsCreationDataFromPrompt(workspace_util.ProjectsDataPromptConfig{
@@ -343,6 +374,10 @@ func processCmdArgument(argument string, apiClient *apiclient.APIClient, project
 		return nil, fmt.Errorf("can't set devcontainer file path if builder is not set to %s", views_util.DEVCONTAINER)
 	}
 
+	if *projectConfigurationFlags.Builder != "" && *projectConfigurationFlags.Builder != views_util.DOCKERFILE && workspace_util.CheckAnyDockerfileFlagSet(projectConfigurationFlags) {
+		return nil, fmt.Errorf("can't set Dockerfile options if builder is not set to %s", views_util.DOCKERFILE)
+	}
+
 	var projectConfig *apiclient.ProjectConfig
 
 	repoUrl, err := util.GetValidatedUrl(argument)
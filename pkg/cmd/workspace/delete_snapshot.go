@@ -0,0 +1,97 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/charmbracelet/huh"
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var DeleteSnapshotCmd = &cobra.Command{
+	Use:     "delete-snapshot [WORKSPACE] [PROJECT] [SNAPSHOT]",
+	Short:   "Delete a project snapshot",
+	Long:    "Delete a project snapshot, found by its ID or name.",
+	GroupID: util.WORKSPACE_GROUP,
+	Args:    cobra.RangeArgs(0, 3),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		workspaceId, projectName, err := getWorkspaceAndProjectFromArgs(ctx, apiClient, args, "Delete snapshot of")
+		if err != nil {
+			log.Fatal(err)
+		}
+		if projectName == "" {
+			return
+		}
+
+		snapshotId, err := getSnapshotIdFromArgs(ctx, apiClient, args, workspaceId, projectName, "Choose a snapshot to delete")
+		if err != nil {
+			log.Fatal(err)
+		}
+		if snapshotId == "" {
+			return
+		}
+
+		if !yesFlag {
+			form := huh.NewForm(
+				huh.NewGroup(
+					huh.NewConfirm().
+						Title(fmt.Sprintf("Delete snapshot %s of project %s?", snapshotId, projectName)).
+						Value(&yesFlag),
+				),
+			).WithTheme(views.GetCustomTheme())
+
+			err := form.Run()
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			if !yesFlag {
+				fmt.Println("Operation canceled.")
+				return
+			}
+		}
+
+		err = views_util.WithSpinner("Deleting snapshot", func() error {
+			res, err := apiClient.WorkspaceAPI.DeleteSnapshot(ctx, workspaceId, projectName, snapshotId).Execute()
+			if err != nil {
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+			return nil
+		})
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Snapshot '%s' of project '%s' has been deleted", snapshotId, projectName))
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 1 {
+			return getProjectNameCompletions(cmd, args, toComplete)
+		}
+
+		if len(args) >= 2 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		return getWorkspaceNameCompletions()
+	},
+}
+
+func init() {
+	DeleteSnapshotCmd.Flags().BoolVarP(&yesFlag, "yes", "y", false, "Confirm deletion without prompt")
+}
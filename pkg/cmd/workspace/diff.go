@@ -0,0 +1,125 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	workspace_util "github.com/daytonaio/daytona/pkg/cmd/workspace/util"
+	"github.com/daytonaio/daytona/pkg/views"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var definitionFileFlag string
+
+var DiffCmd = &cobra.Command{
+	Use:     "diff",
+	Short:   "Show the changes 'apply' would make to a workspace",
+	GroupID: util.WORKSPACE_GROUP,
+	Args:    cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		desired, _, plan, err := getWorkspacePlan(ctx, apiClient, definitionFileFlag)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		renderWorkspacePlan(desired, plan)
+	},
+}
+
+func init() {
+	DiffCmd.Flags().StringVarP(&definitionFileFlag, "file", "f", "", "Path to the workspace definition file (YAML or JSON)")
+	err := DiffCmd.MarkFlagRequired("file")
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// getWorkspacePlan reads the workspace definition and compares it to the workspace with the same name, if it exists
+func getWorkspacePlan(ctx context.Context, apiClient *apiclient.APIClient, path string) (*apiclient.CreateWorkspaceDTO, *apiclient.WorkspaceDTO, *workspace_util.WorkspacePlan, error) {
+	definition, err := workspace_util.ReadWorkspaceDefinition(path)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	desired, err := definition.ToCreateWorkspaceDto(ctx, apiClient)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	profileData, res, err := apiClient.ProfileAPI.GetProfileData(ctx).Execute()
+	if err != nil {
+		return nil, nil, nil, apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	for i := range desired.Projects {
+		if profileData != nil && profileData.EnvVars != nil {
+			desired.Projects[i].EnvVars = util.MergeEnvVars(profileData.EnvVars, desired.Projects[i].EnvVars)
+		} else {
+			desired.Projects[i].EnvVars = util.MergeEnvVars(desired.Projects[i].EnvVars)
+		}
+	}
+
+	workspaceList, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Execute()
+	if err != nil {
+		return nil, nil, nil, apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	var existing *apiclient.WorkspaceDTO
+	for i, w := range workspaceList {
+		if w.Name == desired.Name {
+			existing = &workspaceList[i]
+			break
+		}
+	}
+
+	plan, err := workspace_util.GetWorkspacePlan(existing, *desired)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return desired, existing, plan, nil
+}
+
+func renderWorkspacePlan(desired *apiclient.CreateWorkspaceDTO, plan *workspace_util.WorkspacePlan) {
+	if plan.IsEmpty() {
+		views.RenderInfoMessage(fmt.Sprintf("Workspace '%s' is up to date", desired.Name))
+		return
+	}
+
+	lines := []string{}
+
+	if plan.Create {
+		lines = append(lines, views.ActiveStyle.Render(fmt.Sprintf("+ workspace %s", desired.Name)))
+		for _, project := range desired.Projects {
+			lines = append(lines, views.ActiveStyle.Render(fmt.Sprintf("  + project %s (%s)", project.Name, project.Source.Repository.Url)))
+		}
+	} else {
+		lines = append(lines, fmt.Sprintf("~ workspace %s", desired.Name))
+		for _, project := range plan.Add {
+			lines = append(lines, views.ActiveStyle.Render(fmt.Sprintf("  + project %s (%s)", project.Name, project.Source.Repository.Url)))
+		}
+		for _, change := range plan.Change {
+			lines = append(lines, views.InactiveStyle.Render(fmt.Sprintf("  ~ project %s (%s will be recreated)", change.Project.Name, strings.Join(change.Fields, ", "))))
+		}
+		for _, projectName := range plan.Remove {
+			lines = append(lines, views.InactiveStyle.Render(fmt.Sprintf("  - project %s", projectName)))
+		}
+	}
+
+	fmt.Println(strings.Join(lines, "\n"))
+}
@@ -0,0 +1,75 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var ExtendCmd = &cobra.Command{
+	Use:     "extend [WORKSPACE] DURATION",
+	Short:   "Postpone the expiry of a workspace",
+	Long:    "Postpone the expiry of a workspace created with a TTL by the given duration (e.g. '24h')",
+	GroupID: util.WORKSPACE_GROUP,
+	Args:    cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		var workspaceId string
+		ctx := context.Background()
+
+		duration, err := time.ParseDuration(args[len(args)-1])
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		minutes, err := util.DurationToMinutes(duration)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if len(args) == 1 {
+			workspaceList, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Execute()
+			if err != nil {
+				log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+			}
+
+			workspace := selection.GetWorkspaceFromPrompt(workspaceList, "Extend")
+			if workspace == nil {
+				return
+			}
+			workspaceId = workspace.Name
+		} else {
+			workspaceId = args[0]
+		}
+
+		ws, res, err := apiClient.WorkspaceAPI.ExtendWorkspace(ctx, workspaceId).Extend(apiclient.ExtendWorkspace{
+			Duration: minutes,
+		}).Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+
+		expiresAt, err := time.Parse(time.RFC3339Nano, ws.GetExpiresAt())
+		if err != nil {
+			views.RenderInfoMessage(fmt.Sprintf("Workspace '%s' has been extended", ws.Name))
+			return
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Workspace '%s' now expires at %s", ws.Name, expiresAt.Local().Format(time.RFC1123)))
+	},
+}
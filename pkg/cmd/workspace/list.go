@@ -5,11 +5,14 @@ package workspace
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"os/signal"
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
 	"github.com/daytonaio/daytona/internal/util"
-	"github.com/daytonaio/daytona/internal/util/apiclient"
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/cmd/format"
 	"github.com/daytonaio/daytona/pkg/views"
 	list_view "github.com/daytonaio/daytona/pkg/views/workspace/list"
@@ -18,6 +21,7 @@ import (
 )
 
 var verbose bool
+var watchFlag bool
 
 var ListCmd = &cobra.Command{
 	Use:     "list",
@@ -27,54 +31,101 @@ var ListCmd = &cobra.Command{
 	GroupID: util.WORKSPACE_GROUP,
 	Run: func(cmd *cobra.Command, args []string) {
 		ctx := context.Background()
-		var specifyGitProviders bool
 
 		apiClient, err := apiclient_util.GetApiClient(nil)
 		if err != nil {
 			log.Fatal(err)
 		}
 
-		workspaceList, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Verbose(verbose).Execute()
+		if format.FormatFlag != "" {
+			workspaceList, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Verbose(verbose).Execute()
+			if err != nil {
+				log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+			}
 
-		if err != nil {
-			log.Fatal(apiclient.HandleErrorResponse(res, err))
+			formattedData := format.NewFormatter(workspaceList)
+			formattedData.Print()
+			return
 		}
 
-		gitProviders, res, err := apiClient.GitProviderAPI.ListGitProviders(ctx).Execute()
+		c, err := config.GetConfig()
 		if err != nil {
-			log.Fatal(apiclient.HandleErrorResponse(res, err))
+			log.Fatal(err)
 		}
 
-		if len(gitProviders) > 1 {
-			specifyGitProviders = true
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			log.Fatal(err)
 		}
 
-		if format.FormatFlag != "" {
-			formattedData := format.NewFormatter(workspaceList)
-			formattedData.Print()
+		if !watchFlag {
+			err = renderWorkspaceList(ctx, apiClient, activeProfile.Name)
+			if err != nil {
+				log.Fatal(err)
+			}
 			return
 		}
 
-		if len(workspaceList) == 0 {
-			views.RenderInfoMessage("The workspace list is empty. Start off by running 'daytona create'.")
-			return
-		}
+		ctx, cancel := signal.NotifyContext(ctx, os.Interrupt)
+		defer cancel()
 
-		c, err := config.GetConfig()
-		if err != nil {
-			log.Fatal(err)
+		refresh := func() {
+			// Clear the screen before re-rendering the list
+			fmt.Print("\033[H\033[2J")
+			err := renderWorkspaceList(ctx, apiClient, activeProfile.Name)
+			if err != nil {
+				log.Error(err)
+			}
 		}
 
-		activeProfile, err := c.GetActiveProfile()
+		refresh()
+
+		err = apiclient_util.ReadEventStream(ctx, &activeProfile, apiclient_util.EventStreamFilter{
+			Types: []apiclient.EventType{
+				apiclient.EventTypeWorkspaceCreated,
+				apiclient.EventTypeWorkspaceStarted,
+				apiclient.EventTypeWorkspaceStopped,
+				apiclient.EventTypeWorkspaceRemoved,
+				apiclient.EventTypeWorkspaceError,
+				apiclient.EventTypeProjectStateUpdated,
+			},
+		}, func(event apiclient.Event) {
+			refresh()
+		})
 		if err != nil {
 			log.Fatal(err)
 		}
-
-		list_view.ListWorkspaces(workspaceList, specifyGitProviders, verbose, activeProfile.Name)
 	},
 }
 
+func renderWorkspaceList(ctx context.Context, apiClient *apiclient.APIClient, profileName string) error {
+	var specifyGitProviders bool
+
+	workspaceList, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Verbose(verbose).Execute()
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	gitProviders, res, err := apiClient.GitProviderAPI.ListGitProviders(ctx).Execute()
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	if len(gitProviders) > 1 {
+		specifyGitProviders = true
+	}
+
+	if len(workspaceList) == 0 {
+		views.RenderInfoMessage("The workspace list is empty. Start off by running 'daytona create'.")
+		return nil
+	}
+
+	list_view.ListWorkspaces(workspaceList, specifyGitProviders, verbose, profileName)
+	return nil
+}
+
 func init() {
 	ListCmd.Flags().BoolVarP(&verbose, "verbose", "v", false, "Show verbose output")
+	ListCmd.Flags().BoolVarP(&watchFlag, "watch", "w", false, "Watch for workspace changes and refresh the list")
 	format.RegisterFormatFlag(ListCmd)
 }
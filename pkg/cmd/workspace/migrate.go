@@ -0,0 +1,155 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/charmbracelet/huh"
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/common"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/daytonaio/daytona/pkg/views/target"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var MigrateCmd = &cobra.Command{
+	Use:     "migrate [WORKSPACE]",
+	Short:   "Move a workspace to another target",
+	Long:    "Recreate the workspace on another target at the current commit of its projects, carrying over their uncommitted changes, and remove it and its snapshots from the current target. The migration is refused if the current commit of a started project has not been pushed.",
+	GroupID: util.WORKSPACE_GROUP,
+	Args:    cobra.RangeArgs(0, 1),
+	Run: func(cmd *cobra.Command, args []string) {
+		var workspace *apiclient.WorkspaceDTO
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if len(args) == 0 {
+			workspaceList, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Execute()
+			if err != nil {
+				log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+			}
+
+			workspace = selection.GetWorkspaceFromPrompt(workspaceList, "Migrate")
+			if workspace == nil {
+				return
+			}
+		} else {
+			var res *http.Response
+			workspace, res, err = apiClient.WorkspaceAPI.GetWorkspace(ctx, args[0]).Execute()
+			if err != nil {
+				log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+			}
+		}
+
+		targetName, err := getMigrationTarget(workspace.Target)
+		if err != nil {
+			if errors.Is(err, common.ErrCtrlCAbort) {
+				return
+			}
+			log.Fatal(err)
+		}
+
+		if !yesFlag {
+			form := huh.NewForm(
+				huh.NewGroup(
+					huh.NewConfirm().
+						Title(fmt.Sprintf("Migrate workspace %s from target %s to %s?", workspace.Name, workspace.Target, targetName)).
+						Description("The workspace and its snapshots are removed from the current target once it is running on the new one").
+						Value(&yesFlag),
+				),
+			).WithTheme(views.GetCustomTheme())
+
+			err := form.Run()
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			if !yesFlag {
+				fmt.Println("Operation canceled.")
+				return
+			}
+		}
+
+		err = views_util.WithSpinner("Migrating workspace", func() error {
+			_, res, err := apiClient.WorkspaceAPI.MigrateWorkspace(ctx, workspace.Id).Migrate(apiclient.MigrateWorkspace{
+				Target: targetName,
+			}).Execute()
+			if err != nil {
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+			return nil
+		})
+		if err != nil {
+			log.Fatal(fmt.Errorf("%w; check the workspace logs for details", err))
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Workspace '%s' has been migrated to target '%s'", workspace.Name, targetName))
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) >= 1 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		return getWorkspaceNameCompletions()
+	},
+}
+
+// getMigrationTarget returns the target set with the flag or prompts for one of the targets other than the current one
+func getMigrationTarget(currentTarget string) (string, error) {
+	if targetNameFlag != "" {
+		return targetNameFlag, nil
+	}
+
+	targets, err := apiclient_util.GetTargetList()
+	if err != nil {
+		return "", err
+	}
+
+	otherTargets := []apiclient.ProviderTarget{}
+	for _, t := range targets {
+		if t.Name != currentTarget {
+			otherTargets = append(otherTargets, t)
+		}
+	}
+
+	if len(otherTargets) == 0 {
+		return "", errors.New("no other targets found. Add a new target by running 'daytona target set'")
+	}
+
+	c, err := config.GetConfig()
+	if err != nil {
+		return "", err
+	}
+
+	activeProfile, err := c.GetActiveProfile()
+	if err != nil {
+		return "", err
+	}
+
+	t, err := target.GetTargetFromPrompt(otherTargets, activeProfile.Name, false)
+	if err != nil {
+		return "", err
+	}
+
+	return t.Name, nil
+}
+
+func init() {
+	MigrateCmd.Flags().StringVarP(&targetNameFlag, "target", "t", "", "Specify the target the workspace is moved to")
+	MigrateCmd.Flags().BoolVarP(&yesFlag, "yes", "y", false, "Confirm migration without prompt")
+}
@@ -0,0 +1,114 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/charmbracelet/huh"
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var RemoveProjectCmd = &cobra.Command{
+	Use:     "remove-project [WORKSPACE] [PROJECT]",
+	Short:   "Remove a project from a workspace",
+	GroupID: util.WORKSPACE_GROUP,
+	Args:    cobra.RangeArgs(0, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		var workspaceId string
+		var projectName string
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if len(args) == 0 {
+			workspaceList, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Execute()
+			if err != nil {
+				log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+			}
+
+			workspace := selection.GetWorkspaceFromPrompt(workspaceList, "Remove a project from")
+			if workspace == nil {
+				return
+			}
+			workspaceId = workspace.Name
+		} else {
+			workspaceId = args[0]
+		}
+
+		if len(args) < 2 {
+			workspace, res, err := apiClient.WorkspaceAPI.GetWorkspace(ctx, workspaceId).Execute()
+			if err != nil {
+				log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+			}
+
+			project := selection.GetProjectFromPrompt(workspace.Projects, "Remove")
+			if project == nil {
+				return
+			}
+			projectName = project.Name
+		} else {
+			projectName = args[1]
+		}
+
+		if !yesFlag {
+			form := huh.NewForm(
+				huh.NewGroup(
+					huh.NewConfirm().
+						Title(fmt.Sprintf("Remove project %s?", projectName)).
+						Description("The project and all of its data will be destroyed").
+						Value(&yesFlag),
+				),
+			).WithTheme(views.GetCustomTheme())
+
+			err := form.Run()
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			if !yesFlag {
+				fmt.Println("Operation canceled.")
+				return
+			}
+		}
+
+		err = views_util.WithSpinner("Removing project", func() error {
+			res, err := apiClient.WorkspaceAPI.RemoveProject(ctx, workspaceId, projectName).Execute()
+			if err != nil {
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+			return nil
+		})
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Project '%s' has been removed from workspace '%s'", projectName, workspaceId))
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 1 {
+			return getProjectNameCompletions(cmd, args, toComplete)
+		}
+
+		if len(args) >= 2 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		return getWorkspaceNameCompletions()
+	},
+}
+
+func init() {
+	RemoveProjectCmd.Flags().BoolVarP(&yesFlag, "yes", "y", false, "Confirm removal without prompt")
+}
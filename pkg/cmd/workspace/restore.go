@@ -0,0 +1,139 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/charmbracelet/huh"
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/common"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	snapshot_view "github.com/daytonaio/daytona/pkg/views/workspace/snapshot"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var RestoreCmd = &cobra.Command{
+	Use:     "restore [WORKSPACE] [PROJECT] [SNAPSHOT]",
+	Short:   "Restore a project from a snapshot",
+	Long:    "Restore a project from a snapshot, found by its ID or name. Changes made since the snapshot was created are lost.",
+	GroupID: util.WORKSPACE_GROUP,
+	Args:    cobra.RangeArgs(0, 3),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		workspaceId, projectName, err := getWorkspaceAndProjectFromArgs(ctx, apiClient, args, "Restore")
+		if err != nil {
+			log.Fatal(err)
+		}
+		if projectName == "" {
+			return
+		}
+
+		snapshotId, err := getSnapshotIdFromArgs(ctx, apiClient, args, workspaceId, projectName, "Choose a snapshot to restore")
+		if err != nil {
+			log.Fatal(err)
+		}
+		if snapshotId == "" {
+			return
+		}
+
+		if !yesFlag {
+			form := huh.NewForm(
+				huh.NewGroup(
+					huh.NewConfirm().
+						Title(fmt.Sprintf("Restore project %s from snapshot %s?", projectName, snapshotId)).
+						Description("Changes made since the snapshot was created will be lost").
+						Value(&yesFlag),
+				),
+			).WithTheme(views.GetCustomTheme())
+
+			err := form.Run()
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			if !yesFlag {
+				fmt.Println("Operation canceled.")
+				return
+			}
+		}
+
+		err = views_util.WithSpinner("Restoring project", func() error {
+			res, err := apiClient.WorkspaceAPI.RestoreProject(ctx, workspaceId, projectName).Restore(apiclient.RestoreSnapshot{
+				Snapshot: snapshotId,
+			}).Execute()
+			if err != nil {
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+			return nil
+		})
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Project '%s' has been restored from snapshot '%s'", projectName, snapshotId))
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 1 {
+			return getProjectNameCompletions(cmd, args, toComplete)
+		}
+
+		if len(args) >= 2 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		return getWorkspaceNameCompletions()
+	},
+}
+
+func init() {
+	RestoreCmd.Flags().BoolVarP(&yesFlag, "yes", "y", false, "Confirm restore without prompt")
+}
+
+// getSnapshotIdFromArgs prompts for a snapshot of the project if it is not passed as the third argument.
+// The snapshot ID is empty if the project has no snapshots or the prompt is aborted.
+func getSnapshotIdFromArgs(ctx context.Context, apiClient *apiclient.APIClient, args []string, workspaceId, projectName, title string) (string, error) {
+	if len(args) >= 3 {
+		return args[2], nil
+	}
+
+	snapshots, res, err := apiClient.WorkspaceAPI.ListSnapshots(ctx, workspaceId).Execute()
+	if err != nil {
+		return "", apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	projectSnapshots := []apiclient.ProjectSnapshot{}
+	for _, snapshot := range snapshots {
+		if snapshot.ProjectName == projectName {
+			projectSnapshots = append(projectSnapshots, snapshot)
+		}
+	}
+
+	if len(projectSnapshots) == 0 {
+		views.RenderInfoMessage(fmt.Sprintf("No snapshots found for project '%s'. Create one by running 'daytona snapshot'", projectName))
+		return "", nil
+	}
+
+	snapshot, err := snapshot_view.GetSnapshotFromPrompt(projectSnapshots, title)
+	if err != nil {
+		if errors.Is(err, common.ErrCtrlCAbort) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	return snapshot.Id, nil
+}
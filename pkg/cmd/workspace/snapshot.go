@@ -0,0 +1,120 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var snapshotNameFlag string
+
+var SnapshotCmd = &cobra.Command{
+	Use:     "snapshot [WORKSPACE] [PROJECT]",
+	Short:   "Save the current state of a project",
+	GroupID: util.WORKSPACE_GROUP,
+	Args:    cobra.RangeArgs(0, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		workspaceId, projectName, err := getWorkspaceAndProjectFromArgs(ctx, apiClient, args, "Snapshot")
+		if err != nil {
+			log.Fatal(err)
+		}
+		if projectName == "" {
+			return
+		}
+
+		var snapshot *apiclient.ProjectSnapshot
+
+		err = views_util.WithSpinner("Creating snapshot", func() error {
+			createSnapshot := apiclient.CreateSnapshot{}
+			if snapshotNameFlag != "" {
+				createSnapshot.Name = &snapshotNameFlag
+			}
+
+			createdSnapshot, res, err := apiClient.WorkspaceAPI.SnapshotProject(ctx, workspaceId, projectName).Snapshot(createSnapshot).Execute()
+			if err != nil {
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+			snapshot = createdSnapshot
+			return nil
+		})
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Snapshot '%s' of project '%s' has been created", snapshot.Name, projectName))
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 1 {
+			return getProjectNameCompletions(cmd, args, toComplete)
+		}
+
+		if len(args) >= 2 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		return getWorkspaceNameCompletions()
+	},
+}
+
+// getWorkspaceAndProjectFromArgs prompts for the workspace and project that are not passed as arguments.
+// The project name is empty if the prompt is aborted.
+func getWorkspaceAndProjectFromArgs(ctx context.Context, apiClient *apiclient.APIClient, args []string, actionVerb string) (string, string, error) {
+	var workspaceId string
+
+	if len(args) == 0 {
+		workspaceList, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Execute()
+		if err != nil {
+			return "", "", apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		workspace := selection.GetWorkspaceFromPrompt(workspaceList, actionVerb)
+		if workspace == nil {
+			return "", "", nil
+		}
+		workspaceId = workspace.Name
+	} else {
+		workspaceId = args[0]
+	}
+
+	if len(args) >= 2 {
+		return workspaceId, args[1], nil
+	}
+
+	workspace, res, err := apiClient.WorkspaceAPI.GetWorkspace(ctx, workspaceId).Execute()
+	if err != nil {
+		return "", "", apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	if len(workspace.Projects) == 1 {
+		return workspaceId, workspace.Projects[0].Name, nil
+	}
+
+	project := selection.GetProjectFromPrompt(workspace.Projects, actionVerb)
+	if project == nil {
+		return "", "", nil
+	}
+
+	return workspaceId, project.Name, nil
+}
+
+func init() {
+	SnapshotCmd.Flags().StringVarP(&snapshotNameFlag, "name", "n", "", "Specify the snapshot name")
+}
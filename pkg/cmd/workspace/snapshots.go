@@ -0,0 +1,77 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
+	snapshot_view "github.com/daytonaio/daytona/pkg/views/workspace/snapshot"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var SnapshotsCmd = &cobra.Command{
+	Use:     "snapshots [WORKSPACE]",
+	Short:   "List the project snapshots of a workspace",
+	GroupID: util.WORKSPACE_GROUP,
+	Args:    cobra.RangeArgs(0, 1),
+	Run: func(cmd *cobra.Command, args []string) {
+		var workspaceId string
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if len(args) == 0 {
+			workspaceList, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Execute()
+			if err != nil {
+				log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+			}
+
+			workspace := selection.GetWorkspaceFromPrompt(workspaceList, "List snapshots of")
+			if workspace == nil {
+				return
+			}
+			workspaceId = workspace.Name
+		} else {
+			workspaceId = args[0]
+		}
+
+		snapshots, res, err := apiClient.WorkspaceAPI.ListSnapshots(ctx, workspaceId).Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(snapshots)
+			formattedData.Print()
+			return
+		}
+
+		if len(snapshots) == 0 {
+			views.RenderInfoMessage("No snapshots found. Create a snapshot by running 'daytona snapshot'")
+			return
+		}
+
+		snapshot_view.ListSnapshots(snapshots)
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) >= 1 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		return getWorkspaceNameCompletions()
+	},
+}
+
+func init() {
+	format.RegisterFormatFlag(SnapshotsCmd)
+}
@@ -0,0 +1,128 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	top_view "github.com/daytonaio/daytona/pkg/views/workspace/top"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// Matches the interval in which the agents report their metrics
+const topRefreshInterval = 2 * time.Second
+
+var TopCmd = &cobra.Command{
+	Use:     "top [WORKSPACE]",
+	Short:   "Show the resource usage of started projects",
+	GroupID: util.WORKSPACE_GROUP,
+	Args:    cobra.RangeArgs(0, 1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		var workspaceId string
+		if len(args) == 1 {
+			workspaceId = args[0]
+		}
+
+		// Render once if the output is not a terminal
+		if !term.IsTerminal(int(os.Stdout.Fd())) {
+			err = renderUsage(ctx, apiClient, workspaceId)
+			if err != nil {
+				log.Fatal(err)
+			}
+			return
+		}
+
+		ctx, cancel := signal.NotifyContext(ctx, os.Interrupt)
+		defer cancel()
+
+		ticker := time.NewTicker(topRefreshInterval)
+		defer ticker.Stop()
+
+		for {
+			// Clear the screen before re-rendering the usage
+			fmt.Print("\033[H\033[2J")
+			err = renderUsage(ctx, apiClient, workspaceId)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) >= 1 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		return getWorkspaceNameCompletions()
+	},
+}
+
+func renderUsage(ctx context.Context, apiClient *apiclient.APIClient, workspaceId string) error {
+	var workspaceList []apiclient.WorkspaceDTO
+
+	if workspaceId != "" {
+		workspace, res, err := apiClient.WorkspaceAPI.GetWorkspace(ctx, workspaceId).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+		workspaceList = append(workspaceList, *workspace)
+	} else {
+		workspaces, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+		workspaceList = workspaces
+	}
+
+	usageList := []top_view.ProjectUsage{}
+
+	for _, workspace := range workspaceList {
+		for _, project := range workspace.Projects {
+			if project.Lifecycle.State != apiclient.StateStarted {
+				continue
+			}
+
+			history, res, err := apiClient.WorkspaceAPI.GetProjectMetrics(ctx, workspace.Id, project.Name).Execute()
+			if err != nil {
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+
+			usageList = append(usageList, top_view.ProjectUsage{
+				WorkspaceName: workspace.Name,
+				ProjectName:   project.Name,
+				History:       history,
+			})
+		}
+	}
+
+	if len(usageList) == 0 {
+		views.RenderInfoMessage("No started projects found")
+		return nil
+	}
+
+	top_view.ListUsage(usageList)
+	return nil
+}
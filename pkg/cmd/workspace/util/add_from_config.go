@@ -43,6 +43,8 @@ func AddProjectFromConfig(projectConfig *apiclient.ProjectConfig, apiClient *api
 		Image:       &projectConfig.Image,
 		User:        &projectConfig.User,
 		EnvVars:     projectConfig.EnvVars,
+		Secrets:     projectConfig.Secrets,
+		Resources:   projectConfig.Resources,
 	}
 	*projects = append(*projects, *project)
 
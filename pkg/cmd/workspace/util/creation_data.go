@@ -100,6 +100,8 @@ func GetProjectsCreationDataFromPrompt(config ProjectsDataPromptConfig) ([]apicl
 					Image:       config.Defaults.Image,
 					User:        config.Defaults.ImageUser,
 					EnvVars:     projectConfig.EnvVars,
+					Secrets:     projectConfig.Secrets,
+					Resources:   projectConfig.Resources,
 				})
 				continue
 			}
@@ -225,6 +227,33 @@ func GetCreateProjectDtoFromFlags(projectConfigurationFlags ProjectConfiguration
 
 	}
 
+	if *projectConfigurationFlags.Builder == views_util.DOCKERFILE || CheckAnyDockerfileFlagSet(projectConfigurationFlags) {
+		dockerfileConfig := apiclient.NewDockerfileConfig(create.DOCKERFILE_FILEPATH)
+		if *projectConfigurationFlags.DockerfilePath != "" {
+			dockerfileConfig.FilePath = *projectConfigurationFlags.DockerfilePath
+		}
+		if *projectConfigurationFlags.DockerfileContext != "" {
+			dockerfileConfig.Context = projectConfigurationFlags.DockerfileContext
+		}
+		if *projectConfigurationFlags.DockerfileTarget != "" {
+			dockerfileConfig.Target = projectConfigurationFlags.DockerfileTarget
+		}
+
+		if len(*projectConfigurationFlags.DockerfileBuildArgs) > 0 {
+			buildArgs := make(map[string]string)
+			for _, buildArg := range *projectConfigurationFlags.DockerfileBuildArgs {
+				parts := strings.SplitN(buildArg, "=", 2)
+				if len(parts) != 2 {
+					return nil, fmt.Errorf("Invalid build argument format: %s\n", buildArg)
+				}
+				buildArgs[parts[0]] = parts[1]
+			}
+			dockerfileConfig.BuildArgs = &buildArgs
+		}
+
+		project.BuildConfig.Dockerfile = dockerfileConfig
+	}
+
 	if *projectConfigurationFlags.Builder == views_util.NONE || *projectConfigurationFlags.CustomImage != "" || *projectConfigurationFlags.CustomImageUser != "" {
 		project.BuildConfig = nil
 		if *projectConfigurationFlags.CustomImage != "" || *projectConfigurationFlags.CustomImageUser != "" {
@@ -245,6 +274,23 @@ func GetCreateProjectDtoFromFlags(projectConfigurationFlags ProjectConfiguration
 	}
 
 	project.EnvVars = envVars
+	project.Secrets = *projectConfigurationFlags.Secrets
+
+	if CheckAnyResourceFlagSet(projectConfigurationFlags) {
+		project.Resources = &apiclient.ResourceLimits{}
+		if *projectConfigurationFlags.Cpus > 0 {
+			cpus := float32(*projectConfigurationFlags.Cpus)
+			project.Resources.Cpus = &cpus
+		}
+		if *projectConfigurationFlags.Memory > 0 {
+			memory := int32(*projectConfigurationFlags.Memory)
+			project.Resources.Memory = &memory
+		}
+		if *projectConfigurationFlags.Disk > 0 {
+			disk := int32(*projectConfigurationFlags.Disk)
+			project.Resources.Disk = &disk
+		}
+	}
 
 	return project, nil
 }
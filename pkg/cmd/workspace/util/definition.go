@@ -0,0 +1,379 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"gopkg.in/yaml.v2"
+)
+
+// WorkspaceDefinition is the declarative form of a workspace read from a YAML or JSON file.
+// It maps onto CreateWorkspaceDTO.
+type WorkspaceDefinition struct {
+	Name     string              `yaml:"name"`
+	Target   string              `yaml:"target,omitempty"`
+	Projects []ProjectDefinition `yaml:"projects"`
+}
+
+type ProjectDefinition struct {
+	// Defaults to the name of the repository
+	Name       string `yaml:"name,omitempty"`
+	Repository string `yaml:"repository"`
+	// Defaults to the default branch of the repository
+	Branch string `yaml:"branch,omitempty"`
+	Image  string `yaml:"image,omitempty"`
+	User   string `yaml:"user,omitempty"`
+	// The builder is detected automatically if neither the build config nor an image is set
+	BuildConfig *BuildConfigDefinition `yaml:"buildConfig,omitempty"`
+	EnvVars     map[string]string      `yaml:"envVars,omitempty"`
+	// Names of the secrets injected as environment variables
+	Secrets   []string             `yaml:"secrets,omitempty"`
+	Resources *ResourcesDefinition `yaml:"resources,omitempty"`
+}
+
+type ResourcesDefinition struct {
+	Cpus float32 `yaml:"cpus,omitempty"`
+	// Memory in MB
+	Memory int32 `yaml:"memory,omitempty"`
+	// Disk size in GB
+	Disk int32 `yaml:"disk,omitempty"`
+}
+
+type BuildConfigDefinition struct {
+	Devcontainer *DevcontainerDefinition `yaml:"devcontainer,omitempty"`
+	Dockerfile   *DockerfileDefinition   `yaml:"dockerfile,omitempty"`
+}
+
+type DevcontainerDefinition struct {
+	FilePath string `yaml:"filePath"`
+}
+
+type DockerfileDefinition struct {
+	FilePath  string            `yaml:"filePath"`
+	Context   string            `yaml:"context,omitempty"`
+	Target    string            `yaml:"target,omitempty"`
+	BuildArgs map[string]string `yaml:"buildArgs,omitempty"`
+}
+
+// ProjectChange describes an existing project that differs from its definition
+type ProjectChange struct {
+	Project apiclient.CreateProjectDTO
+	Fields  []string
+}
+
+// WorkspacePlan lists the operations needed to bring a workspace in line with its definition
+type WorkspacePlan struct {
+	Create bool
+	// Projects are changed by removing and adding them again
+	Change []ProjectChange
+	Add    []apiclient.CreateProjectDTO
+	Remove []string
+}
+
+func (p *WorkspacePlan) IsEmpty() bool {
+	return !p.Create && len(p.Change) == 0 && len(p.Add) == 0 && len(p.Remove) == 0
+}
+
+// IsDestructive reports whether applying the plan removes existing projects, including the ones that are recreated
+func (p *WorkspacePlan) IsDestructive() bool {
+	return len(p.Change) > 0 || len(p.Remove) > 0
+}
+
+func ReadWorkspaceDefinition(path string) (*WorkspaceDefinition, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var definition WorkspaceDefinition
+	err = yaml.UnmarshalStrict(content, &definition)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse workspace definition %s: %w", path, err)
+	}
+
+	if definition.Name == "" {
+		return nil, errors.New("workspace name is required")
+	}
+
+	if len(definition.Projects) == 0 {
+		return nil, errors.New("at least one project is required")
+	}
+
+	for i, project := range definition.Projects {
+		if project.Repository == "" {
+			return nil, fmt.Errorf("repository is required for project %d", i+1)
+		}
+
+		if project.BuildConfig != nil && project.Image != "" {
+			return nil, fmt.Errorf("project %d can not set both the build config and an image", i+1)
+		}
+	}
+
+	return &definition, nil
+}
+
+// ToCreateWorkspaceDto resolves the repositories of the definition's projects and converts it to a CreateWorkspaceDTO.
+// The workspace ID is left for the caller to set.
+func (d *WorkspaceDefinition) ToCreateWorkspaceDto(ctx context.Context, apiClient *apiclient.APIClient) (*apiclient.CreateWorkspaceDTO, error) {
+	createWorkspaceDto := &apiclient.CreateWorkspaceDTO{
+		Name:     d.Name,
+		Target:   d.Target,
+		Projects: []apiclient.CreateProjectDTO{},
+	}
+
+	projectNames := map[string]bool{}
+
+	for _, projectDefinition := range d.Projects {
+		project, err := projectDefinition.toCreateProjectDto(ctx, apiClient)
+		if err != nil {
+			return nil, err
+		}
+
+		if projectNames[project.Name] {
+			return nil, fmt.Errorf("project %s is defined more than once", project.Name)
+		}
+		projectNames[project.Name] = true
+
+		createWorkspaceDto.Projects = append(createWorkspaceDto.Projects, *project)
+	}
+
+	return createWorkspaceDto, nil
+}
+
+func (d *ProjectDefinition) toCreateProjectDto(ctx context.Context, apiClient *apiclient.APIClient) (*apiclient.CreateProjectDTO, error) {
+	var branch *string
+	if d.Branch != "" {
+		branch = &d.Branch
+	}
+
+	repo, res, err := apiClient.GitProviderAPI.GetGitContext(ctx).Repository(apiclient.GetRepositoryContext{
+		Url:    d.Repository,
+		Branch: branch,
+	}).Execute()
+	if err != nil {
+		return nil, apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	name := d.Name
+	if name == "" {
+		name, err = GetSanitizedProjectName(repo.Name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	project := &apiclient.CreateProjectDTO{
+		Name: name,
+		Source: apiclient.CreateProjectSourceDTO{
+			Repository: *repo,
+		},
+		EnvVars: map[string]string{},
+		Secrets: d.Secrets,
+	}
+
+	for k, v := range d.EnvVars {
+		project.EnvVars[k] = v
+	}
+
+	if d.Image != "" {
+		project.Image = &d.Image
+	}
+
+	if d.User != "" {
+		project.User = &d.User
+	}
+
+	if d.Image == "" {
+		project.BuildConfig = d.BuildConfig.toBuildConfig()
+	}
+
+	project.Resources = d.Resources.toResourceLimits()
+
+	return project, nil
+}
+
+func (d *BuildConfigDefinition) toBuildConfig() *apiclient.BuildConfig {
+	buildConfig := &apiclient.BuildConfig{}
+	if d == nil {
+		return buildConfig
+	}
+
+	if d.Devcontainer != nil {
+		buildConfig.Devcontainer = apiclient.NewDevcontainerConfig(d.Devcontainer.FilePath)
+	}
+
+	if d.Dockerfile != nil {
+		dockerfileConfig := apiclient.NewDockerfileConfig(d.Dockerfile.FilePath)
+		if d.Dockerfile.Context != "" {
+			dockerfileConfig.Context = &d.Dockerfile.Context
+		}
+		if d.Dockerfile.Target != "" {
+			dockerfileConfig.Target = &d.Dockerfile.Target
+		}
+		if len(d.Dockerfile.BuildArgs) > 0 {
+			dockerfileConfig.BuildArgs = &d.Dockerfile.BuildArgs
+		}
+		buildConfig.Dockerfile = dockerfileConfig
+	}
+
+	return buildConfig
+}
+
+func (d *ResourcesDefinition) toResourceLimits() *apiclient.ResourceLimits {
+	if d == nil {
+		return nil
+	}
+
+	resources := &apiclient.ResourceLimits{}
+	if d.Cpus > 0 {
+		resources.Cpus = &d.Cpus
+	}
+	if d.Memory > 0 {
+		resources.Memory = &d.Memory
+	}
+	if d.Disk > 0 {
+		resources.Disk = &d.Disk
+	}
+
+	return resources
+}
+
+// GetWorkspacePlan compares an existing workspace to the desired one.
+// If the workspace does not exist, the plan is to create it.
+func GetWorkspacePlan(existing *apiclient.WorkspaceDTO, desired apiclient.CreateWorkspaceDTO) (*WorkspacePlan, error) {
+	if existing == nil {
+		return &WorkspacePlan{Create: true}, nil
+	}
+
+	if desired.Target != "" && desired.Target != existing.Target {
+		return nil, fmt.Errorf("workspace %s already exists on target %s; changing the target is not supported", existing.Name, existing.Target)
+	}
+
+	plan := &WorkspacePlan{}
+	existingProjects := map[string]apiclient.Project{}
+
+	for _, project := range existing.Projects {
+		existingProjects[project.Name] = project
+	}
+
+	for _, project := range desired.Projects {
+		existingProject, ok := existingProjects[project.Name]
+		if !ok {
+			plan.Add = append(plan.Add, project)
+			continue
+		}
+
+		fields := getProjectChanges(existingProject, project)
+		if len(fields) > 0 {
+			plan.Change = append(plan.Change, ProjectChange{Project: project, Fields: fields})
+		}
+
+		delete(existingProjects, project.Name)
+	}
+
+	for _, project := range existing.Projects {
+		if _, ok := existingProjects[project.Name]; ok {
+			plan.Remove = append(plan.Remove, project.Name)
+		}
+	}
+
+	// Projects can only be added to started workspaces. Checking this upfront keeps changed projects
+	// from being removed when adding them back would fail
+	if (len(plan.Add) > 0 || len(plan.Change) > 0) && existing.Lifecycle.State != apiclient.StateStarted {
+		return nil, fmt.Errorf("workspace %s is %s; start it with 'daytona start %s' and apply the definition again", existing.Name, existing.Lifecycle.State, existing.Name)
+	}
+
+	// Changed projects are replaced one at a time, so another project must remain in the workspace
+	if len(plan.Change) > 0 && len(existing.Projects)+len(plan.Add) < 2 {
+		return nil, fmt.Errorf("project %s of workspace %s can not be changed because it is the only project; delete the workspace and apply the definition again", plan.Change[0].Project.Name, existing.Name)
+	}
+
+	return plan, nil
+}
+
+func getProjectChanges(existing apiclient.Project, desired apiclient.CreateProjectDTO) []string {
+	fields := []string{}
+
+	if existing.Repository.Url != desired.Source.Repository.Url {
+		fields = append(fields, "repository")
+	}
+
+	if existing.Repository.Branch != desired.Source.Repository.Branch {
+		fields = append(fields, "branch")
+	}
+
+	// The server sets the default image and user if none are requested
+	if desired.Image != nil && *desired.Image != existing.Image {
+		fields = append(fields, "image")
+	}
+
+	if desired.User != nil && *desired.User != existing.User {
+		fields = append(fields, "user")
+	}
+
+	if !buildConfigEquals(existing.BuildConfig, desired.BuildConfig) {
+		fields = append(fields, "build config")
+	}
+
+	if !envVarsEqual(existing.EnvVars, desired.EnvVars) {
+		fields = append(fields, "env vars")
+	}
+
+	if !slices.Equal(existing.Secrets, desired.Secrets) {
+		fields = append(fields, "secrets")
+	}
+
+	if !resourcesEqual(existing.Resources, desired.Resources) {
+		fields = append(fields, "resources")
+	}
+
+	return fields
+}
+
+func buildConfigEquals(existing, desired *apiclient.BuildConfig) bool {
+	if existing == nil || desired == nil {
+		return existing == nil && desired == nil
+	}
+
+	// The cached build is set by the server and is not part of the definition
+	return reflect.DeepEqual(existing.Devcontainer, desired.Devcontainer) && reflect.DeepEqual(existing.Dockerfile, desired.Dockerfile)
+}
+
+func resourcesEqual(existing, desired *apiclient.ResourceLimits) bool {
+	if existing == nil {
+		existing = &apiclient.ResourceLimits{}
+	}
+
+	if desired == nil {
+		desired = &apiclient.ResourceLimits{}
+	}
+
+	return reflect.DeepEqual(existing, desired)
+}
+
+// envVarsEqual ignores the DAYTONA_ env vars the server adds to every project
+func envVarsEqual(existing, desired map[string]string) bool {
+	for k, v := range desired {
+		if existingValue, ok := existing[k]; !ok || existingValue != v {
+			return false
+		}
+	}
+
+	for k := range existing {
+		if _, ok := desired[k]; !ok && !strings.HasPrefix(k, "DAYTONA_") {
+			return false
+		}
+	}
+
+	return true
+}
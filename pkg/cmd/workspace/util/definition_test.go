@@ -0,0 +1,195 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import (
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/stretchr/testify/require"
+)
+
+func newExistingProject(name, url, branch string) apiclient.Project {
+	return apiclient.Project{
+		Name:  name,
+		Image: "daytonaio/workspace-project:latest",
+		User:  "daytona",
+		Repository: apiclient.GitRepository{
+			Url:    url,
+			Branch: branch,
+		},
+		EnvVars: map[string]string{
+			"DAYTONA_WS_ID": "ws1",
+		},
+	}
+}
+
+func newDesiredProject(name, url, branch string) apiclient.CreateProjectDTO {
+	return apiclient.CreateProjectDTO{
+		Name: name,
+		Source: apiclient.CreateProjectSourceDTO{
+			Repository: apiclient.GitRepository{
+				Url:    url,
+				Branch: branch,
+			},
+		},
+		EnvVars: map[string]string{},
+	}
+}
+
+func newExistingWorkspace(state apiclient.LifecycleState, projects ...apiclient.Project) *apiclient.WorkspaceDTO {
+	return &apiclient.WorkspaceDTO{
+		Id:     "ws1",
+		Name:   "ws",
+		Target: "local",
+		Lifecycle: apiclient.Lifecycle{
+			State: state,
+		},
+		Projects: projects,
+	}
+}
+
+const repoUrl = "https://github.com/daytonaio/daytona.git"
+const otherRepoUrl = "https://github.com/daytonaio/docs.git"
+
+func TestGetWorkspacePlan(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing *apiclient.WorkspaceDTO
+		desired  apiclient.CreateWorkspaceDTO
+		expected *WorkspacePlan
+		err      string
+	}{
+		{
+			name: "creates a missing workspace",
+			desired: apiclient.CreateWorkspaceDTO{
+				Name:     "ws",
+				Projects: []apiclient.CreateProjectDTO{newDesiredProject("p1", repoUrl, "main")},
+			},
+			expected: &WorkspacePlan{Create: true},
+		},
+		{
+			name:     "is empty for an unchanged workspace",
+			existing: newExistingWorkspace(apiclient.StateStopped, newExistingProject("p1", repoUrl, "main")),
+			desired: apiclient.CreateWorkspaceDTO{
+				Name:     "ws",
+				Projects: []apiclient.CreateProjectDTO{newDesiredProject("p1", repoUrl, "main")},
+			},
+			expected: &WorkspacePlan{},
+		},
+		{
+			name:     "adds, changes and removes projects",
+			existing: newExistingWorkspace(apiclient.StateStarted, newExistingProject("p1", repoUrl, "main"), newExistingProject("p2", repoUrl, "main")),
+			desired: apiclient.CreateWorkspaceDTO{
+				Name: "ws",
+				Projects: []apiclient.CreateProjectDTO{
+					newDesiredProject("p1", otherRepoUrl, "dev"),
+					newDesiredProject("p3", repoUrl, "main"),
+				},
+			},
+			expected: &WorkspacePlan{
+				Change: []ProjectChange{{Project: newDesiredProject("p1", otherRepoUrl, "dev"), Fields: []string{"repository", "branch"}}},
+				Add:    []apiclient.CreateProjectDTO{newDesiredProject("p3", repoUrl, "main")},
+				Remove: []string{"p2"},
+			},
+		},
+		{
+			name:     "removes projects from a stopped workspace",
+			existing: newExistingWorkspace(apiclient.StateStopped, newExistingProject("p1", repoUrl, "main"), newExistingProject("p2", repoUrl, "main")),
+			desired: apiclient.CreateWorkspaceDTO{
+				Name:     "ws",
+				Projects: []apiclient.CreateProjectDTO{newDesiredProject("p1", repoUrl, "main")},
+			},
+			expected: &WorkspacePlan{Remove: []string{"p2"}},
+		},
+		{
+			name:     "ignores server env vars and detects env var changes",
+			existing: newExistingWorkspace(apiclient.StateStarted, newExistingProject("p1", repoUrl, "main"), newExistingProject("p2", repoUrl, "main")),
+			desired: apiclient.CreateWorkspaceDTO{
+				Name: "ws",
+				Projects: []apiclient.CreateProjectDTO{
+					newDesiredProject("p1", repoUrl, "main"),
+					func() apiclient.CreateProjectDTO {
+						p := newDesiredProject("p2", repoUrl, "main")
+						p.EnvVars["FOO"] = "bar"
+						return p
+					}(),
+				},
+			},
+			expected: &WorkspacePlan{
+				Change: []ProjectChange{{
+					Project: func() apiclient.CreateProjectDTO {
+						p := newDesiredProject("p2", repoUrl, "main")
+						p.EnvVars["FOO"] = "bar"
+						return p
+					}(),
+					Fields: []string{"env vars"},
+				}},
+			},
+		},
+		{
+			name:     "fails to change projects of a stopped workspace",
+			existing: newExistingWorkspace(apiclient.StateStopped, newExistingProject("p1", repoUrl, "main"), newExistingProject("p2", repoUrl, "main")),
+			desired: apiclient.CreateWorkspaceDTO{
+				Name: "ws",
+				Projects: []apiclient.CreateProjectDTO{
+					newDesiredProject("p1", repoUrl, "dev"),
+					newDesiredProject("p2", repoUrl, "main"),
+				},
+			},
+			err: "workspace ws is stopped",
+		},
+		{
+			name:     "fails to add projects to a stopped workspace",
+			existing: newExistingWorkspace(apiclient.StateStopped, newExistingProject("p1", repoUrl, "main")),
+			desired: apiclient.CreateWorkspaceDTO{
+				Name: "ws",
+				Projects: []apiclient.CreateProjectDTO{
+					newDesiredProject("p1", repoUrl, "main"),
+					newDesiredProject("p2", repoUrl, "main"),
+				},
+			},
+			err: "workspace ws is stopped",
+		},
+		{
+			name:     "fails to change the only project",
+			existing: newExistingWorkspace(apiclient.StateStarted, newExistingProject("p1", repoUrl, "main")),
+			desired: apiclient.CreateWorkspaceDTO{
+				Name:     "ws",
+				Projects: []apiclient.CreateProjectDTO{newDesiredProject("p1", repoUrl, "dev")},
+			},
+			err: "it is the only project",
+		},
+		{
+			name:     "fails to change the target",
+			existing: newExistingWorkspace(apiclient.StateStarted, newExistingProject("p1", repoUrl, "main")),
+			desired: apiclient.CreateWorkspaceDTO{
+				Name:     "ws",
+				Target:   "remote",
+				Projects: []apiclient.CreateProjectDTO{newDesiredProject("p1", repoUrl, "main")},
+			},
+			err: "changing the target is not supported",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan, err := GetWorkspacePlan(tt.existing, tt.desired)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, plan)
+		})
+	}
+}
+
+func TestWorkspacePlanIsDestructive(t *testing.T) {
+	require.False(t, (&WorkspacePlan{Create: true}).IsDestructive())
+	require.False(t, (&WorkspacePlan{Add: []apiclient.CreateProjectDTO{newDesiredProject("p1", repoUrl, "main")}}).IsDestructive())
+	require.True(t, (&WorkspacePlan{Remove: []string{"p1"}}).IsDestructive())
+	require.True(t, (&WorkspacePlan{Change: []ProjectChange{{Project: newDesiredProject("p1", repoUrl, "dev"), Fields: []string{"branch"}}}}).IsDestructive())
+}
@@ -12,13 +12,21 @@ import (
 )
 
 type ProjectConfigurationFlags struct {
-	Builder          *views_util.BuildChoice
-	CustomImage      *string
-	CustomImageUser  *string
-	Branch           *string
-	DevcontainerPath *string
-	EnvVars          *[]string
-	Manual           *bool
+	Builder             *views_util.BuildChoice
+	CustomImage         *string
+	CustomImageUser     *string
+	Branch              *string
+	DevcontainerPath    *string
+	DockerfilePath      *string
+	DockerfileContext   *string
+	DockerfileTarget    *string
+	DockerfileBuildArgs *[]string
+	EnvVars             *[]string
+	Secrets             *[]string
+	Cpus                *float64
+	Memory              *uint64
+	Disk                *uint64
+	Manual              *bool
 }
 
 func AddProjectConfigurationFlags(cmd *cobra.Command, flags ProjectConfigurationFlags, multiProjectFlagException bool) {
@@ -26,27 +34,51 @@ func AddProjectConfigurationFlags(cmd *cobra.Command, flags ProjectConfiguration
 	cmd.Flags().StringVar(flags.CustomImageUser, "custom-image-user", "", "Create the project with the custom image user passed as the flag value; Requires setting --custom-image flag as well")
 	cmd.Flags().StringVar(flags.Branch, "branch", "", "Specify the Git branch to use in the project")
 	cmd.Flags().StringVar(flags.DevcontainerPath, "devcontainer-path", "", "Automatically assign the devcontainer builder with the path passed as the flag value")
-	cmd.Flags().Var(flags.Builder, "builder", fmt.Sprintf("Specify the builder (currently %s/%s/%s)", views_util.AUTOMATIC, views_util.DEVCONTAINER, views_util.NONE))
+	cmd.Flags().StringVar(flags.DockerfilePath, "dockerfile-path", "", "Automatically assign the Dockerfile builder with the path passed as the flag value")
+	cmd.Flags().StringVar(flags.DockerfileContext, "dockerfile-context", "", "Specify the Dockerfile build context directory, relative to the repository root")
+	cmd.Flags().StringVar(flags.DockerfileTarget, "dockerfile-target", "", "Specify the target stage of a multi-stage Dockerfile")
+	cmd.Flags().StringArrayVar(flags.DockerfileBuildArgs, "dockerfile-build-arg", []string{}, "Specify Dockerfile build arguments (e.g. --dockerfile-build-arg 'KEY1=VALUE1' --dockerfile-build-arg 'KEY2=VALUE2' ...')")
+	cmd.Flags().Var(flags.Builder, "builder", fmt.Sprintf("Specify the builder (currently %s/%s/%s/%s)", views_util.AUTOMATIC, views_util.DEVCONTAINER, views_util.DOCKERFILE, views_util.NONE))
 	cmd.Flags().StringArrayVar(flags.EnvVars, "env", []string{}, "Specify environment variables (e.g. --env 'KEY1=VALUE1' --env 'KEY2=VALUE2' ...')")
+	cmd.Flags().StringArrayVar(flags.Secrets, "secret", []string{}, "Inject secrets as environment variables by their name (e.g. --secret 'NPM_TOKEN' --secret 'AWS_SECRET_ACCESS_KEY' ...')")
+	cmd.Flags().Float64Var(flags.Cpus, "cpus", 0, "Limit the number of CPUs of the project (e.g. 1.5)")
+	cmd.Flags().Uint64Var(flags.Memory, "memory", 0, "Limit the memory of the project in MB")
+	cmd.Flags().Uint64Var(flags.Disk, "disk", 0, "Limit the disk size of the project in GB")
 	cmd.Flags().BoolVar(flags.Manual, "manual", false, "Manually enter the Git repository")
 
 	cmd.MarkFlagsMutuallyExclusive("builder", "custom-image")
 	cmd.MarkFlagsMutuallyExclusive("builder", "custom-image-user")
 	cmd.MarkFlagsMutuallyExclusive("devcontainer-path", "custom-image")
 	cmd.MarkFlagsMutuallyExclusive("devcontainer-path", "custom-image-user")
+	cmd.MarkFlagsMutuallyExclusive("dockerfile-path", "custom-image")
+	cmd.MarkFlagsMutuallyExclusive("dockerfile-path", "custom-image-user")
+	cmd.MarkFlagsMutuallyExclusive("dockerfile-path", "devcontainer-path")
 	cmd.MarkFlagsRequiredTogether("custom-image", "custom-image-user")
 
 	if multiProjectFlagException {
 		cmd.MarkFlagsMutuallyExclusive("multi-project", "custom-image")
 		cmd.MarkFlagsMutuallyExclusive("multi-project", "custom-image-user")
 		cmd.MarkFlagsMutuallyExclusive("multi-project", "devcontainer-path")
+		cmd.MarkFlagsMutuallyExclusive("multi-project", "dockerfile-path")
 		cmd.MarkFlagsMutuallyExclusive("multi-project", "builder")
 		cmd.MarkFlagsMutuallyExclusive("multi-project", "env")
+		cmd.MarkFlagsMutuallyExclusive("multi-project", "secret")
+		cmd.MarkFlagsMutuallyExclusive("multi-project", "cpus")
+		cmd.MarkFlagsMutuallyExclusive("multi-project", "memory")
+		cmd.MarkFlagsMutuallyExclusive("multi-project", "disk")
 	}
 }
 
 func CheckAnyProjectConfigurationFlagSet(flags ProjectConfigurationFlags) bool {
-	return *flags.CustomImage != "" || *flags.CustomImageUser != "" || *flags.Branch != "" || *flags.DevcontainerPath != "" || *flags.Builder != "" || len(*flags.EnvVars) > 0
+	return *flags.CustomImage != "" || *flags.CustomImageUser != "" || *flags.Branch != "" || *flags.DevcontainerPath != "" || CheckAnyDockerfileFlagSet(flags) || *flags.Builder != "" || len(*flags.EnvVars) > 0 || len(*flags.Secrets) > 0 || CheckAnyResourceFlagSet(flags)
+}
+
+func CheckAnyResourceFlagSet(flags ProjectConfigurationFlags) bool {
+	return *flags.Cpus > 0 || *flags.Memory > 0 || *flags.Disk > 0
+}
+
+func CheckAnyDockerfileFlagSet(flags ProjectConfigurationFlags) bool {
+	return *flags.DockerfilePath != "" || *flags.DockerfileContext != "" || *flags.DockerfileTarget != "" || len(*flags.DockerfileBuildArgs) > 0
 }
 
 func IsProjectRunning(workspace *apiclient.WorkspaceDTO, projectName string) bool {
@@ -39,7 +39,13 @@ var gitCredCmd = &cobra.Command{
 		}
 
 		encodedUrl := url.QueryEscape(host)
-		gitProvider, _, _ := apiClient.GitProviderAPI.GetGitProviderForUrl(ctx, encodedUrl).Execute()
+		gitProvider, res, err := apiClient.GitProviderAPI.GetGitProviderForUrl(ctx, encodedUrl).Execute()
+		if err != nil {
+			// Git shows the helper's stderr, e.g. to point out an expired token
+			fmt.Fprintln(os.Stderr, apiclient.HandleErrorResponse(res, err))
+			os.Exit(1)
+			return
+		}
 		if gitProvider == nil {
 			fmt.Println("error: git provider not found")
 			os.Exit(1)
@@ -77,6 +77,20 @@ func (a *ApiKeyStore) Save(apiKey *apikey.ApiKey) error {
 	return nil
 }
 
+func (a *ApiKeyStore) Rotate(replacedKey *apikey.ApiKey, newKey *apikey.ApiKey) error {
+	return a.db.Transaction(func(tx *gorm.DB) error {
+		// The replaced key is renamed first to free its name for the new key
+		replacedKeyDTO := ToApiKeyDTO(*replacedKey)
+		err := tx.Save(&replacedKeyDTO).Error
+		if err != nil {
+			return err
+		}
+
+		newKeyDTO := ToApiKeyDTO(*newKey)
+		return tx.Create(&newKeyDTO).Error
+	})
+}
+
 func (a *ApiKeyStore) Delete(apiKey *apikey.ApiKey) error {
 	tx := a.db.Where("key_hash = ?", apiKey.KeyHash).Delete(&ApiKeyDTO{})
 	if tx.Error != nil {
@@ -0,0 +1,56 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApiKeyStore_Rotate(t *testing.T) {
+	store, err := NewApiKeyStore(newTestDB(t))
+	require.NoError(t, err)
+
+	oldKey := &apikey.ApiKey{KeyHash: "old", Type: apikey.ApiKeyTypeClient, Role: apikey.ApiKeyRoleAdmin, Name: "default"}
+	otherKey := &apikey.ApiKey{KeyHash: "other", Type: apikey.ApiKeyTypeClient, Role: apikey.ApiKeyRoleAdmin, Name: "other"}
+	require.NoError(t, store.Save(oldKey))
+	require.NoError(t, store.Save(otherKey))
+
+	rotate := func(newKeyHash string) error {
+		replacedKey := *oldKey
+		expiresAt := time.Now().Add(time.Hour)
+		replacedKey.Name = "default-rotated"
+		replacedKey.ExpiresAt = &expiresAt
+
+		return store.Rotate(&replacedKey, &apikey.ApiKey{KeyHash: newKeyHash, Type: oldKey.Type, Role: oldKey.Role, Name: oldKey.Name})
+	}
+
+	t.Run("rolls back the rename if the new key cannot be saved", func(t *testing.T) {
+		require.Error(t, rotate(otherKey.KeyHash))
+
+		key, err := store.FindByName("default")
+		require.NoError(t, err)
+		require.Equal(t, "old", key.KeyHash)
+		require.Nil(t, key.ExpiresAt)
+
+		_, err = store.FindByName("default-rotated")
+		require.True(t, apikey.IsApiKeyNotFound(err))
+	})
+
+	t.Run("renames the old key and saves the new key", func(t *testing.T) {
+		require.NoError(t, rotate("new"))
+
+		key, err := store.FindByName("default")
+		require.NoError(t, err)
+		require.Equal(t, "new", key.KeyHash)
+
+		replacedKey, err := store.FindByName("default-rotated")
+		require.NoError(t, err)
+		require.Equal(t, "old", replacedKey.KeyHash)
+		require.NotNil(t, replacedKey.ExpiresAt)
+	})
+}
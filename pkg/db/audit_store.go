@@ -0,0 +1,84 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/daytonaio/daytona/pkg/audit"
+	. "github.com/daytonaio/daytona/pkg/db/dto"
+)
+
+type AuditStore struct {
+	db *gorm.DB
+}
+
+func NewAuditStore(db *gorm.DB) (*AuditStore, error) {
+	err := db.AutoMigrate(&AuditRecordDTO{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuditStore{db: db}, nil
+}
+
+func (s *AuditStore) List(filter *audit.Filter) ([]*audit.Record, error) {
+	recordDTOs := []AuditRecordDTO{}
+	tx := processAuditFilters(s.db, filter).Order("timestamp desc").Find(&recordDTOs)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	records := []*audit.Record{}
+	for _, recordDTO := range recordDTOs {
+		records = append(records, ToAuditRecord(recordDTO))
+	}
+
+	return records, nil
+}
+
+func (s *AuditStore) Save(record *audit.Record) error {
+	recordDTO := ToAuditRecordDTO(record)
+	tx := s.db.Save(&recordDTO)
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (s *AuditStore) DeleteBefore(timestamp time.Time) (int, error) {
+	tx := s.db.Where("timestamp < ?", timestamp).Delete(&AuditRecordDTO{})
+	if tx.Error != nil {
+		return 0, tx.Error
+	}
+
+	return int(tx.RowsAffected), nil
+}
+
+func processAuditFilters(tx *gorm.DB, filter *audit.Filter) *gorm.DB {
+	if filter == nil {
+		return tx
+	}
+
+	if filter.Since != nil {
+		tx = tx.Where("timestamp >= ?", *filter.Since)
+	}
+	if filter.KeyName != nil {
+		tx = tx.Where("key_name = ?", *filter.KeyName)
+	}
+	if filter.Resource != nil {
+		tx = tx.Where(`resource LIKE ? ESCAPE '\'`, escapeLike(*filter.Resource)+"%")
+	}
+
+	return tx
+}
+
+// escapeLike escapes the wildcards of a LIKE pattern so that the value is matched literally
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
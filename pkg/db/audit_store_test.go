@@ -0,0 +1,65 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/audit"
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func newTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "db")), &gorm.Config{})
+	require.NoError(t, err)
+
+	return db
+}
+
+func TestAuditStore(t *testing.T) {
+	store, err := NewAuditStore(newTestDB(t))
+	require.NoError(t, err)
+
+	now := time.Now()
+
+	old := &audit.Record{Id: "old", KeyName: "default", Resource: "/workspace/my_ws", Timestamp: now.Add(-48 * time.Hour)}
+	underscore := &audit.Record{Id: "underscore", KeyName: "default", Resource: "/workspace/my_ws/start", Timestamp: now.Add(-time.Minute)}
+	wildcardMatch := &audit.Record{Id: "wildcard-match", KeyName: "default", Resource: "/workspace/myXws", Timestamp: now}
+	percent := &audit.Record{Id: "percent", KeyName: "default", Resource: "/workspace/100%", Timestamp: now.Add(-time.Second)}
+
+	for _, r := range []*audit.Record{old, underscore, wildcardMatch, percent} {
+		require.NoError(t, store.Save(r))
+	}
+
+	listIds := func(resource string) []string {
+		records, err := store.List(&audit.Filter{Resource: &resource})
+		require.NoError(t, err)
+
+		ids := []string{}
+		for _, r := range records {
+			ids = append(ids, r.Id)
+		}
+		return ids
+	}
+
+	t.Run("Resource filter matches wildcards literally", func(t *testing.T) {
+		require.Equal(t, []string{"underscore", "old"}, listIds("/workspace/my_ws"))
+		require.Equal(t, []string{"percent"}, listIds("/workspace/100%"))
+		require.Empty(t, listIds("/workspace/%"))
+	})
+
+	t.Run("DeleteBefore", func(t *testing.T) {
+		deleted, err := store.DeleteBefore(now.Add(-24 * time.Hour))
+		require.NoError(t, err)
+		require.Equal(t, 1, deleted)
+
+		records, err := store.List(nil)
+		require.NoError(t, err)
+		require.Len(t, records, 3)
+	})
+}
@@ -80,6 +80,22 @@ func (b *BuildStore) Save(build *build.Build) error {
 	return nil
 }
 
+func (b *BuildStore) SaveIfState(newBuild *build.Build, states []build.BuildState) error {
+	b.Lock.Lock()
+	defer b.Lock.Unlock()
+
+	buildDTO := ToBuildDTO(newBuild)
+	tx := b.db.Model(&BuildDTO{}).Where("id = ? AND state IN ?", buildDTO.Id, states).Select("*").Updates(&buildDTO)
+	if tx.Error != nil {
+		return tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		return build.ErrBuildStateChanged
+	}
+
+	return nil
+}
+
 func (b *BuildStore) Delete(id string) error {
 	b.Lock.Lock()
 	defer b.Lock.Unlock()
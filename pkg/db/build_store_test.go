@@ -0,0 +1,38 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"testing"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/build"
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestBuild(id string, state build.BuildState) *build.Build {
+	return &build.Build{Id: id, State: state, Repository: &gitprovider.GitRepository{}}
+}
+
+func TestBuildStoreSaveIfState(t *testing.T) {
+	store, err := NewBuildStore(newTestDB(t))
+	require.NoError(t, err)
+
+	require.NoError(t, store.Save(newTestBuild("1", build.BuildStateRunning)))
+
+	err = store.SaveIfState(newTestBuild("1", build.BuildStateCancelled), []build.BuildState{build.BuildStatePendingRun, build.BuildStateRunning})
+	require.NoError(t, err)
+
+	// A runner still holding the running build must not overwrite the cancellation
+	err = store.SaveIfState(newTestBuild("1", build.BuildStatePublished), []build.BuildState{build.BuildStatePendingRun, build.BuildStateRunning})
+	require.True(t, build.IsBuildStateChanged(err))
+
+	err = store.SaveIfState(newTestBuild("2", build.BuildStateRunning), []build.BuildState{build.BuildStatePendingRun})
+	require.True(t, build.IsBuildStateChanged(err))
+
+	b, err := store.Find(&build.Filter{Id: util.Pointer("1")})
+	require.NoError(t, err)
+	require.Equal(t, build.BuildStateCancelled, b.State)
+}
@@ -4,27 +4,44 @@
 package dto
 
 import (
+	"time"
+
 	"github.com/daytonaio/daytona/pkg/apikey"
 )
 
 type ApiKeyDTO struct {
-	KeyHash string `gorm:"primaryKey"`
-	Type    apikey.ApiKeyType
-	Name    string `gorm:"uniqueIndex"`
+	KeyHash    string `gorm:"primaryKey"`
+	Type       apikey.ApiKeyType
+	Role       apikey.ApiKeyRole
+	Name       string `gorm:"uniqueIndex"`
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
 }
 
 func ToApiKeyDTO(apiKey apikey.ApiKey) ApiKeyDTO {
 	return ApiKeyDTO{
-		KeyHash: apiKey.KeyHash,
-		Type:    apiKey.Type,
-		Name:    apiKey.Name,
+		KeyHash:    apiKey.KeyHash,
+		Type:       apiKey.Type,
+		Role:       apiKey.Role,
+		Name:       apiKey.Name,
+		ExpiresAt:  apiKey.ExpiresAt,
+		LastUsedAt: apiKey.LastUsedAt,
 	}
 }
 
 func ToApiKey(apiKeyDTO ApiKeyDTO) apikey.ApiKey {
+	role := apiKeyDTO.Role
+	// Keys saved before roles were introduced
+	if role == "" {
+		role = apikey.GetDefaultRole(apiKeyDTO.Type)
+	}
+
 	return apikey.ApiKey{
-		KeyHash: apiKeyDTO.KeyHash,
-		Type:    apiKeyDTO.Type,
-		Name:    apiKeyDTO.Name,
+		KeyHash:    apiKeyDTO.KeyHash,
+		Type:       apiKeyDTO.Type,
+		Role:       role,
+		Name:       apiKeyDTO.Name,
+		ExpiresAt:  apiKeyDTO.ExpiresAt,
+		LastUsedAt: apiKeyDTO.LastUsedAt,
 	}
 }
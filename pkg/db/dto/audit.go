@@ -0,0 +1,54 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/audit"
+)
+
+type AuditRecordDTO struct {
+	Id         string `gorm:"primaryKey"`
+	KeyName    string `gorm:"index"`
+	KeyType    apikey.ApiKeyType
+	Method     string
+	Route      string
+	Resource   string
+	StatusCode int
+	Result     audit.Result
+	Error      string
+	Timestamp  time.Time `gorm:"index"`
+}
+
+func ToAuditRecordDTO(r *audit.Record) AuditRecordDTO {
+	return AuditRecordDTO{
+		Id:         r.Id,
+		KeyName:    r.KeyName,
+		KeyType:    r.KeyType,
+		Method:     r.Method,
+		Route:      r.Route,
+		Resource:   r.Resource,
+		StatusCode: r.StatusCode,
+		Result:     r.Result,
+		Error:      r.Error,
+		Timestamp:  r.Timestamp,
+	}
+}
+
+func ToAuditRecord(recordDTO AuditRecordDTO) *audit.Record {
+	return &audit.Record{
+		Id:         recordDTO.Id,
+		KeyName:    recordDTO.KeyName,
+		KeyType:    recordDTO.KeyType,
+		Method:     recordDTO.Method,
+		Route:      recordDTO.Route,
+		Resource:   recordDTO.Resource,
+		StatusCode: recordDTO.StatusCode,
+		Result:     recordDTO.Result,
+		Error:      recordDTO.Error,
+		Timestamp:  recordDTO.Timestamp,
+	}
+}
@@ -18,6 +18,10 @@ type BuildDTO struct {
 	Repository  RepositoryDTO     `gorm:"serializer:json"`
 	EnvVars     map[string]string `json:"envVars" gorm:"serializer:json"`
 	PrebuildId  string            `json:"prebuildId"`
+	Priority    int               `json:"priority"`
+	RetryCount  int               `json:"retryCount"`
+	MaxRetries  *int              `json:"maxRetries,omitempty"`
+	NextRetryAt *time.Time        `json:"nextRetryAt,omitempty"`
 	CreatedAt   time.Time         `json:"createdAt"`
 	UpdatedAt   time.Time         `json:"updatedAt"`
 }
@@ -32,6 +36,10 @@ func ToBuildDTO(build *build.Build) BuildDTO {
 		Repository:  ToRepositoryDTO(build.Repository),
 		EnvVars:     build.EnvVars,
 		PrebuildId:  build.PrebuildId,
+		Priority:    build.Priority,
+		RetryCount:  build.RetryCount,
+		MaxRetries:  build.MaxRetries,
+		NextRetryAt: build.NextRetryAt,
 		CreatedAt:   build.CreatedAt,
 		UpdatedAt:   build.UpdatedAt,
 	}
@@ -47,6 +55,10 @@ func ToBuild(buildDTO BuildDTO) *build.Build {
 		Repository:  ToRepository(buildDTO.Repository),
 		EnvVars:     buildDTO.EnvVars,
 		PrebuildId:  buildDTO.PrebuildId,
+		Priority:    buildDTO.Priority,
+		RetryCount:  buildDTO.RetryCount,
+		MaxRetries:  buildDTO.MaxRetries,
+		NextRetryAt: buildDTO.NextRetryAt,
 		CreatedAt:   buildDTO.CreatedAt,
 		UpdatedAt:   buildDTO.UpdatedAt,
 	}
@@ -10,7 +10,7 @@ import (
 type ContainerRegistryDTO struct {
 	Server   string `gorm:"primaryKey"`
 	Username string `json:"username"`
-	Password string `json:"password"`
+	Password string `json:"password" gorm:"serializer:encrypted"`
 }
 
 func ToContainerRegistryDTO(cr *containerregistry.ContainerRegistry) ContainerRegistryDTO {
@@ -0,0 +1,172 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/daytonaio/daytona/pkg/encryption"
+	"gorm.io/gorm/schema"
+)
+
+var keyring *encryption.Keyring
+
+var ErrKeyringNotSet = errors.New("encryption keyring is not set")
+
+// SetEncryptionKeyring sets the keyring used by the encrypted serializer and EncryptedString
+func SetEncryptionKeyring(k *encryption.Keyring) {
+	keyring = k
+}
+
+func init() {
+	schema.RegisterSerializer("encrypted", encryptedSerializer{})
+}
+
+// encryptedSerializer encrypts string columns as they are and other columns as JSON.
+// Values that were saved before encryption was introduced are read as plaintext
+type encryptedSerializer struct{}
+
+func (encryptedSerializer) Scan(ctx context.Context, field *schema.Field, dst reflect.Value, dbValue interface{}) error {
+	fieldValue := reflect.New(field.FieldType)
+
+	if dbValue != nil {
+		var value string
+		switch v := dbValue.(type) {
+		case []byte:
+			value = string(v)
+		case string:
+			value = v
+		default:
+			return fmt.Errorf("failed to decrypt value: %#v", dbValue)
+		}
+
+		plaintext, err := decrypt(value)
+		if err != nil {
+			return fmt.Errorf("failed to decrypt %s: %w", field.Name, err)
+		}
+
+		if field.FieldType.Kind() == reflect.String {
+			fieldValue.Elem().SetString(string(plaintext))
+		} else if len(plaintext) > 0 {
+			err = json.Unmarshal(plaintext, fieldValue.Interface())
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	field.ReflectValueOf(ctx, dst).Set(fieldValue.Elem())
+	return nil
+}
+
+func (encryptedSerializer) Value(ctx context.Context, field *schema.Field, dst reflect.Value, fieldValue interface{}) (interface{}, error) {
+	var plaintext []byte
+
+	value := reflect.ValueOf(fieldValue)
+	if value.Kind() == reflect.String {
+		plaintext = []byte(value.String())
+	} else {
+		var err error
+		plaintext, err = json.Marshal(fieldValue)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return encrypt(plaintext)
+}
+
+// EncryptedString is encrypted when it is marshalled to JSON.
+// It is used for secrets that are nested in JSON serialized columns
+type EncryptedString string
+
+func (s EncryptedString) MarshalJSON() ([]byte, error) {
+	value, err := encrypt([]byte(s))
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(value)
+}
+
+func (s *EncryptedString) UnmarshalJSON(data []byte) error {
+	var value string
+	err := json.Unmarshal(data, &value)
+	if err != nil {
+		return err
+	}
+
+	plaintext, err := decrypt(value)
+	if err != nil {
+		return err
+	}
+
+	*s = EncryptedString(plaintext)
+	return nil
+}
+
+// EncryptedEnvVars are encrypted as a whole when they are marshalled to JSON.
+// Env vars that were saved as a plain JSON object before encryption was introduced are read as they are
+type EncryptedEnvVars map[string]string
+
+func (e EncryptedEnvVars) MarshalJSON() ([]byte, error) {
+	if e == nil {
+		return []byte("null"), nil
+	}
+
+	plaintext, err := json.Marshal(map[string]string(e))
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := encrypt(plaintext)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(value)
+}
+
+func (e *EncryptedEnvVars) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 || data[0] != '"' {
+		return json.Unmarshal(data, (*map[string]string)(e))
+	}
+
+	var value string
+	err := json.Unmarshal(data, &value)
+	if err != nil {
+		return err
+	}
+
+	plaintext, err := decrypt(value)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(plaintext, (*map[string]string)(e))
+}
+
+func encrypt(plaintext []byte) (string, error) {
+	if keyring == nil {
+		return "", ErrKeyringNotSet
+	}
+
+	return keyring.Encrypt(plaintext)
+}
+
+func decrypt(value string) ([]byte, error) {
+	if !encryption.IsEncrypted(value) {
+		return []byte(value), nil
+	}
+
+	if keyring == nil {
+		return nil, ErrKeyringNotSet
+	}
+
+	return keyring.Decrypt(value)
+}
@@ -0,0 +1,52 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/encryption"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptedEnvVars(t *testing.T) {
+	key, err := encryption.GenerateKey()
+	require.NoError(t, err)
+	k, err := encryption.NewKeyring(key)
+	require.NoError(t, err)
+
+	SetEncryptionKeyring(k)
+	t.Cleanup(func() { SetEncryptionKeyring(nil) })
+
+	t.Run("round trip", func(t *testing.T) {
+		data, err := json.Marshal(EncryptedEnvVars{"FOO": "secret"})
+		require.NoError(t, err)
+		require.NotContains(t, string(data), "secret")
+
+		var value string
+		require.NoError(t, json.Unmarshal(data, &value))
+		require.True(t, encryption.IsEncrypted(value))
+
+		var envVars EncryptedEnvVars
+		require.NoError(t, json.Unmarshal(data, &envVars))
+		require.Equal(t, EncryptedEnvVars{"FOO": "secret"}, envVars)
+	})
+
+	t.Run("reads plaintext env vars", func(t *testing.T) {
+		var envVars EncryptedEnvVars
+		require.NoError(t, json.Unmarshal([]byte(`{"FOO":"bar"}`), &envVars))
+		require.Equal(t, EncryptedEnvVars{"FOO": "bar"}, envVars)
+	})
+
+	t.Run("keeps nil env vars", func(t *testing.T) {
+		data, err := json.Marshal(EncryptedEnvVars(nil))
+		require.NoError(t, err)
+		require.Equal(t, "null", string(data))
+
+		var envVars EncryptedEnvVars
+		require.NoError(t, json.Unmarshal(data, &envVars))
+		require.Nil(t, envVars)
+	})
+}
@@ -4,22 +4,30 @@
 package dto
 
 import (
+	"time"
+
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 )
 
 type GitProviderConfigDTO struct {
-	Id         string  `gorm:"primaryKey"`
-	Username   string  `json:"username"`
-	Token      string  `json:"token"`
-	BaseApiUrl *string `json:"baseApiUrl,omitempty"`
+	Id             string     `gorm:"primaryKey"`
+	Username       string     `json:"username"`
+	Token          string     `json:"token" gorm:"serializer:encrypted"`
+	BaseApiUrl     *string    `json:"baseApiUrl,omitempty"`
+	RefreshToken   string     `json:"refreshToken,omitempty" gorm:"serializer:encrypted"`
+	TokenScopes    []string   `json:"tokenScopes,omitempty" gorm:"serializer:json"`
+	TokenExpiresAt *time.Time `json:"tokenExpiresAt,omitempty"`
 }
 
 func ToGitProviderConfigDTO(gitProvider gitprovider.GitProviderConfig) GitProviderConfigDTO {
 	gitProviderDTO := GitProviderConfigDTO{
-		Id:         gitProvider.Id,
-		Username:   gitProvider.Username,
-		Token:      gitProvider.Token,
-		BaseApiUrl: gitProvider.BaseApiUrl,
+		Id:             gitProvider.Id,
+		Username:       gitProvider.Username,
+		Token:          gitProvider.Token,
+		BaseApiUrl:     gitProvider.BaseApiUrl,
+		RefreshToken:   gitProvider.RefreshToken,
+		TokenScopes:    gitProvider.TokenScopes,
+		TokenExpiresAt: gitProvider.TokenExpiresAt,
 	}
 
 	return gitProviderDTO
@@ -27,9 +35,12 @@ func ToGitProviderConfigDTO(gitProvider gitprovider.GitProviderConfig) GitProvid
 
 func ToGitProviderConfig(gitProviderDTO GitProviderConfigDTO) gitprovider.GitProviderConfig {
 	return gitprovider.GitProviderConfig{
-		Id:         gitProviderDTO.Id,
-		Username:   gitProviderDTO.Username,
-		Token:      gitProviderDTO.Token,
-		BaseApiUrl: gitProviderDTO.BaseApiUrl,
+		Id:             gitProviderDTO.Id,
+		Username:       gitProviderDTO.Username,
+		Token:          gitProviderDTO.Token,
+		BaseApiUrl:     gitProviderDTO.BaseApiUrl,
+		RefreshToken:   gitProviderDTO.RefreshToken,
+		TokenScopes:    gitProviderDTO.TokenScopes,
+		TokenExpiresAt: gitProviderDTO.TokenExpiresAt,
 	}
 }
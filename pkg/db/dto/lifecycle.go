@@ -0,0 +1,43 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/workspace/lifecycle"
+)
+
+type LifecycleDTO struct {
+	State         string     `json:"state"`
+	Error         string     `json:"error,omitempty"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	UpdatedAt     time.Time  `json:"updatedAt"`
+	LastStartedAt *time.Time `json:"lastStartedAt,omitempty"`
+}
+
+func ToLifecycleDTO(l lifecycle.Lifecycle) *LifecycleDTO {
+	return &LifecycleDTO{
+		State:         string(l.State),
+		Error:         l.Error,
+		CreatedAt:     l.CreatedAt,
+		UpdatedAt:     l.UpdatedAt,
+		LastStartedAt: l.LastStartedAt,
+	}
+}
+
+// ToLifecycle treats workspaces and projects saved before lifecycle states were recorded as stopped
+func ToLifecycle(lifecycleDTO *LifecycleDTO) lifecycle.Lifecycle {
+	if lifecycleDTO == nil {
+		return lifecycle.Lifecycle{State: lifecycle.StateStopped}
+	}
+
+	return lifecycle.Lifecycle{
+		State:         lifecycle.State(lifecycleDTO.State),
+		Error:         lifecycleDTO.Error,
+		CreatedAt:     lifecycleDTO.CreatedAt,
+		UpdatedAt:     lifecycleDTO.UpdatedAt,
+		LastStartedAt: lifecycleDTO.LastStartedAt,
+	}
+}
@@ -9,7 +9,7 @@ const ProfileDataId = "profile_data"
 
 type ProfileDataDTO struct {
 	Id      string            `gorm:"primaryKey"`
-	EnvVars map[string]string `gorm:"serializer:json"`
+	EnvVars map[string]string `gorm:"serializer:encrypted"`
 }
 
 func ToProfileDataDTO(profileData *profiledata.ProfileData) ProfileDataDTO {
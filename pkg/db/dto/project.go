@@ -35,29 +35,60 @@ type GitStatusDTO struct {
 }
 
 type ProjectStateDTO struct {
-	UpdatedAt string        `json:"updatedAt"`
-	Uptime    uint64        `json:"uptime"`
-	GitStatus *GitStatusDTO `json:"gitStatus"`
+	UpdatedAt    string             `json:"updatedAt"`
+	Uptime       uint64             `json:"uptime"`
+	GitStatus    *GitStatusDTO      `json:"gitStatus"`
+	LastActivity string             `json:"lastActivity,omitempty"`
+	Metrics      *ProjectMetricsDTO `json:"metrics,omitempty"`
+}
+
+type ProjectMetricsDTO struct {
+	CpuUsage    float64 `json:"cpuUsage"`
+	MemoryUsage uint64  `json:"memoryUsage"`
+	MemoryLimit uint64  `json:"memoryLimit"`
+	DiskUsage   uint64  `json:"diskUsage"`
+	DiskTotal   uint64  `json:"diskTotal"`
+	Processes   uint64  `json:"processes"`
+	CollectedAt string  `json:"collectedAt"`
 }
 
 type ProjectBuildDevcontainerDTO struct {
 	FilePath string `json:"filePath"`
 }
 
+type ProjectBuildDockerfileDTO struct {
+	FilePath  string            `json:"filePath"`
+	Context   string            `json:"context,omitempty"`
+	BuildArgs map[string]string `json:"buildArgs,omitempty"`
+	Target    string            `json:"target,omitempty"`
+}
+
 type ProjectBuildDTO struct {
-	Devcontainer *ProjectBuildDevcontainerDTO `json:"devcontainer"`
+	Devcontainer *ProjectBuildDevcontainerDTO `json:"devcontainer,omitempty"`
+	Dockerfile   *ProjectBuildDockerfileDTO   `json:"dockerfile,omitempty"`
+}
+
+type ResourceLimitsDTO struct {
+	Cpus   float64 `json:"cpus,omitempty"`
+	Memory uint64  `json:"memory,omitempty"`
+	Disk   uint64  `json:"disk,omitempty"`
 }
 
 type ProjectDTO struct {
-	Name        string           `json:"name"`
-	Image       string           `json:"image"`
-	User        string           `json:"user"`
-	Build       *ProjectBuildDTO `json:"build,omitempty" gorm:"serializer:json"`
-	Repository  RepositoryDTO    `json:"repository" gorm:"serializer:json"`
-	WorkspaceId string           `json:"workspaceId"`
-	Target      string           `json:"target"`
-	ApiKey      string           `json:"apiKey"`
-	State       *ProjectStateDTO `json:"state,omitempty" gorm:"serializer:json"`
+	Name        string             `json:"name"`
+	Image       string             `json:"image"`
+	User        string             `json:"user"`
+	Build       *ProjectBuildDTO   `json:"build,omitempty" gorm:"serializer:json"`
+	Resources   *ResourceLimitsDTO `json:"resources,omitempty" gorm:"serializer:json"`
+	Repository  RepositoryDTO      `json:"repository" gorm:"serializer:json"`
+	EnvVars     EncryptedEnvVars   `json:"envVars"`
+	Secrets     []string           `json:"secrets,omitempty" gorm:"serializer:json"`
+	WorkspaceId string             `json:"workspaceId"`
+	Target      string             `json:"target"`
+	ApiKey      EncryptedString    `json:"apiKey"`
+	State       *ProjectStateDTO   `json:"state,omitempty" gorm:"serializer:json"`
+	Lifecycle   *LifecycleDTO      `json:"lifecycle,omitempty" gorm:"serializer:json"`
+	Patch       string             `json:"patch,omitempty"`
 }
 
 func ToProjectDTO(project *project.Project) ProjectDTO {
@@ -66,11 +97,16 @@ func ToProjectDTO(project *project.Project) ProjectDTO {
 		Image:       project.Image,
 		User:        project.User,
 		Build:       ToProjectBuildDTO(project.BuildConfig),
+		Resources:   ToResourceLimitsDTO(project.Resources),
 		Repository:  ToRepositoryDTO(project.Repository),
+		EnvVars:     EncryptedEnvVars(project.EnvVars),
+		Secrets:     project.Secrets,
 		WorkspaceId: project.WorkspaceId,
 		Target:      project.Target,
 		State:       ToProjectStateDTO(project.State),
-		ApiKey:      project.ApiKey,
+		Lifecycle:   ToLifecycleDTO(project.Lifecycle),
+		ApiKey:      EncryptedString(project.ApiKey),
+		Patch:       project.Patch,
 	}
 }
 
@@ -126,9 +162,27 @@ func ToProjectStateDTO(state *project.ProjectState) *ProjectStateDTO {
 	}
 
 	return &ProjectStateDTO{
-		UpdatedAt: state.UpdatedAt,
-		Uptime:    state.Uptime,
-		GitStatus: ToGitStatusDTO(state.GitStatus),
+		UpdatedAt:    state.UpdatedAt,
+		Uptime:       state.Uptime,
+		GitStatus:    ToGitStatusDTO(state.GitStatus),
+		LastActivity: state.LastActivity,
+		Metrics:      ToProjectMetricsDTO(state.Metrics),
+	}
+}
+
+func ToProjectMetricsDTO(metrics *project.ProjectMetrics) *ProjectMetricsDTO {
+	if metrics == nil {
+		return nil
+	}
+
+	return &ProjectMetricsDTO{
+		CpuUsage:    metrics.CpuUsage,
+		MemoryUsage: metrics.MemoryUsage,
+		MemoryLimit: metrics.MemoryLimit,
+		DiskUsage:   metrics.DiskUsage,
+		DiskTotal:   metrics.DiskTotal,
+		Processes:   metrics.Processes,
+		CollectedAt: metrics.CollectedAt,
 	}
 }
 
@@ -137,14 +191,35 @@ func ToProjectBuildDTO(build *buildconfig.BuildConfig) *ProjectBuildDTO {
 		return nil
 	}
 
-	if build.Devcontainer == nil {
-		return &ProjectBuildDTO{}
-	}
+	buildDTO := &ProjectBuildDTO{}
 
-	return &ProjectBuildDTO{
-		Devcontainer: &ProjectBuildDevcontainerDTO{
+	if build.Devcontainer != nil {
+		buildDTO.Devcontainer = &ProjectBuildDevcontainerDTO{
 			FilePath: build.Devcontainer.FilePath,
-		},
+		}
+	}
+
+	if build.Dockerfile != nil {
+		buildDTO.Dockerfile = &ProjectBuildDockerfileDTO{
+			FilePath:  build.Dockerfile.FilePath,
+			Context:   build.Dockerfile.Context,
+			BuildArgs: build.Dockerfile.BuildArgs,
+			Target:    build.Dockerfile.Target,
+		}
+	}
+
+	return buildDTO
+}
+
+func ToResourceLimitsDTO(limits *project.ResourceLimits) *ResourceLimitsDTO {
+	if limits == nil {
+		return nil
+	}
+
+	return &ResourceLimitsDTO{
+		Cpus:   limits.Cpus,
+		Memory: limits.Memory,
+		Disk:   limits.Disk,
 	}
 }
 
@@ -154,11 +229,16 @@ func ToProject(projectDTO ProjectDTO) *project.Project {
 		Image:       projectDTO.Image,
 		User:        projectDTO.User,
 		BuildConfig: ToProjectBuild(projectDTO.Build),
+		Resources:   ToResourceLimits(projectDTO.Resources),
 		Repository:  ToRepository(projectDTO.Repository),
+		EnvVars:     map[string]string(projectDTO.EnvVars),
+		Secrets:     projectDTO.Secrets,
 		WorkspaceId: projectDTO.WorkspaceId,
 		Target:      projectDTO.Target,
 		State:       ToProjectState(projectDTO.State),
-		ApiKey:      projectDTO.ApiKey,
+		Lifecycle:   ToLifecycle(projectDTO.Lifecycle),
+		ApiKey:      string(projectDTO.ApiKey),
+		Patch:       projectDTO.Patch,
 	}
 }
 
@@ -197,9 +277,27 @@ func ToProjectState(stateDTO *ProjectStateDTO) *project.ProjectState {
 	}
 
 	return &project.ProjectState{
-		UpdatedAt: stateDTO.UpdatedAt,
-		Uptime:    stateDTO.Uptime,
-		GitStatus: ToGitStatus(stateDTO.GitStatus),
+		UpdatedAt:    stateDTO.UpdatedAt,
+		Uptime:       stateDTO.Uptime,
+		GitStatus:    ToGitStatus(stateDTO.GitStatus),
+		LastActivity: stateDTO.LastActivity,
+		Metrics:      ToProjectMetrics(stateDTO.Metrics),
+	}
+}
+
+func ToProjectMetrics(metricsDTO *ProjectMetricsDTO) *project.ProjectMetrics {
+	if metricsDTO == nil {
+		return nil
+	}
+
+	return &project.ProjectMetrics{
+		CpuUsage:    metricsDTO.CpuUsage,
+		MemoryUsage: metricsDTO.MemoryUsage,
+		MemoryLimit: metricsDTO.MemoryLimit,
+		DiskUsage:   metricsDTO.DiskUsage,
+		DiskTotal:   metricsDTO.DiskTotal,
+		Processes:   metricsDTO.Processes,
+		CollectedAt: metricsDTO.CollectedAt,
 	}
 }
 
@@ -225,13 +323,34 @@ func ToProjectBuild(buildDTO *ProjectBuildDTO) *buildconfig.BuildConfig {
 		return nil
 	}
 
-	if buildDTO.Devcontainer == nil {
-		return &buildconfig.BuildConfig{}
-	}
+	build := &buildconfig.BuildConfig{}
 
-	return &buildconfig.BuildConfig{
-		Devcontainer: &buildconfig.DevcontainerConfig{
+	if buildDTO.Devcontainer != nil {
+		build.Devcontainer = &buildconfig.DevcontainerConfig{
 			FilePath: buildDTO.Devcontainer.FilePath,
-		},
+		}
+	}
+
+	if buildDTO.Dockerfile != nil {
+		build.Dockerfile = &buildconfig.DockerfileConfig{
+			FilePath:  buildDTO.Dockerfile.FilePath,
+			Context:   buildDTO.Dockerfile.Context,
+			BuildArgs: buildDTO.Dockerfile.BuildArgs,
+			Target:    buildDTO.Dockerfile.Target,
+		}
+	}
+
+	return build
+}
+
+func ToResourceLimits(limitsDTO *ResourceLimitsDTO) *project.ResourceLimits {
+	if limitsDTO == nil {
+		return nil
+	}
+
+	return &project.ResourceLimits{
+		Cpus:   limitsDTO.Cpus,
+		Memory: limitsDTO.Memory,
+		Disk:   limitsDTO.Disk,
 	}
 }
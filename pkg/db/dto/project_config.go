@@ -8,14 +8,16 @@ import (
 )
 
 type ProjectConfigDTO struct {
-	Name          string            `gorm:"primaryKey"`
-	Image         string            `json:"image"`
-	User          string            `json:"user"`
-	Build         *ProjectBuildDTO  `json:"build,omitempty" gorm:"serializer:json"`
-	RepositoryUrl string            `json:"repositoryUrl"`
-	EnvVars       map[string]string `json:"envVars" gorm:"serializer:json"`
-	Prebuilds     []PrebuildDTO     `gorm:"serializer:json"`
-	IsDefault     bool              `json:"isDefault"`
+	Name          string             `gorm:"primaryKey"`
+	Image         string             `json:"image"`
+	User          string             `json:"user"`
+	Build         *ProjectBuildDTO   `json:"build,omitempty" gorm:"serializer:json"`
+	Resources     *ResourceLimitsDTO `json:"resources,omitempty" gorm:"serializer:json"`
+	RepositoryUrl string             `json:"repositoryUrl"`
+	EnvVars       map[string]string  `json:"envVars" gorm:"serializer:json"`
+	Secrets       []string           `json:"secrets,omitempty" gorm:"serializer:json"`
+	Prebuilds     []PrebuildDTO      `gorm:"serializer:json"`
+	IsDefault     bool               `json:"isDefault"`
 }
 
 type PrebuildDTO struct {
@@ -24,6 +26,7 @@ type PrebuildDTO struct {
 	CommitInterval *int     `json:"commitInterval,omitempty"`
 	TriggerFiles   []string `json:"triggerFiles,omitempty"`
 	Retention      int      `json:"retention"`
+	MaxRetries     *int     `json:"maxRetries,omitempty"`
 }
 
 func ToProjectConfigDTO(projectConfig *config.ProjectConfig) ProjectConfigDTO {
@@ -37,8 +40,10 @@ func ToProjectConfigDTO(projectConfig *config.ProjectConfig) ProjectConfigDTO {
 		Image:         projectConfig.Image,
 		User:          projectConfig.User,
 		Build:         ToProjectBuildDTO(projectConfig.BuildConfig),
+		Resources:     ToResourceLimitsDTO(projectConfig.Resources),
 		RepositoryUrl: projectConfig.RepositoryUrl,
 		EnvVars:       projectConfig.EnvVars,
+		Secrets:       projectConfig.Secrets,
 		Prebuilds:     prebuilds,
 		IsDefault:     projectConfig.IsDefault,
 	}
@@ -55,8 +60,10 @@ func ToProjectConfig(projectConfigDTO ProjectConfigDTO) *config.ProjectConfig {
 		Image:         projectConfigDTO.Image,
 		User:          projectConfigDTO.User,
 		BuildConfig:   ToProjectBuild(projectConfigDTO.Build),
+		Resources:     ToResourceLimits(projectConfigDTO.Resources),
 		RepositoryUrl: projectConfigDTO.RepositoryUrl,
 		EnvVars:       projectConfigDTO.EnvVars,
+		Secrets:       projectConfigDTO.Secrets,
 		Prebuilds:     prebuilds,
 		IsDefault:     projectConfigDTO.IsDefault,
 	}
@@ -69,6 +76,7 @@ func ToPrebuildDTO(prebuild *config.PrebuildConfig) PrebuildDTO {
 		CommitInterval: prebuild.CommitInterval,
 		TriggerFiles:   prebuild.TriggerFiles,
 		Retention:      prebuild.Retention,
+		MaxRetries:     prebuild.MaxRetries,
 	}
 }
 
@@ -79,5 +87,6 @@ func ToPrebuild(prebuildDTO PrebuildDTO) *config.PrebuildConfig {
 		CommitInterval: prebuildDTO.CommitInterval,
 		TriggerFiles:   prebuildDTO.TriggerFiles,
 		Retention:      prebuildDTO.Retention,
+		MaxRetries:     prebuildDTO.MaxRetries,
 	}
 }
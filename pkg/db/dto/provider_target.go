@@ -10,6 +10,7 @@ type ProviderTargetDTO struct {
 	ProviderName    string `json:"providerName"`
 	ProviderVersion string `json:"providerVersion"`
 	Options         string `json:"options"`
+	AutoStop        uint32 `json:"autoStop"`
 }
 
 func ToProviderTargetDTO(providerTarget *provider.ProviderTarget) ProviderTargetDTO {
@@ -18,6 +19,7 @@ func ToProviderTargetDTO(providerTarget *provider.ProviderTarget) ProviderTarget
 		ProviderName:    providerTarget.ProviderInfo.Name,
 		ProviderVersion: providerTarget.ProviderInfo.Version,
 		Options:         providerTarget.Options,
+		AutoStop:        providerTarget.AutoStop,
 	}
 }
 
@@ -28,6 +30,7 @@ func ToProviderTarget(providerTargetDTO ProviderTargetDTO) *provider.ProviderTar
 			Name:    providerTargetDTO.ProviderName,
 			Version: providerTargetDTO.ProviderVersion,
 		},
-		Options: providerTargetDTO.Options,
+		Options:  providerTargetDTO.Options,
+		AutoStop: providerTargetDTO.AutoStop,
 	}
 }
@@ -0,0 +1,32 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/secret"
+)
+
+type SecretDTO struct {
+	Name      string `gorm:"primaryKey"`
+	Value     string `gorm:"serializer:encrypted"`
+	UpdatedAt time.Time
+}
+
+func ToSecretDTO(s *secret.Secret) SecretDTO {
+	return SecretDTO{
+		Name:      s.Name,
+		Value:     s.Value,
+		UpdatedAt: s.UpdatedAt,
+	}
+}
+
+func ToSecret(secretDTO SecretDTO) *secret.Secret {
+	return &secret.Secret{
+		Name:      secretDTO.Name,
+		Value:     secretDTO.Value,
+		UpdatedAt: secretDTO.UpdatedAt,
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project/snapshot"
+)
+
+type ProjectSnapshotDTO struct {
+	Id          string `gorm:"primaryKey"`
+	Name        string
+	WorkspaceId string `gorm:"index"`
+	ProjectName string
+	CreatedAt   time.Time
+}
+
+func ToProjectSnapshotDTO(s *snapshot.Snapshot) ProjectSnapshotDTO {
+	return ProjectSnapshotDTO{
+		Id:          s.Id,
+		Name:        s.Name,
+		WorkspaceId: s.WorkspaceId,
+		ProjectName: s.ProjectName,
+		CreatedAt:   s.CreatedAt,
+	}
+}
+
+func ToProjectSnapshot(snapshotDTO ProjectSnapshotDTO) *snapshot.Snapshot {
+	return &snapshot.Snapshot{
+		Id:          snapshotDTO.Id,
+		Name:        snapshotDTO.Name,
+		WorkspaceId: snapshotDTO.WorkspaceId,
+		ProjectName: snapshotDTO.ProjectName,
+		CreatedAt:   snapshotDTO.CreatedAt,
+	}
+}
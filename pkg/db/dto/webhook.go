@@ -0,0 +1,82 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/server/events"
+	"github.com/daytonaio/daytona/pkg/webhook"
+)
+
+type WebhookDTO struct {
+	Id        string `gorm:"primaryKey"`
+	Url       string
+	Events    []events.EventType `gorm:"serializer:json"`
+	Secret    string             `gorm:"serializer:encrypted"`
+	CreatedAt time.Time
+}
+
+type WebhookDeliveryDTO struct {
+	Id         string `gorm:"primaryKey"`
+	WebhookId  string `gorm:"index"`
+	EventId    string
+	EventType  events.EventType
+	Attempts   int
+	StatusCode int
+	Success    bool
+	Error      string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func ToWebhookDTO(w *webhook.Webhook) WebhookDTO {
+	return WebhookDTO{
+		Id:        w.Id,
+		Url:       w.Url,
+		Events:    w.Events,
+		Secret:    w.Secret,
+		CreatedAt: w.CreatedAt,
+	}
+}
+
+func ToWebhook(webhookDTO WebhookDTO) *webhook.Webhook {
+	return &webhook.Webhook{
+		Id:        webhookDTO.Id,
+		Url:       webhookDTO.Url,
+		Events:    webhookDTO.Events,
+		Secret:    webhookDTO.Secret,
+		CreatedAt: webhookDTO.CreatedAt,
+	}
+}
+
+func ToWebhookDeliveryDTO(d *webhook.Delivery) WebhookDeliveryDTO {
+	return WebhookDeliveryDTO{
+		Id:         d.Id,
+		WebhookId:  d.WebhookId,
+		EventId:    d.EventId,
+		EventType:  d.EventType,
+		Attempts:   d.Attempts,
+		StatusCode: d.StatusCode,
+		Success:    d.Success,
+		Error:      d.Error,
+		CreatedAt:  d.CreatedAt,
+		UpdatedAt:  d.UpdatedAt,
+	}
+}
+
+func ToWebhookDelivery(deliveryDTO WebhookDeliveryDTO) *webhook.Delivery {
+	return &webhook.Delivery{
+		Id:         deliveryDTO.Id,
+		WebhookId:  deliveryDTO.WebhookId,
+		EventId:    deliveryDTO.EventId,
+		EventType:  deliveryDTO.EventType,
+		Attempts:   deliveryDTO.Attempts,
+		StatusCode: deliveryDTO.StatusCode,
+		Success:    deliveryDTO.Success,
+		Error:      deliveryDTO.Error,
+		CreatedAt:  deliveryDTO.CreatedAt,
+		UpdatedAt:  deliveryDTO.UpdatedAt,
+	}
+}
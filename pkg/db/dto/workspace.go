@@ -5,16 +5,20 @@ package dto
 
 import (
 	"errors"
+	"time"
 
 	"github.com/daytonaio/daytona/pkg/workspace"
 )
 
 type WorkspaceDTO struct {
-	Id       string       `gorm:"primaryKey"`
-	Name     string       `json:"name" gorm:"unique"`
-	Target   string       `json:"target"`
-	ApiKey   string       `json:"apiKey"`
-	Projects []ProjectDTO `gorm:"serializer:json"`
+	Id        string        `gorm:"primaryKey"`
+	Name      string        `json:"name" gorm:"unique"`
+	Target    string        `json:"target"`
+	ApiKey    string        `json:"apiKey" gorm:"serializer:encrypted"`
+	Projects  []ProjectDTO  `gorm:"serializer:json"`
+	Lifecycle *LifecycleDTO `gorm:"serializer:json"`
+	AutoStop  uint32        `json:"autoStop"`
+	ExpiresAt *time.Time    `json:"expiresAt"`
 }
 
 func (w WorkspaceDTO) GetProject(name string) (*ProjectDTO, error) {
@@ -29,10 +33,13 @@ func (w WorkspaceDTO) GetProject(name string) (*ProjectDTO, error) {
 
 func ToWorkspaceDTO(workspace *workspace.Workspace) WorkspaceDTO {
 	workspaceDTO := WorkspaceDTO{
-		Id:     workspace.Id,
-		Name:   workspace.Name,
-		Target: workspace.Target,
-		ApiKey: workspace.ApiKey,
+		Id:        workspace.Id,
+		Name:      workspace.Name,
+		Target:    workspace.Target,
+		ApiKey:    workspace.ApiKey,
+		Lifecycle: ToLifecycleDTO(workspace.Lifecycle),
+		AutoStop:  workspace.AutoStop,
+		ExpiresAt: workspace.ExpiresAt,
 	}
 
 	for _, project := range workspace.Projects {
@@ -44,10 +51,13 @@ func ToWorkspaceDTO(workspace *workspace.Workspace) WorkspaceDTO {
 
 func ToWorkspace(workspaceDTO WorkspaceDTO) *workspace.Workspace {
 	workspace := workspace.Workspace{
-		Id:     workspaceDTO.Id,
-		Name:   workspaceDTO.Name,
-		Target: workspaceDTO.Target,
-		ApiKey: workspaceDTO.ApiKey,
+		Id:        workspaceDTO.Id,
+		Name:      workspaceDTO.Name,
+		Target:    workspaceDTO.Target,
+		ApiKey:    workspaceDTO.ApiKey,
+		Lifecycle: ToLifecycle(workspaceDTO.Lifecycle),
+		AutoStop:  workspaceDTO.AutoStop,
+		ExpiresAt: workspaceDTO.ExpiresAt,
 	}
 
 	for _, projectDTO := range workspaceDTO.Projects {
@@ -0,0 +1,99 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"gorm.io/gorm"
+
+	. "github.com/daytonaio/daytona/pkg/db/dto"
+)
+
+// EncryptPlaintextSecrets encrypts the secrets of rows that were saved before encryption was introduced.
+// The stores must be created before calling it so that their tables exist
+func EncryptPlaintextSecrets(db *gorm.DB) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		return resaveSecrets(tx, "NOT LIKE 'enc:%'")
+	})
+}
+
+// ReencryptSecrets encrypts all secrets with the current key of the encryption keyring
+func ReencryptSecrets(db *gorm.DB) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		return resaveSecrets(tx, "")
+	})
+}
+
+// resaveSecrets saves the rows with encrypted columns again, which encrypts them with the current key.
+// If condition is set, only rows whose encrypted column matches it are saved
+func resaveSecrets(tx *gorm.DB, condition string) error {
+	err := resaveRows[GitProviderConfigDTO](tx, columnCondition("token", condition))
+	if err != nil {
+		return err
+	}
+
+	err = resaveRows[ContainerRegistryDTO](tx, columnCondition("password", condition))
+	if err != nil {
+		return err
+	}
+
+	err = resaveRows[ProfileDataDTO](tx, columnCondition("env_vars", condition))
+	if err != nil {
+		return err
+	}
+
+	err = resaveRows[SecretDTO](tx, columnCondition("value", condition))
+	if err != nil {
+		return err
+	}
+
+	err = resaveRows[WebhookDTO](tx, columnCondition("secret", condition))
+	if err != nil {
+		return err
+	}
+
+	// Project API keys and env vars are saved together with the workspace API key.
+	// Project env vars that are still plaintext are stored as JSON objects
+	workspaceCondition := ""
+	if condition != "" {
+		workspaceCondition = columnCondition("api_key", condition) + ` OR projects LIKE '%"envVars":{%'`
+	}
+
+	return resaveRows[WorkspaceDTO](tx, workspaceCondition)
+}
+
+func resaveRows[T any](tx *gorm.DB, condition string) error {
+	if !tx.Migrator().HasTable(new(T)) {
+		return nil
+	}
+
+	rows := []T{}
+
+	query := tx
+	if condition != "" {
+		query = query.Where(condition)
+	}
+
+	err := query.Find(&rows).Error
+	if err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		// Re-encrypting a value does not change it
+		err = tx.Omit("updated_at").Save(&row).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func columnCondition(column, condition string) string {
+	if condition == "" {
+		return ""
+	}
+
+	return column + " " + condition
+}
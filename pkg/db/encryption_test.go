@@ -0,0 +1,174 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/encryption"
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/profiledata"
+	"github.com/daytonaio/daytona/pkg/webhook"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func newTestKeyring(t *testing.T, keys ...[]byte) *encryption.Keyring {
+	keyring, err := encryption.NewKeyring(keys...)
+	require.NoError(t, err)
+
+	return keyring
+}
+
+func newTestKey(t *testing.T) []byte {
+	key, err := encryption.GenerateKey()
+	require.NoError(t, err)
+
+	return key
+}
+
+func getColumn(t *testing.T, db *gorm.DB, table, column string) string {
+	var value string
+	require.NoError(t, db.Table(table).Select(column).Order("rowid").Limit(1).Row().Scan(&value))
+
+	return value
+}
+
+func TestEncryptedSerializer(t *testing.T) {
+	setTestKeyring(t)
+
+	db := newTestDB(t)
+	profileDataStore, err := NewProfileDataStore(db)
+	require.NoError(t, err)
+	gitProviderStore, err := NewGitProviderConfigStore(db)
+	require.NoError(t, err)
+
+	envVars := map[string]string{"TOKEN": "profile-secret"}
+	require.NoError(t, profileDataStore.Save(&profiledata.ProfileData{EnvVars: envVars}))
+	require.NoError(t, gitProviderStore.Save(&gitprovider.GitProviderConfig{Id: "github", Token: "provider-secret"}))
+
+	require.True(t, encryption.IsEncrypted(getColumn(t, db, "profile_data_dtos", "env_vars")))
+	require.True(t, encryption.IsEncrypted(getColumn(t, db, "git_provider_config_dtos", "token")))
+
+	profileData, err := profileDataStore.Get()
+	require.NoError(t, err)
+	require.Equal(t, envVars, profileData.EnvVars)
+
+	gitProvider, err := gitProviderStore.Find("github")
+	require.NoError(t, err)
+	require.Equal(t, "provider-secret", gitProvider.Token)
+}
+
+func TestEncryptPlaintextSecrets(t *testing.T) {
+	setTestKeyring(t)
+
+	db := newTestDB(t)
+	workspaceStore, err := NewWorkspaceStore(db)
+	require.NoError(t, err)
+	gitProviderStore, err := NewGitProviderConfigStore(db)
+	require.NoError(t, err)
+	webhookStore, err := NewWebhookStore(db)
+	require.NoError(t, err)
+
+	// Rows as they were saved before encryption was introduced
+	require.NoError(t, db.Exec(
+		"INSERT INTO workspace_dtos (id, name, target, api_key, projects) VALUES (?, ?, ?, ?, ?)",
+		"ws1", "ws1", "local", "workspace-secret",
+		`[{"name":"p1","workspaceId":"ws1","repository":{"url":"https://github.com/daytonaio/daytona"},"envVars":{"FOO":"env-secret"},"apiKey":"project-secret"}]`,
+	).Error)
+	require.NoError(t, db.Exec("INSERT INTO git_provider_config_dtos (id, token) VALUES (?, ?)", "github", "provider-secret").Error)
+	require.NoError(t, db.Exec("INSERT INTO webhook_dtos (id, url, events, secret) VALUES (?, ?, ?, ?)", "webhook1", "https://example.com", "[]", "webhook-secret").Error)
+
+	// A workspace whose API key is already encrypted but whose project env vars are not
+	require.NoError(t, workspaceStore.Save(&workspace.Workspace{Id: "ws2", Name: "ws2", ApiKey: "ws2-secret"}))
+	require.NoError(t, db.Exec(
+		"UPDATE workspace_dtos SET projects = ? WHERE id = ?",
+		`[{"name":"p1","workspaceId":"ws2","repository":{},"envVars":{"BAR":"ws2-env-secret"}}]`, "ws2",
+	).Error)
+
+	require.NoError(t, EncryptPlaintextSecrets(db))
+
+	var ws2Projects string
+	require.NoError(t, db.Table("workspace_dtos").Select("projects").Where("id = ?", "ws2").Row().Scan(&ws2Projects))
+	require.NotContains(t, ws2Projects, "ws2-env-secret")
+
+	projects := getColumn(t, db, "workspace_dtos", "projects")
+	require.NotContains(t, projects, "env-secret")
+	require.NotContains(t, projects, "project-secret")
+	require.True(t, encryption.IsEncrypted(getColumn(t, db, "workspace_dtos", "api_key")))
+	require.True(t, encryption.IsEncrypted(getColumn(t, db, "git_provider_config_dtos", "token")))
+	require.True(t, encryption.IsEncrypted(getColumn(t, db, "webhook_dtos", "secret")))
+
+	w, err := workspaceStore.Find("ws1")
+	require.NoError(t, err)
+	require.Equal(t, "workspace-secret", w.ApiKey)
+	require.Equal(t, map[string]string{"FOO": "env-secret"}, w.Projects[0].EnvVars)
+	require.Equal(t, "project-secret", w.Projects[0].ApiKey)
+
+	gitProvider, err := gitProviderStore.Find("github")
+	require.NoError(t, err)
+	require.Equal(t, "provider-secret", gitProvider.Token)
+
+	storedWebhook, err := webhookStore.Find("webhook1")
+	require.NoError(t, err)
+	require.Equal(t, "webhook-secret", storedWebhook.Secret)
+
+	// Encrypted rows are left as they are
+	require.NoError(t, EncryptPlaintextSecrets(db))
+	require.Equal(t, projects, getColumn(t, db, "workspace_dtos", "projects"))
+}
+
+func TestReencryptSecrets(t *testing.T) {
+	oldKey := newTestKey(t)
+	newKey := newTestKey(t)
+
+	dto.SetEncryptionKeyring(newTestKeyring(t, oldKey))
+	t.Cleanup(func() { dto.SetEncryptionKeyring(nil) })
+
+	db := newTestDB(t)
+	workspaceStore, err := NewWorkspaceStore(db)
+	require.NoError(t, err)
+	gitProviderStore, err := NewGitProviderConfigStore(db)
+	require.NoError(t, err)
+	webhookStore, err := NewWebhookStore(db)
+	require.NoError(t, err)
+
+	require.NoError(t, workspaceStore.Save(&workspace.Workspace{
+		Id:     "ws1",
+		Name:   "ws1",
+		ApiKey: "workspace-secret",
+		Projects: []*project.Project{{
+			Name:        "p1",
+			WorkspaceId: "ws1",
+			ApiKey:      "project-secret",
+			EnvVars:     map[string]string{"FOO": "env-secret"},
+			Repository:  &gitprovider.GitRepository{},
+		}},
+	}))
+	require.NoError(t, gitProviderStore.Save(&gitprovider.GitProviderConfig{Id: "github", Token: "provider-secret"}))
+	require.NoError(t, webhookStore.Save(&webhook.Webhook{Id: "webhook1", Url: "https://example.com", Secret: "webhook-secret"}))
+
+	dto.SetEncryptionKeyring(newTestKeyring(t, newKey, oldKey))
+	require.NoError(t, ReencryptSecrets(db))
+
+	// The old key is no longer needed after re-encryption
+	dto.SetEncryptionKeyring(newTestKeyring(t, newKey))
+
+	w, err := workspaceStore.Find("ws1")
+	require.NoError(t, err)
+	require.Equal(t, "workspace-secret", w.ApiKey)
+	require.Equal(t, "project-secret", w.Projects[0].ApiKey)
+	require.Equal(t, map[string]string{"FOO": "env-secret"}, w.Projects[0].EnvVars)
+
+	gitProvider, err := gitProviderStore.Find("github")
+	require.NoError(t, err)
+	require.Equal(t, "provider-secret", gitProvider.Token)
+
+	storedWebhook, err := webhookStore.Find("webhook1")
+	require.NoError(t, err)
+	require.Equal(t, "webhook-secret", storedWebhook.Secret)
+}
@@ -0,0 +1,73 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"gorm.io/gorm"
+
+	. "github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/secret"
+)
+
+type SecretStore struct {
+	db *gorm.DB
+}
+
+func NewSecretStore(db *gorm.DB) (*SecretStore, error) {
+	err := db.AutoMigrate(&SecretDTO{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &SecretStore{db: db}, nil
+}
+
+func (s *SecretStore) List() ([]*secret.Secret, error) {
+	secretDTOs := []SecretDTO{}
+	tx := s.db.Order("name").Find(&secretDTOs)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	secrets := []*secret.Secret{}
+	for _, secretDTO := range secretDTOs {
+		secrets = append(secrets, ToSecret(secretDTO))
+	}
+
+	return secrets, nil
+}
+
+func (s *SecretStore) Find(name string) (*secret.Secret, error) {
+	secretDTO := SecretDTO{}
+	tx := s.db.Where("name = ?", name).First(&secretDTO)
+	if tx.Error != nil {
+		if IsRecordNotFound(tx.Error) {
+			return nil, secret.ErrSecretNotFound
+		}
+		return nil, tx.Error
+	}
+
+	return ToSecret(secretDTO), nil
+}
+
+func (s *SecretStore) Save(sec *secret.Secret) error {
+	tx := s.db.Save(ToSecretDTO(sec))
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (s *SecretStore) Delete(sec *secret.Secret) error {
+	tx := s.db.Delete(ToSecretDTO(sec))
+	if tx.Error != nil {
+		return tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		return secret.ErrSecretNotFound
+	}
+
+	return nil
+}
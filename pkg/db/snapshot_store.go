@@ -0,0 +1,74 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"gorm.io/gorm"
+
+	. "github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/workspace/project/snapshot"
+)
+
+type SnapshotStore struct {
+	db *gorm.DB
+}
+
+func NewSnapshotStore(db *gorm.DB) (*SnapshotStore, error) {
+	err := db.AutoMigrate(&ProjectSnapshotDTO{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &SnapshotStore{db: db}, nil
+}
+
+func (s *SnapshotStore) List(workspaceId string) ([]*snapshot.Snapshot, error) {
+	snapshotDTOs := []ProjectSnapshotDTO{}
+	tx := s.db.Where("workspace_id = ?", workspaceId).Order("created_at").Find(&snapshotDTOs)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	snapshots := []*snapshot.Snapshot{}
+	for _, snapshotDTO := range snapshotDTOs {
+		snapshots = append(snapshots, ToProjectSnapshot(snapshotDTO))
+	}
+
+	return snapshots, nil
+}
+
+func (s *SnapshotStore) Find(id string) (*snapshot.Snapshot, error) {
+	snapshotDTO := ProjectSnapshotDTO{}
+	tx := s.db.Where("id = ?", id).First(&snapshotDTO)
+	if tx.Error != nil {
+		if IsRecordNotFound(tx.Error) {
+			return nil, snapshot.ErrSnapshotNotFound
+		}
+		return nil, tx.Error
+	}
+
+	return ToProjectSnapshot(snapshotDTO), nil
+}
+
+func (s *SnapshotStore) Save(snap *snapshot.Snapshot) error {
+	snapshotDTO := ToProjectSnapshotDTO(snap)
+	tx := s.db.Save(&snapshotDTO)
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (s *SnapshotStore) Delete(snap *snapshot.Snapshot) error {
+	tx := s.db.Where("id = ?", snap.Id).Delete(&ProjectSnapshotDTO{})
+	if tx.Error != nil {
+		return tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		return snapshot.ErrSnapshotNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"gorm.io/gorm"
+
+	. "github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/webhook"
+)
+
+type WebhookDeliveryStore struct {
+	db *gorm.DB
+}
+
+func NewWebhookDeliveryStore(db *gorm.DB) (*WebhookDeliveryStore, error) {
+	err := db.AutoMigrate(&WebhookDeliveryDTO{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &WebhookDeliveryStore{db: db}, nil
+}
+
+func (s *WebhookDeliveryStore) List(webhookId string) ([]*webhook.Delivery, error) {
+	deliveryDTOs := []WebhookDeliveryDTO{}
+	tx := s.db.Where("webhook_id = ?", webhookId).Order("created_at desc").Find(&deliveryDTOs)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	deliveries := []*webhook.Delivery{}
+	for _, deliveryDTO := range deliveryDTOs {
+		deliveries = append(deliveries, ToWebhookDelivery(deliveryDTO))
+	}
+
+	return deliveries, nil
+}
+
+func (s *WebhookDeliveryStore) Save(delivery *webhook.Delivery) error {
+	deliveryDTO := ToWebhookDeliveryDTO(delivery)
+	tx := s.db.Save(&deliveryDTO)
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (s *WebhookDeliveryStore) Prune(webhookId string, keep int) error {
+	newest := s.db.Model(&WebhookDeliveryDTO{}).Select("id").Where("webhook_id = ?", webhookId).Order("created_at desc").Limit(keep)
+
+	tx := s.db.Where("webhook_id = ? AND id NOT IN (?)", webhookId, newest).Delete(&WebhookDeliveryDTO{})
+	return tx.Error
+}
+
+func (s *WebhookDeliveryStore) DeleteAll(webhookId string) error {
+	tx := s.db.Where("webhook_id = ?", webhookId).Delete(&WebhookDeliveryDTO{})
+	return tx.Error
+}
@@ -0,0 +1,74 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"gorm.io/gorm"
+
+	. "github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/webhook"
+)
+
+type WebhookStore struct {
+	db *gorm.DB
+}
+
+func NewWebhookStore(db *gorm.DB) (*WebhookStore, error) {
+	err := db.AutoMigrate(&WebhookDTO{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &WebhookStore{db: db}, nil
+}
+
+func (s *WebhookStore) List() ([]*webhook.Webhook, error) {
+	webhookDTOs := []WebhookDTO{}
+	tx := s.db.Order("created_at").Find(&webhookDTOs)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	webhooks := []*webhook.Webhook{}
+	for _, webhookDTO := range webhookDTOs {
+		webhooks = append(webhooks, ToWebhook(webhookDTO))
+	}
+
+	return webhooks, nil
+}
+
+func (s *WebhookStore) Find(id string) (*webhook.Webhook, error) {
+	webhookDTO := WebhookDTO{}
+	tx := s.db.Where("id = ?", id).First(&webhookDTO)
+	if tx.Error != nil {
+		if IsRecordNotFound(tx.Error) {
+			return nil, webhook.ErrWebhookNotFound
+		}
+		return nil, tx.Error
+	}
+
+	return ToWebhook(webhookDTO), nil
+}
+
+func (s *WebhookStore) Save(w *webhook.Webhook) error {
+	webhookDTO := ToWebhookDTO(w)
+	tx := s.db.Save(&webhookDTO)
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (s *WebhookStore) Delete(w *webhook.Webhook) error {
+	tx := s.db.Where("id = ?", w.Id).Delete(&WebhookDTO{})
+	if tx.Error != nil {
+		return tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		return webhook.ErrWebhookNotFound
+	}
+
+	return nil
+}
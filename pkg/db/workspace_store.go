@@ -8,6 +8,7 @@ import (
 
 	. "github.com/daytonaio/daytona/pkg/db/dto"
 	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
 )
 
 type WorkspaceStore struct {
@@ -71,3 +72,38 @@ func (w *WorkspaceStore) Delete(ws *workspace.Workspace) error {
 
 	return nil
 }
+
+// RemoveServerEnvVars removes the env vars set by the server from the stored projects.
+// They were stored together with the project env vars before they were only passed to the provisioner
+func RemoveServerEnvVars(db *gorm.DB) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		workspaceDTOs := []WorkspaceDTO{}
+		err := tx.Find(&workspaceDTOs).Error
+		if err != nil {
+			return err
+		}
+
+		for _, workspaceDTO := range workspaceDTOs {
+			removed := false
+			for _, projectDTO := range workspaceDTO.Projects {
+				for name := range projectDTO.EnvVars {
+					if project.IsServerEnvVar(name) {
+						delete(projectDTO.EnvVars, name)
+						removed = true
+					}
+				}
+			}
+
+			if !removed {
+				continue
+			}
+
+			err = tx.Save(&workspaceDTO).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,48 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/encryption"
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/stretchr/testify/require"
+)
+
+func setTestKeyring(t *testing.T) {
+	key, err := encryption.GenerateKey()
+	require.NoError(t, err)
+
+	keyring, err := encryption.NewKeyring(key)
+	require.NoError(t, err)
+
+	dto.SetEncryptionKeyring(keyring)
+	t.Cleanup(func() { dto.SetEncryptionKeyring(nil) })
+}
+
+func TestRemoveServerEnvVars(t *testing.T) {
+	setTestKeyring(t)
+
+	db := newTestDB(t)
+	store, err := NewWorkspaceStore(db)
+	require.NoError(t, err)
+
+	p := &project.Project{Name: "p1", WorkspaceId: "ws1", ApiKey: "project-key", Repository: &gitprovider.GitRepository{Url: "https://github.com/daytonaio/daytona"}}
+	p.EnvVars = project.GetProjectEnvVars(p, project.ProjectEnvVarParams{}, true)
+	p.EnvVars["FOO"] = "bar"
+
+	require.NoError(t, store.Save(&workspace.Workspace{Id: "ws1", Name: "ws1", Projects: []*project.Project{p}}))
+	require.NoError(t, store.Save(&workspace.Workspace{Id: "ws2", Name: "ws2", Projects: []*project.Project{{Name: "p1", WorkspaceId: "ws2", Repository: &gitprovider.GitRepository{}}}}))
+
+	require.NoError(t, RemoveServerEnvVars(db))
+
+	w, err := store.Find("ws1")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"FOO": "bar"}, w.Projects[0].EnvVars)
+	require.Equal(t, "project-key", w.Projects[0].ApiKey)
+}
@@ -38,6 +38,10 @@ type IDockerClient interface {
 	StartProject(opts *CreateProjectOptions, daytonaDownloadUrl string) error
 	StopProject(project *project.Project, logWriter io.Writer) error
 
+	SnapshotProject(opts *SnapshotProjectOptions) error
+	RestoreProject(opts *SnapshotProjectOptions) error
+	DeleteSnapshot(opts *SnapshotProjectOptions) error
+
 	GetProjectInfo(project *project.Project) (*project.ProjectInfo, error)
 	GetWorkspaceInfo(ws *workspace.Workspace) (*workspace.WorkspaceInfo, error)
 
@@ -46,11 +50,13 @@ type IDockerClient interface {
 	ExecSync(containerID string, config container.ExecOptions, outputWriter io.Writer) (*ExecResult, error)
 	GetContainerLogs(containerName string, logWriter io.Writer) error
 	PullImage(imageName string, cr *containerregistry.ContainerRegistry, logWriter io.Writer) error
-	PushImage(imageName string, cr *containerregistry.ContainerRegistry, logWriter io.Writer) error
+	PushImage(ctx context.Context, imageName string, cr *containerregistry.ContainerRegistry, logWriter io.Writer) error
 	DeleteImage(imageName string, force bool, logWriter io.Writer) error
 
 	CreateFromDevcontainer(opts CreateDevcontainerOptions) (string, RemoteUser, error)
+	BuildFromDockerfile(ctx context.Context, opts BuildFromDockerfileOptions) (RemoteUser, error)
 	RemoveContainer(containerName string) error
+	RemoveContainersByLabel(label, value string) error
 }
 
 type DockerClientConfig struct {
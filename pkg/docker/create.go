@@ -55,6 +55,8 @@ func (d *DockerClient) CreateProject(opts *CreateProjectOptions) error {
 	case detect.BuilderTypeDevcontainer:
 		_, _, err := d.CreateFromDevcontainer(d.toCreateDevcontainerOptions(opts, true))
 		return err
+	case detect.BuilderTypeDockerfile:
+		return d.createProjectFromDockerfile(opts)
 	case detect.BuilderTypeImage:
 		return d.createProjectFromImage(opts)
 	default:
@@ -196,6 +198,7 @@ func (d *DockerClient) toCreateDevcontainerOptions(opts *CreateProjectOptions, p
 		SshClient:         opts.SshClient,
 		ContainerRegistry: opts.Cr,
 		EnvVars:           opts.Project.EnvVars,
+		Resources:         opts.Project.Resources,
 		IdLabels: map[string]string{
 			"daytona.workspace.id": opts.Project.WorkspaceId,
 			"daytona.project.name": opts.Project.Name,
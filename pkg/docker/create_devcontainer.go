@@ -19,6 +19,7 @@ import (
 	"github.com/daytonaio/daytona/pkg/build/devcontainer"
 	"github.com/daytonaio/daytona/pkg/containerregistry"
 	"github.com/daytonaio/daytona/pkg/ssh"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
 	"github.com/daytonaio/daytona/pkg/workspace/project/buildconfig"
 	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/api/types/filters"
@@ -47,7 +48,10 @@ type CreateDevcontainerOptions struct {
 	ContainerRegistry *containerregistry.ContainerRegistry
 	Prebuild          bool
 	EnvVars           map[string]string
+	Resources         *project.ResourceLimits
 	IdLabels          map[string]string
+	// Labels applied to the helper containers that run the devcontainer CLI
+	HelperLabels map[string]string
 }
 
 func (d *DockerClient) CreateFromDevcontainer(opts CreateDevcontainerOptions) (string, RemoteUser, error) {
@@ -119,6 +123,11 @@ func (d *DockerClient) CreateFromDevcontainer(opts CreateDevcontainerOptions) (s
 
 	delete(devcontainerConfig, "initializeCommand")
 
+	if opts.Resources != nil {
+		runArgs, _ := devcontainerConfig["runArgs"].([]interface{})
+		devcontainerConfig["runArgs"] = append(runArgs, getResourceRunArgs(opts.Resources)...)
+	}
+
 	if _, ok := devcontainerConfig["dockerComposeFile"]; ok {
 		composeFilePath := devcontainerConfig["dockerComposeFile"].(string)
 
@@ -472,6 +481,7 @@ func (d *DockerClient) execDevcontainerCommand(cmd string, opts *CreateDevcontai
 		Cmd:        append([]string{"-c"}, cmd),
 		Tty:        true,
 		WorkingDir: workdir,
+		Labels:     opts.HelperLabels,
 	}, &container.HostConfig{
 		Privileged:  true,
 		NetworkMode: container.NetworkMode(fmt.Sprintf("container:%s", socketForwardId)),
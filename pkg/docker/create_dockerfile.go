@@ -0,0 +1,322 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"archive/tar"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/containerregistry"
+	"github.com/daytonaio/daytona/pkg/ssh"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/daytonaio/daytona/pkg/workspace/project/buildconfig"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/registry"
+	"github.com/docker/docker/pkg/jsonmessage"
+	"github.com/moby/patternmatcher"
+	"github.com/moby/patternmatcher/ignorefile"
+)
+
+type BuildFromDockerfileOptions struct {
+	ProjectDir string
+	// Tag applied to the built image
+	ImageName         string
+	BuildConfig       *buildconfig.BuildConfig
+	LogWriter         io.Writer
+	SshClient         *ssh.Client
+	ContainerRegistry *containerregistry.ContainerRegistry
+}
+
+// BuildFromDockerfile builds the image described by the Dockerfile build config
+// and returns the user the resulting image runs as. Cancelling ctx stops the build
+func (d *DockerClient) BuildFromDockerfile(ctx context.Context, opts BuildFromDockerfileOptions) (RemoteUser, error) {
+	if opts.BuildConfig == nil || opts.BuildConfig.Dockerfile == nil {
+		return "", fmt.Errorf("dockerfile build config is not set")
+	}
+
+	dockerfile := opts.BuildConfig.Dockerfile
+
+	buildContext := dockerfile.Context
+	if buildContext == "" {
+		buildContext = "."
+	}
+
+	dockerfilePath, err := filepath.Rel(buildContext, dockerfile.FilePath)
+	if err != nil || strings.HasPrefix(dockerfilePath, "..") {
+		return "", fmt.Errorf("Dockerfile %s must be located inside the build context %s", dockerfile.FilePath, buildContext)
+	}
+
+	var cacheFrom []string
+	if opts.BuildConfig.CachedBuild != nil {
+		err := d.pullImage(ctx, opts.BuildConfig.CachedBuild.Image, opts.ContainerRegistry, opts.LogWriter)
+		if err != nil {
+			opts.LogWriter.Write([]byte(fmt.Sprintf("Error pulling cached build image: %v. Continuing without cache.\n", err)))
+		} else {
+			cacheFrom = append(cacheFrom, opts.BuildConfig.CachedBuild.Image)
+			opts.LogWriter.Write([]byte(fmt.Sprintf("Using existing build cache from: %s\n", opts.BuildConfig.CachedBuild.Image)))
+		}
+	}
+
+	if opts.SshClient != nil {
+		err = d.buildDockerfileOverSsh(opts, path.Join(opts.ProjectDir, buildContext), filepath.ToSlash(dockerfilePath), cacheFrom)
+	} else {
+		err = d.buildDockerfile(ctx, opts, filepath.Join(opts.ProjectDir, buildContext), filepath.ToSlash(dockerfilePath), cacheFrom)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return d.getImageUser(ctx, opts.ImageName)
+}
+
+func (d *DockerClient) createProjectFromDockerfile(opts *CreateProjectOptions) error {
+	imageName := GetProjectImageName(opts.Project)
+
+	remoteUser, err := d.BuildFromDockerfile(context.Background(), BuildFromDockerfileOptions{
+		ProjectDir:        opts.ProjectDir,
+		ImageName:         imageName,
+		BuildConfig:       opts.Project.BuildConfig,
+		LogWriter:         opts.LogWriter,
+		SshClient:         opts.SshClient,
+		ContainerRegistry: opts.Cr,
+	})
+	if err != nil {
+		return err
+	}
+
+	opts.Project.Image = imageName
+	opts.Project.User = string(remoteUser)
+
+	return d.initProjectContainer(opts)
+}
+
+// GetProjectImageName returns the local tag used for images built from a project's Dockerfile
+func GetProjectImageName(p *project.Project) string {
+	return strings.ToLower(fmt.Sprintf("daytona-%s-%s:latest", p.WorkspaceId, p.Name))
+}
+
+func (d *DockerClient) buildDockerfile(ctx context.Context, opts BuildFromDockerfileOptions, contextDir, dockerfilePath string, cacheFrom []string) error {
+	buildContext, err := tarBuildContext(contextDir, dockerfilePath)
+	if err != nil {
+		return err
+	}
+	defer buildContext.Close()
+
+	buildArgs := map[string]*string{}
+	for k, v := range opts.BuildConfig.Dockerfile.BuildArgs {
+		value := v
+		buildArgs[k] = &value
+	}
+
+	var authConfigs map[string]registry.AuthConfig
+	if opts.ContainerRegistry != nil {
+		authConfigs = map[string]registry.AuthConfig{
+			opts.ContainerRegistry.Server: {
+				Username:      opts.ContainerRegistry.Username,
+				Password:      opts.ContainerRegistry.Password,
+				ServerAddress: opts.ContainerRegistry.Server,
+			},
+		}
+	}
+
+	if opts.LogWriter != nil {
+		opts.LogWriter.Write([]byte("Building image from Dockerfile...\n"))
+	}
+
+	res, err := d.apiClient.ImageBuild(ctx, buildContext, types.ImageBuildOptions{
+		Tags:        []string{opts.ImageName},
+		Dockerfile:  dockerfilePath,
+		BuildArgs:   buildArgs,
+		Target:      opts.BuildConfig.Dockerfile.Target,
+		CacheFrom:   cacheFrom,
+		AuthConfigs: authConfigs,
+		Remove:      true,
+	})
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	err = jsonmessage.DisplayJSONMessagesStream(res.Body, opts.LogWriter, 0, true, nil)
+	if err != nil {
+		return err
+	}
+
+	if opts.LogWriter != nil {
+		opts.LogWriter.Write([]byte("Image built successfully\n"))
+	}
+
+	return nil
+}
+
+func (d *DockerClient) buildDockerfileOverSsh(opts BuildFromDockerfileOptions, contextDir, dockerfilePath string, cacheFrom []string) error {
+	if opts.LogWriter != nil {
+		opts.LogWriter.Write([]byte("Building image from Dockerfile...\n"))
+	}
+
+	return opts.SshClient.Exec(getDockerfileBuildCommand(opts.ImageName, opts.BuildConfig.Dockerfile, contextDir, dockerfilePath, cacheFrom), opts.LogWriter)
+}
+
+// getDockerfileBuildCommand returns the docker build shell command with every argument quoted
+func getDockerfileBuildCommand(imageName string, dockerfile *buildconfig.DockerfileConfig, contextDir, dockerfilePath string, cacheFrom []string) string {
+	buildCmd := []string{"docker", "build", "-t", imageName, "-f", path.Join(contextDir, dockerfilePath)}
+
+	if dockerfile.Target != "" {
+		buildCmd = append(buildCmd, "--target", dockerfile.Target)
+	}
+
+	buildArgKeys := make([]string, 0, len(dockerfile.BuildArgs))
+	for k := range dockerfile.BuildArgs {
+		buildArgKeys = append(buildArgKeys, k)
+	}
+	slices.Sort(buildArgKeys)
+
+	for _, k := range buildArgKeys {
+		buildCmd = append(buildCmd, "--build-arg", fmt.Sprintf("%s=%s", k, dockerfile.BuildArgs[k]))
+	}
+
+	for _, image := range cacheFrom {
+		buildCmd = append(buildCmd, "--cache-from", image)
+	}
+
+	buildCmd = append(buildCmd, contextDir)
+
+	return strings.Join(util.ArrayMap(buildCmd, util.ShellQuote), " ")
+}
+
+func (d *DockerClient) getImageUser(ctx context.Context, imageName string) (RemoteUser, error) {
+	image, _, err := d.apiClient.ImageInspectWithRaw(ctx, imageName)
+	if err != nil {
+		return "", err
+	}
+
+	if image.Config == nil || image.Config.User == "" {
+		return "root", nil
+	}
+
+	// The user can be set as user:group
+	return RemoteUser(strings.Split(image.Config.User, ":")[0]), nil
+}
+
+// tarBuildContext streams the contents of the build context directory as a tar archive.
+// Like docker build, it skips the paths matched by the .dockerignore file of the context
+// but always sends the Dockerfile and the .dockerignore file
+func tarBuildContext(contextDir, dockerfilePath string) (io.ReadCloser, error) {
+	info, err := os.Stat(contextDir)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("build context %s is not a directory", contextDir)
+	}
+
+	ignored, err := readDockerignore(contextDir)
+	if err != nil {
+		return nil, err
+	}
+
+	pr, pw := io.Pipe()
+
+	go func() {
+		tw := tar.NewWriter(pw)
+
+		err := filepath.Walk(contextDir, func(filePath string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+
+			relPath, err := filepath.Rel(contextDir, filePath)
+			if err != nil || relPath == "." {
+				return err
+			}
+
+			relPath = filepath.ToSlash(relPath)
+			if ignored != nil && relPath != ".dockerignore" && relPath != path.Clean(dockerfilePath) {
+				skip, err := ignored.MatchesOrParentMatches(relPath)
+				if err != nil {
+					return err
+				}
+
+				if skip {
+					// Files of an ignored directory can be included again by exclusions
+					if info.IsDir() && !ignored.Exclusions() {
+						return filepath.SkipDir
+					}
+					return nil
+				}
+			}
+
+			link := ""
+			if info.Mode()&os.ModeSymlink != 0 {
+				link, err = os.Readlink(filePath)
+				if err != nil {
+					return err
+				}
+			}
+
+			header, err := tar.FileInfoHeader(info, link)
+			if err != nil {
+				return err
+			}
+			header.Name = relPath
+
+			err = tw.WriteHeader(header)
+			if err != nil {
+				return err
+			}
+
+			if !info.Mode().IsRegular() {
+				return nil
+			}
+
+			f, err := os.Open(filePath)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			_, err = io.Copy(tw, f)
+			return err
+		})
+		if err == nil {
+			err = tw.Close()
+		}
+
+		pw.CloseWithError(err)
+	}()
+
+	return pr, nil
+}
+
+// readDockerignore returns the matcher for the patterns of the .dockerignore file of the build context,
+// or nil if there is none
+func readDockerignore(contextDir string) (*patternmatcher.PatternMatcher, error) {
+	f, err := os.Open(filepath.Join(contextDir, ".dockerignore"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	patterns, err := ignorefile.ReadAll(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read .dockerignore: %w", err)
+	}
+
+	if len(patterns) == 0 {
+		return nil, nil
+	}
+
+	return patternmatcher.New(patterns)
+}
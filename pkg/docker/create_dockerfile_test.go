@@ -0,0 +1,79 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"archive/tar"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project/buildconfig"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetDockerfileBuildCommand(t *testing.T) {
+	dockerfile := &buildconfig.DockerfileConfig{
+		FilePath: "Dockerfile",
+		Target:   "dev",
+		BuildArgs: map[string]string{
+			"VERSION":  "1.0",
+			"GREETING": "it's $HOME",
+		},
+	}
+
+	cmd := getDockerfileBuildCommand("daytona-ws-p:latest", dockerfile, "/home/user/my project", "Dockerfile", []string{"registry.io/cache;rm -rf /"})
+
+	require.Equal(t, `'docker' 'build' '-t' 'daytona-ws-p:latest' '-f' '/home/user/my project/Dockerfile' '--target' 'dev' `+
+		`'--build-arg' 'GREETING=it'\''s $HOME' '--build-arg' 'VERSION=1.0' `+
+		`'--cache-from' 'registry.io/cache;rm -rf /' '/home/user/my project'`, cmd)
+}
+
+func TestGetDockerfileBuildCommand_Minimal(t *testing.T) {
+	cmd := getDockerfileBuildCommand("image", &buildconfig.DockerfileConfig{FilePath: "Dockerfile"}, "/project", "Dockerfile", nil)
+
+	require.Equal(t, `'docker' 'build' '-t' 'image' '-f' '/project/Dockerfile' '/project'`, cmd)
+}
+
+func TestTarBuildContext(t *testing.T) {
+	contextDir := t.TempDir()
+
+	files := map[string]string{
+		".dockerignore":             "# comment\n.git\nnode_modules\n*.env\nDockerfile\nlogs/*\n!logs/keep.log\n",
+		"Dockerfile":                "FROM alpine",
+		"main.go":                   "package main",
+		"secrets.env":               "TOKEN=secret",
+		".git/config":               "[core]",
+		"node_modules/pkg/index.js": "module.exports = {}",
+		"logs/debug.log":            "debug",
+		"logs/keep.log":             "keep",
+		"src/app.go":                "package src",
+	}
+	for name, content := range files {
+		filePath := filepath.Join(contextDir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(filePath), 0755))
+		require.NoError(t, os.WriteFile(filePath, []byte(content), 0644))
+	}
+
+	buildContext, err := tarBuildContext(contextDir, "Dockerfile")
+	require.NoError(t, err)
+	defer buildContext.Close()
+
+	names := []string{}
+	tr := tar.NewReader(buildContext)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+
+		if header.Typeflag == tar.TypeReg {
+			names = append(names, header.Name)
+		}
+	}
+
+	require.ElementsMatch(t, []string{".dockerignore", "Dockerfile", "main.go", "logs/keep.log", "src/app.go"}, names)
+}
@@ -38,6 +38,8 @@ func (d *DockerClient) initProjectContainer(opts *CreateProjectOptions) error {
 		ExtraHosts: []string{
 			"host.docker.internal:host-gateway",
 		},
+		Resources:  GetContainerResources(opts.Project.Resources),
+		StorageOpt: GetContainerStorageOpt(opts.Project.Resources),
 	}, nil, nil, d.GetProjectContainerName(opts.Project))
 	if err != nil {
 		return err
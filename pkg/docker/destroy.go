@@ -12,6 +12,7 @@ import (
 	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
 	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
 	"github.com/docker/docker/client"
 )
 
@@ -95,3 +96,24 @@ func (d *DockerClient) RemoveContainer(containerName string) error {
 
 	return nil
 }
+
+func (d *DockerClient) RemoveContainersByLabel(label, value string) error {
+	ctx := context.Background()
+
+	containers, err := d.apiClient.ContainerList(ctx, container.ListOptions{
+		All:     true,
+		Filters: filters.NewArgs(filters.Arg("label", fmt.Sprintf("%s=%s", label, value))),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, c := range containers {
+		err = d.RemoveContainer(c.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -18,7 +18,10 @@ import (
 )
 
 func (d *DockerClient) PullImage(imageName string, cr *containerregistry.ContainerRegistry, logWriter io.Writer) error {
-	ctx := context.Background()
+	return d.pullImage(context.Background(), imageName, cr, logWriter)
+}
+
+func (d *DockerClient) pullImage(ctx context.Context, imageName string, cr *containerregistry.ContainerRegistry, logWriter io.Writer) error {
 
 	tag := "latest"
 	tagSplit := strings.Split(imageName, ":")
@@ -12,9 +12,7 @@ import (
 	"github.com/docker/docker/pkg/jsonmessage"
 )
 
-func (d *DockerClient) PushImage(imageName string, cr *containerregistry.ContainerRegistry, logWriter io.Writer) error {
-	ctx := context.Background()
-
+func (d *DockerClient) PushImage(ctx context.Context, imageName string, cr *containerregistry.ContainerRegistry, logWriter io.Writer) error {
 	if logWriter != nil {
 		logWriter.Write([]byte("Pushing image...\n"))
 	}
@@ -0,0 +1,65 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/docker/docker/api/types/container"
+)
+
+// GetContainerResources converts the project resource limits to container resources.
+// Unset limits are left at zero, which Docker treats as unlimited.
+func GetContainerResources(limits *project.ResourceLimits) container.Resources {
+	resources := container.Resources{}
+	if limits == nil {
+		return resources
+	}
+
+	if limits.Cpus > 0 {
+		resources.NanoCPUs = int64(limits.Cpus * 1e9)
+	}
+
+	if limits.Memory > 0 {
+		resources.Memory = int64(limits.Memory) * 1024 * 1024
+	}
+
+	return resources
+}
+
+// GetContainerStorageOpt returns the storage options limiting the container disk size.
+// The size option is only supported by some storage drivers, e.g. overlay2 on xfs with pquota.
+func GetContainerStorageOpt(limits *project.ResourceLimits) map[string]string {
+	if limits == nil || limits.Disk == 0 {
+		return nil
+	}
+
+	return map[string]string{
+		"size": fmt.Sprintf("%dG", limits.Disk),
+	}
+}
+
+// getResourceRunArgs returns the docker run arguments for the resource limits of a devcontainer
+func getResourceRunArgs(limits *project.ResourceLimits) []interface{} {
+	runArgs := []interface{}{}
+	if limits == nil {
+		return runArgs
+	}
+
+	if limits.Cpus > 0 {
+		runArgs = append(runArgs, "--cpus", strconv.FormatFloat(limits.Cpus, 'f', -1, 64))
+	}
+
+	if limits.Memory > 0 {
+		runArgs = append(runArgs, "--memory", fmt.Sprintf("%dm", limits.Memory))
+	}
+
+	if limits.Disk > 0 {
+		runArgs = append(runArgs, "--storage-opt", fmt.Sprintf("size=%dG", limits.Disk))
+	}
+
+	return runArgs
+}
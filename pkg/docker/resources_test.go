@@ -0,0 +1,30 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker_test
+
+import (
+	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/docker/docker/api/types/container"
+	"github.com/stretchr/testify/require"
+)
+
+func (s *DockerClientTestSuite) TestGetContainerResources() {
+	require.Equal(s.T(), container.Resources{}, docker.GetContainerResources(nil))
+
+	resources := docker.GetContainerResources(&project.ResourceLimits{
+		Cpus:   1.5,
+		Memory: 2048,
+	})
+
+	require.Equal(s.T(), int64(1500000000), resources.NanoCPUs)
+	require.Equal(s.T(), int64(2048*1024*1024), resources.Memory)
+}
+
+func (s *DockerClientTestSuite) TestGetContainerStorageOpt() {
+	require.Nil(s.T(), docker.GetContainerStorageOpt(nil))
+	require.Nil(s.T(), docker.GetContainerStorageOpt(&project.ResourceLimits{Cpus: 2}))
+
+	require.Equal(s.T(), map[string]string{"size": "20G"}, docker.GetContainerStorageOpt(&project.ResourceLimits{Disk: 20}))
+}
@@ -0,0 +1,200 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/ssh"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/client"
+)
+
+type SnapshotProjectOptions struct {
+	Project    *project.Project
+	ProjectDir string
+	// Directory in which the copy of the project directory is stored
+	SnapshotDir string
+	SnapshotId  string
+	LogWriter   io.Writer
+	SshClient   *ssh.Client
+}
+
+func GetSnapshotImageName(snapshotId string) string {
+	return fmt.Sprintf("daytona-snapshot:%s", snapshotId)
+}
+
+// SnapshotProject commits the project container to an image and copies the project directory,
+// which is bind mounted and therefore not part of the commit
+func (d *DockerClient) SnapshotProject(opts *SnapshotProjectOptions) error {
+	ctx := context.Background()
+	imageName := GetSnapshotImageName(opts.SnapshotId)
+
+	if opts.LogWriter != nil {
+		opts.LogWriter.Write([]byte(fmt.Sprintf("Committing project %s to %s\n", opts.Project.Name, imageName)))
+	}
+
+	_, err := d.apiClient.ContainerCommit(ctx, d.GetProjectContainerName(opts.Project), container.CommitOptions{
+		Reference: imageName,
+		Comment:   fmt.Sprintf("Snapshot of project %s", opts.Project.Name),
+		Pause:     true,
+	})
+	if err != nil {
+		return err
+	}
+
+	if opts.LogWriter != nil {
+		opts.LogWriter.Write([]byte("Copying the project directory\n"))
+	}
+
+	err = copyDir(opts.ProjectDir, opts.SnapshotDir, opts.SshClient)
+	if err != nil {
+		// Do not leave a partial snapshot behind
+		deleteErr := d.DeleteImage(imageName, true, nil)
+		if deleteErr != nil {
+			return fmt.Errorf("%w; failed to delete snapshot image: %w", err, deleteErr)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// RestoreProject recreates the project container from the snapshot image, keeping its configuration,
+// and replaces the project directory with the copy taken with the snapshot.
+// The container is left stopped.
+func (d *DockerClient) RestoreProject(opts *SnapshotProjectOptions) error {
+	ctx := context.Background()
+	imageName := GetSnapshotImageName(opts.SnapshotId)
+
+	_, _, err := d.apiClient.ImageInspectWithRaw(ctx, imageName)
+	if err != nil {
+		return fmt.Errorf("failed to find snapshot image %s: %w", imageName, err)
+	}
+
+	c, err := d.apiClient.ContainerInspect(ctx, d.GetProjectContainerName(opts.Project))
+	if err != nil {
+		return err
+	}
+
+	if opts.LogWriter != nil {
+		opts.LogWriter.Write([]byte(fmt.Sprintf("Recreating project %s from %s\n", opts.Project.Name, imageName)))
+	}
+
+	// Volumes are kept so that they can be attached to the new container
+	err = d.apiClient.ContainerRemove(ctx, c.ID, container.RemoveOptions{
+		Force: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	config := c.Config
+	config.Image = imageName
+
+	_, err = d.apiClient.ContainerCreate(ctx, config, c.HostConfig, nil, nil, strings.TrimPrefix(c.Name, "/"))
+	if err != nil {
+		return err
+	}
+
+	if opts.LogWriter != nil {
+		opts.LogWriter.Write([]byte("Restoring the project directory\n"))
+	}
+
+	err = removeDir(opts.ProjectDir, opts.SshClient)
+	if err != nil {
+		return err
+	}
+
+	return copyDir(opts.SnapshotDir, opts.ProjectDir, opts.SshClient)
+}
+
+// DeleteSnapshot removes the snapshot image and the copy of the project directory
+func (d *DockerClient) DeleteSnapshot(opts *SnapshotProjectOptions) error {
+	imageName := GetSnapshotImageName(opts.SnapshotId)
+
+	if opts.LogWriter != nil {
+		opts.LogWriter.Write([]byte(fmt.Sprintf("Deleting snapshot %s\n", imageName)))
+	}
+
+	err := d.DeleteImage(imageName, true, nil)
+	if err != nil && !client.IsErrNotFound(err) {
+		return err
+	}
+
+	return removeDir(opts.SnapshotDir, opts.SshClient)
+}
+
+func removeDir(dir string, sshClient *ssh.Client) error {
+	if sshClient != nil {
+		return sshClient.Exec(fmt.Sprintf("rm -rf %s", util.ShellQuote(dir)), nil)
+	}
+
+	return os.RemoveAll(dir)
+}
+
+// copyDir copies the contents of src to dst, preserving file modes and symlinks
+func copyDir(src, dst string, sshClient *ssh.Client) error {
+	if sshClient != nil {
+		return sshClient.Exec(fmt.Sprintf("mkdir -p %s && cp -a %s %s", util.ShellQuote(dst), util.ShellQuote(src+"/."), util.ShellQuote(dst)), nil)
+	}
+
+	return filepath.WalkDir(src, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relPath, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, relPath)
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case entry.IsDir():
+			return os.MkdirAll(target, info.Mode().Perm())
+		case info.Mode()&os.ModeSymlink != 0:
+			link, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			return os.Symlink(link, target)
+		case info.Mode().IsRegular():
+			return copyFile(path, target, info.Mode().Perm())
+		}
+
+		// Sockets, pipes and devices are not copied
+		return nil
+	})
+}
+
+func copyFile(src, dst string, perm fs.FileMode) error {
+	srcFile, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer srcFile.Close()
+
+	dstFile, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	defer dstFile.Close()
+
+	_, err = io.Copy(dstFile, srcFile)
+	return err
+}
@@ -0,0 +1,112 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker_test
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/image"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func (s *DockerClientTestSuite) TestSnapshotProject() {
+	projectDir := s.T().TempDir()
+	snapshotDir := filepath.Join(s.T().TempDir(), "snapshot")
+
+	require.Nil(s.T(), os.MkdirAll(filepath.Join(projectDir, "src"), 0755))
+	require.Nil(s.T(), os.WriteFile(filepath.Join(projectDir, "src", "main.go"), []byte("package main"), 0644))
+	require.Nil(s.T(), os.Symlink("src/main.go", filepath.Join(projectDir, "main.go")))
+
+	s.mockClient.On("ContainerList", mock.Anything, mock.Anything).Return([]types.Container{}, nil)
+
+	containerName := s.dockerClient.GetProjectContainerName(project1)
+
+	s.mockClient.On("ContainerCommit", mock.Anything, containerName, container.CommitOptions{
+		Reference: docker.GetSnapshotImageName("snapshot1"),
+		Comment:   "Snapshot of project test",
+		Pause:     true,
+	}).Return(types.IDResponse{ID: "image1"}, nil)
+
+	err := s.dockerClient.SnapshotProject(&docker.SnapshotProjectOptions{
+		Project:     project1,
+		ProjectDir:  projectDir,
+		SnapshotDir: snapshotDir,
+		SnapshotId:  "snapshot1",
+	})
+	require.Nil(s.T(), err)
+
+	content, err := os.ReadFile(filepath.Join(snapshotDir, "src", "main.go"))
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), "package main", string(content))
+
+	link, err := os.Readlink(filepath.Join(snapshotDir, "main.go"))
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), "src/main.go", link)
+}
+
+func (s *DockerClientTestSuite) TestRestoreProject() {
+	projectDir := s.T().TempDir()
+	snapshotDir := s.T().TempDir()
+
+	require.Nil(s.T(), os.WriteFile(filepath.Join(projectDir, "experiment.go"), []byte("package main"), 0644))
+	require.Nil(s.T(), os.WriteFile(filepath.Join(snapshotDir, "main.go"), []byte("package main"), 0644))
+
+	s.mockClient.On("ContainerList", mock.Anything, mock.Anything).Return([]types.Container{}, nil)
+
+	containerName := s.dockerClient.GetProjectContainerName(project1)
+	imageName := docker.GetSnapshotImageName("snapshot1")
+	hostConfig := &container.HostConfig{Privileged: true}
+
+	s.mockClient.On("ImageInspectWithRaw", mock.Anything, imageName).Return(types.ImageInspect{ID: "image1"}, nil)
+	s.mockClient.On("ContainerInspect", mock.Anything, containerName).Return(types.ContainerJSON{
+		ContainerJSONBase: &types.ContainerJSONBase{
+			ID:         "container1",
+			Name:       "/" + containerName,
+			HostConfig: hostConfig,
+		},
+		Config: &container.Config{
+			Image: project1.Image,
+		},
+	}, nil)
+	s.mockClient.On("ContainerRemove", mock.Anything, "container1", container.RemoveOptions{Force: true}).Return(nil)
+	s.mockClient.On("ContainerCreate", mock.Anything, &container.Config{Image: imageName}, hostConfig, mock.Anything, mock.Anything, containerName).Return(container.CreateResponse{ID: "container2"}, nil)
+
+	err := s.dockerClient.RestoreProject(&docker.SnapshotProjectOptions{
+		Project:     project1,
+		ProjectDir:  projectDir,
+		SnapshotDir: snapshotDir,
+		SnapshotId:  "snapshot1",
+	})
+	require.Nil(s.T(), err)
+
+	_, err = os.Stat(filepath.Join(projectDir, "experiment.go"))
+	require.True(s.T(), os.IsNotExist(err))
+
+	_, err = os.Stat(filepath.Join(projectDir, "main.go"))
+	require.Nil(s.T(), err)
+}
+
+func (s *DockerClientTestSuite) TestDeleteSnapshot() {
+	snapshotDir := filepath.Join(s.T().TempDir(), "snapshot dir")
+	require.Nil(s.T(), os.MkdirAll(snapshotDir, 0755))
+	require.Nil(s.T(), os.WriteFile(filepath.Join(snapshotDir, "main.go"), []byte("package main"), 0644))
+
+	imageName := docker.GetSnapshotImageName("snapshot1")
+	s.mockClient.On("ImageRemove", mock.Anything, imageName, image.RemoveOptions{Force: true}).Return([]image.DeleteResponse{}, nil)
+
+	err := s.dockerClient.DeleteSnapshot(&docker.SnapshotProjectOptions{
+		Project:     project1,
+		SnapshotDir: snapshotDir,
+		SnapshotId:  "snapshot1",
+	})
+	require.Nil(s.T(), err)
+
+	_, err = os.Stat(snapshotDir)
+	require.True(s.T(), os.IsNotExist(err))
+}
@@ -29,6 +29,10 @@ func (d *DockerClient) StartProject(opts *CreateProjectOptions, daytonaDownloadU
 		var remoteUser RemoteUser
 		remoteUser, err = d.startDevcontainerProject(opts)
 		containerUser = string(remoteUser)
+	case detect.BuilderTypeDockerfile:
+		var remoteUser RemoteUser
+		remoteUser, err = d.startDockerfileProject(opts)
+		containerUser = string(remoteUser)
 	case detect.BuilderTypeImage:
 		err = d.startImageProject(opts)
 	default:
@@ -0,0 +1,28 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"context"
+	"strings"
+)
+
+func (d *DockerClient) startDockerfileProject(opts *CreateProjectOptions) (RemoteUser, error) {
+	err := d.startImageProject(opts)
+	if err != nil {
+		return "", err
+	}
+
+	c, err := d.apiClient.ContainerInspect(context.Background(), d.GetProjectContainerName(opts.Project))
+	if err != nil {
+		return "", err
+	}
+
+	// The project container is created with the user the image was built with
+	if c.Config == nil || c.Config.User == "" {
+		return "root", nil
+	}
+
+	return RemoteUser(strings.Split(c.Config.User, ":")[0]), nil
+}
@@ -0,0 +1,177 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// KeySize is the size of the key encryption keys and the data keys (AES-256)
+const KeySize = 32
+
+// Encrypted values have the format enc:v1:<key id>:<wrapped data key>:<ciphertext>
+const encryptedPrefix = "enc:v1:"
+
+var (
+	ErrInvalidKey     = fmt.Errorf("encryption key must be %d bytes", KeySize)
+	ErrUnknownKey     = errors.New("value was encrypted with an unknown key")
+	ErrMalformedValue = errors.New("malformed encrypted value")
+	ErrEmptyKeyring   = errors.New("keyring must contain at least one key")
+)
+
+// Keyring encrypts values with envelope encryption. Every value is encrypted with a new data key
+// which is in turn encrypted with the current key of the keyring. Values encrypted with any key
+// of the keyring can be decrypted, so that previous keys can be kept around during key rotation
+type Keyring struct {
+	currentKeyId string
+	keys         map[string][]byte
+}
+
+// NewKeyring creates a keyring that encrypts with the first key and decrypts with any of the keys
+func NewKeyring(keys ...[]byte) (*Keyring, error) {
+	if len(keys) == 0 {
+		return nil, ErrEmptyKeyring
+	}
+
+	keyring := &Keyring{
+		keys: map[string][]byte{},
+	}
+
+	for i, key := range keys {
+		if len(key) != KeySize {
+			return nil, ErrInvalidKey
+		}
+
+		keyId := getKeyId(key)
+		if i == 0 {
+			keyring.currentKeyId = keyId
+		}
+		keyring.keys[keyId] = key
+	}
+
+	return keyring, nil
+}
+
+func GenerateKey() ([]byte, error) {
+	key := make([]byte, KeySize)
+	_, err := rand.Read(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, encryptedPrefix)
+}
+
+func (k *Keyring) Encrypt(plaintext []byte) (string, error) {
+	dataKey, err := GenerateKey()
+	if err != nil {
+		return "", err
+	}
+
+	wrappedDataKey, err := seal(k.keys[k.currentKeyId], dataKey)
+	if err != nil {
+		return "", err
+	}
+
+	ciphertext, err := seal(dataKey, plaintext)
+	if err != nil {
+		return "", err
+	}
+
+	return encryptedPrefix + strings.Join([]string{
+		k.currentKeyId,
+		base64.RawStdEncoding.EncodeToString(wrappedDataKey),
+		base64.RawStdEncoding.EncodeToString(ciphertext),
+	}, ":"), nil
+}
+
+func (k *Keyring) Decrypt(value string) ([]byte, error) {
+	if !IsEncrypted(value) {
+		return nil, ErrMalformedValue
+	}
+
+	parts := strings.Split(strings.TrimPrefix(value, encryptedPrefix), ":")
+	if len(parts) != 3 {
+		return nil, ErrMalformedValue
+	}
+
+	key, ok := k.keys[parts[0]]
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+
+	wrappedDataKey, err := base64.RawStdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, ErrMalformedValue
+	}
+
+	ciphertext, err := base64.RawStdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrMalformedValue
+	}
+
+	dataKey, err := open(key, wrappedDataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return open(dataKey, ciphertext)
+}
+
+// seal encrypts the plaintext with AES-GCM and prepends the nonce to the ciphertext
+func seal(key, plaintext []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func open(key, sealed []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sealed) < gcm.NonceSize() {
+		return nil, ErrMalformedValue
+	}
+
+	nonce, ciphertext := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+
+	return gcm.Open(nil, nonce, ciphertext, nil)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+func getKeyId(key []byte) string {
+	hash := sha256.Sum256(key)
+	return hex.EncodeToString(hash[:4])
+}
@@ -0,0 +1,117 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package encryption
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptDecrypt(t *testing.T) {
+	key, err := GenerateKey()
+	require.Nil(t, err)
+
+	keyring, err := NewKeyring(key)
+	require.Nil(t, err)
+
+	encrypted, err := keyring.Encrypt([]byte("secret-token"))
+	require.Nil(t, err)
+	require.True(t, IsEncrypted(encrypted))
+	require.NotContains(t, encrypted, "secret-token")
+
+	decrypted, err := keyring.Decrypt(encrypted)
+	require.Nil(t, err)
+	require.Equal(t, "secret-token", string(decrypted))
+
+	otherEncrypted, err := keyring.Encrypt([]byte("secret-token"))
+	require.Nil(t, err)
+	require.NotEqual(t, encrypted, otherEncrypted)
+}
+
+func TestDecryptWithPreviousKey(t *testing.T) {
+	oldKey, err := GenerateKey()
+	require.Nil(t, err)
+	newKey, err := GenerateKey()
+	require.Nil(t, err)
+
+	oldKeyring, err := NewKeyring(oldKey)
+	require.Nil(t, err)
+
+	encrypted, err := oldKeyring.Encrypt([]byte("password"))
+	require.Nil(t, err)
+
+	rotatedKeyring, err := NewKeyring(newKey, oldKey)
+	require.Nil(t, err)
+
+	decrypted, err := rotatedKeyring.Decrypt(encrypted)
+	require.Nil(t, err)
+	require.Equal(t, "password", string(decrypted))
+
+	newKeyring, err := NewKeyring(newKey)
+	require.Nil(t, err)
+
+	_, err = newKeyring.Decrypt(encrypted)
+	require.ErrorIs(t, err, ErrUnknownKey)
+}
+
+func TestDecryptTampered(t *testing.T) {
+	key, err := GenerateKey()
+	require.Nil(t, err)
+
+	keyring, err := NewKeyring(key)
+	require.Nil(t, err)
+
+	encrypted, err := keyring.Encrypt([]byte("password"))
+	require.Nil(t, err)
+
+	tampered := encrypted[:len(encrypted)-2] + "AA"
+	if tampered == encrypted {
+		tampered = encrypted[:len(encrypted)-2] + "BB"
+	}
+
+	_, err = keyring.Decrypt(tampered)
+	require.NotNil(t, err)
+
+	_, err = keyring.Decrypt("plaintext")
+	require.ErrorIs(t, err, ErrMalformedValue)
+}
+
+func TestNewKeyring_InvalidKey(t *testing.T) {
+	_, err := NewKeyring([]byte("short"))
+	require.ErrorIs(t, err, ErrInvalidKey)
+
+	_, err = NewKeyring()
+	require.ErrorIs(t, err, ErrEmptyKeyring)
+}
+
+func TestLoadKeys(t *testing.T) {
+	t.Setenv(KeyEnvVar, "")
+
+	keyFilePath := filepath.Join(t.TempDir(), "encryption.key")
+
+	keys, fromEnv, err := LoadKeys(keyFilePath)
+	require.Nil(t, err)
+	require.False(t, fromEnv)
+	require.Len(t, keys, 1)
+
+	info, err := os.Stat(keyFilePath)
+	require.Nil(t, err)
+	require.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	reloadedKeys, _, err := LoadKeys(keyFilePath)
+	require.Nil(t, err)
+	require.Equal(t, keys, reloadedKeys)
+
+	envKey, err := GenerateKey()
+	require.Nil(t, err)
+	t.Setenv(KeyEnvVar, EncodeKey(envKey)+","+EncodeKey(keys[0]))
+
+	envKeys, fromEnv, err := LoadKeys(keyFilePath)
+	require.Nil(t, err)
+	require.True(t, fromEnv)
+	require.Equal(t, [][]byte{envKey, keys[0]}, envKeys)
+}
@@ -0,0 +1,104 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package encryption
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// KeyEnvVar takes precedence over the key file. It holds base64 encoded keys separated by commas, the first one being the current key
+const KeyEnvVar = "DAYTONA_ENCRYPTION_KEY"
+
+// LoadKeys returns the encryption keys from KeyEnvVar if it is set, otherwise from the key file.
+// The key file is created with a new key if it does not exist
+func LoadKeys(keyFilePath string) (keys [][]byte, fromEnv bool, err error) {
+	if envKeys, ok := os.LookupEnv(KeyEnvVar); ok && envKeys != "" {
+		keys, err = ParseKeys(envKeys)
+		if err != nil {
+			return nil, true, fmt.Errorf("invalid %s: %w", KeyEnvVar, err)
+		}
+		return keys, true, nil
+	}
+
+	content, err := os.ReadFile(keyFilePath)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return nil, false, err
+		}
+
+		key, err := GenerateKey()
+		if err != nil {
+			return nil, false, err
+		}
+
+		return [][]byte{key}, false, WriteKeyFile(keyFilePath, key)
+	}
+
+	keys, err = ParseKeys(string(content))
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid key file %s: %w", keyFilePath, err)
+	}
+
+	return keys, false, nil
+}
+
+// ParseKeys parses base64 encoded keys separated by commas or newlines
+func ParseKeys(value string) ([][]byte, error) {
+	keys := [][]byte{}
+
+	for _, encodedKey := range strings.FieldsFunc(value, func(r rune) bool {
+		return r == ',' || r == '\n' || r == '\r'
+	}) {
+		encodedKey = strings.TrimSpace(encodedKey)
+		if encodedKey == "" {
+			continue
+		}
+
+		key, err := base64.StdEncoding.DecodeString(encodedKey)
+		if err != nil {
+			return nil, err
+		}
+		if len(key) != KeySize {
+			return nil, ErrInvalidKey
+		}
+
+		keys = append(keys, key)
+	}
+
+	if len(keys) == 0 {
+		return nil, ErrEmptyKeyring
+	}
+
+	return keys, nil
+}
+
+func EncodeKey(key []byte) string {
+	return base64.StdEncoding.EncodeToString(key)
+}
+
+// WriteKeyFile atomically replaces the key file with the given keys, the first one being the current key
+func WriteKeyFile(keyFilePath string, keys ...[]byte) error {
+	err := os.MkdirAll(filepath.Dir(keyFilePath), 0755)
+	if err != nil {
+		return err
+	}
+
+	lines := []string{}
+	for _, key := range keys {
+		lines = append(lines, EncodeKey(key))
+	}
+
+	tmpPath := keyFilePath + ".tmp"
+	err = os.WriteFile(tmpPath, []byte(strings.Join(lines, "\n")+"\n"), 0600)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, keyFilePath)
+}
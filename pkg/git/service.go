@@ -5,9 +5,12 @@ package git
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"strings"
 
@@ -33,13 +36,20 @@ var MapStatus map[git.StatusCode]project.Status = map[git.StatusCode]project.Sta
 }
 
 type IGitService interface {
-	CloneRepository(repo *gitprovider.GitRepository, auth *http.BasicAuth) error
+	CloneRepository(ctx context.Context, repo *gitprovider.GitRepository, auth *http.BasicAuth) error
 	CloneRepositoryCmd(repo *gitprovider.GitRepository, auth *http.BasicAuth) []string
 	RepositoryExists() (bool, error)
 	SetGitConfig(userData *gitprovider.GitUser) error
 	GetGitStatus() (*project.GitStatus, error)
+	GetPatch() (*project.Patch, error)
+	ApplyPatch(patch string) error
 }
 
+// Patches larger than this are not returned
+const maxPatchSize = 10 * 1024 * 1024
+
+var ErrPatchTooLarge = errors.New("uncommitted changes are too large to be copied")
+
 type Service struct {
 	ProjectDir        string
 	GitConfigFileName string
@@ -47,7 +57,8 @@ type Service struct {
 	OpenRepository    *git.Repository
 }
 
-func (s *Service) CloneRepository(repo *gitprovider.GitRepository, auth *http.BasicAuth) error {
+// CloneRepository clones the repository into the project directory. Cancelling ctx aborts the clone
+func (s *Service) CloneRepository(ctx context.Context, repo *gitprovider.GitRepository, auth *http.BasicAuth) error {
 	cloneOptions := &git.CloneOptions{
 		URL:             repo.Url,
 		SingleBranch:    true,
@@ -70,7 +81,7 @@ func (s *Service) CloneRepository(repo *gitprovider.GitRepository, auth *http.Ba
 
 	cloneOptions.ReferenceName = plumbing.ReferenceName("refs/heads/" + repo.Branch)
 
-	_, err := git.PlainClone(s.ProjectDir, false, cloneOptions)
+	_, err := git.PlainCloneContext(ctx, s.ProjectDir, false, cloneOptions)
 	if err != nil {
 		return err
 	}
@@ -224,3 +235,129 @@ func (s *Service) GetGitStatus() (*project.GitStatus, error) {
 		Files:         files,
 	}, nil
 }
+
+// GetPatch returns the uncommitted changes, including untracked files that are not ignored,
+// as a binary patch against HEAD together with the HEAD commit and the remote branch that contains it.
+// The files are staged in a temporary index so that the index of the repository is left untouched
+func (s *Service) GetPatch() (*project.Patch, error) {
+	tmpDir, err := os.MkdirTemp("", "daytona-patch")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	indexPath := filepath.Join(tmpDir, "index")
+
+	index, err := os.ReadFile(filepath.Join(s.ProjectDir, ".git", "index"))
+	if err == nil {
+		err = os.WriteFile(indexPath, index, 0600)
+		if err != nil {
+			return nil, err
+		}
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	_, err = s.runGitWithIndex(indexPath, "add", "--all")
+	if err != nil {
+		return nil, err
+	}
+
+	diff, err := s.runGitWithIndex(indexPath, "diff", "--cached", "--binary", "HEAD")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(diff) > maxPatchSize {
+		return nil, ErrPatchTooLarge
+	}
+
+	sha, err := s.runGitWithIndex(indexPath, "rev-parse", "HEAD")
+	if err != nil {
+		return nil, err
+	}
+
+	branch, err := s.getPushedBranch(indexPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &project.Patch{
+		Sha:    strings.TrimSpace(sha),
+		Branch: branch,
+		Diff:   diff,
+	}, nil
+}
+
+// getPushedBranch returns the branch of the origin remote that contains HEAD, preferring the current branch.
+// An empty string is returned if HEAD has not been pushed
+func (s *Service) getPushedBranch(indexPath string) (string, error) {
+	output, err := s.runGitWithIndex(indexPath, "for-each-ref", "--contains", "HEAD", "--format=%(refname:lstrip=3)", "refs/remotes/origin")
+	if err != nil {
+		return "", err
+	}
+
+	currentBranch, err := s.runGitWithIndex(indexPath, "rev-parse", "--abbrev-ref", "HEAD")
+	if err != nil {
+		return "", err
+	}
+	currentBranch = strings.TrimSpace(currentBranch)
+
+	branches := []string{}
+	for _, branch := range strings.Split(strings.TrimSpace(output), "\n") {
+		if branch == "" || branch == "HEAD" {
+			continue
+		}
+		if branch == currentBranch {
+			return branch, nil
+		}
+		branches = append(branches, branch)
+	}
+
+	if len(branches) == 0 {
+		return "", nil
+	}
+
+	return branches[0], nil
+}
+
+// ApplyPatch applies the patch to the working tree.
+// The patch is only applied once so that restarting the project does not apply it again.
+func (s *Service) ApplyPatch(patch string) error {
+	markerPath := filepath.Join(s.ProjectDir, ".git", "daytona-patch-applied")
+
+	_, err := os.Stat(markerPath)
+	if err == nil {
+		return nil
+	}
+	if !os.IsNotExist(err) {
+		return err
+	}
+
+	cmd := exec.Command("git", "apply", "--whitespace=nowarn", "-")
+	cmd.Dir = s.ProjectDir
+	cmd.Stdin = strings.NewReader(patch)
+
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to apply patch: %s", strings.TrimSpace(string(output)))
+	}
+
+	return os.WriteFile(markerPath, []byte{}, 0644)
+}
+
+func (s *Service) runGitWithIndex(indexPath string, args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = s.ProjectDir
+	cmd.Env = append(os.Environ(), "GIT_INDEX_FILE="+indexPath)
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("git %s failed: %w: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+
+	return string(output), nil
+}
@@ -0,0 +1,105 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package git_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/git"
+	"github.com/stretchr/testify/require"
+)
+
+func runGit(t *testing.T, dir string, args ...string) string {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com", "GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com")
+
+	output, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(output))
+
+	return string(output)
+}
+
+func TestGetPatch(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	remoteDir := t.TempDir()
+	runGit(t, remoteDir, "init", "-q", "--bare")
+
+	projectDir := t.TempDir()
+	runGit(t, projectDir, "init", "-q", "-b", "main")
+	runGit(t, projectDir, "remote", "add", "origin", remoteDir)
+	require.NoError(t, os.WriteFile(filepath.Join(projectDir, "main.go"), []byte("package main\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(projectDir, ".gitignore"), []byte("build/\n"), 0644))
+	runGit(t, projectDir, "add", ".")
+	runGit(t, projectDir, "commit", "-q", "-m", "initial")
+	runGit(t, projectDir, "push", "-q", "origin", "main")
+
+	require.NoError(t, os.WriteFile(filepath.Join(projectDir, "main.go"), []byte("package main\n\nfunc main() {}\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(projectDir, "untracked.go"), []byte("package main\n"), 0644))
+	require.NoError(t, os.MkdirAll(filepath.Join(projectDir, "build"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(projectDir, "build", "out"), []byte("ignored"), 0644))
+
+	service := &git.Service{ProjectDir: projectDir}
+
+	patch, err := service.GetPatch()
+	require.NoError(t, err)
+	require.Equal(t, strings.TrimSpace(runGit(t, projectDir, "rev-parse", "HEAD")), patch.Sha)
+	require.Equal(t, "main", patch.Branch)
+	require.Contains(t, patch.Diff, "diff --git a/main.go b/main.go")
+	require.Contains(t, patch.Diff, "diff --git a/untracked.go b/untracked.go")
+	require.NotContains(t, patch.Diff, "build/out")
+
+	// The index of the repository is left untouched
+	require.Contains(t, runGit(t, projectDir, "status", "--porcelain"), "?? untracked.go")
+
+	// The patch recreates the changes on a clean checkout
+	runGit(t, projectDir, "stash", "-q", "--include-untracked")
+	require.NoError(t, service.ApplyPatch(patch.Diff))
+
+	content, err := os.ReadFile(filepath.Join(projectDir, "untracked.go"))
+	require.NoError(t, err)
+	require.Equal(t, "package main\n", string(content))
+}
+
+func TestGetPatchNotPushed(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	remoteDir := t.TempDir()
+	runGit(t, remoteDir, "init", "-q", "--bare")
+
+	projectDir := t.TempDir()
+	runGit(t, projectDir, "init", "-q", "-b", "main")
+	runGit(t, projectDir, "remote", "add", "origin", remoteDir)
+	require.NoError(t, os.WriteFile(filepath.Join(projectDir, "main.go"), []byte("package main\n"), 0644))
+	runGit(t, projectDir, "add", ".")
+	runGit(t, projectDir, "commit", "-q", "-m", "initial")
+	runGit(t, projectDir, "push", "-q", "origin", "main")
+
+	runGit(t, projectDir, "checkout", "-q", "-b", "feature")
+	require.NoError(t, os.WriteFile(filepath.Join(projectDir, "feature.go"), []byte("package main\n"), 0644))
+	runGit(t, projectDir, "add", ".")
+	runGit(t, projectDir, "commit", "-q", "-m", "feature")
+
+	service := &git.Service{ProjectDir: projectDir}
+
+	patch, err := service.GetPatch()
+	require.NoError(t, err)
+	require.Empty(t, patch.Branch)
+
+	// A pushed commit is found on the remote even if the local branch has another name
+	runGit(t, projectDir, "push", "-q", "origin", "feature:shared")
+
+	patch, err = service.GetPatch()
+	require.NoError(t, err)
+	require.Equal(t, "shared", patch.Branch)
+}
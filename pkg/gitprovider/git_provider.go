@@ -42,6 +42,7 @@ type GitProvider interface {
 	GetNamespaces() ([]*GitNamespace, error)
 	GetRepositories(namespace string) ([]*GitRepository, error)
 	GetUser() (*GitUser, error)
+	GetTokenInfo() (*TokenInfo, error)
 	GetRepoBranches(repositoryId string, namespaceId string) ([]*GitBranch, error)
 	GetRepoPRs(repositoryId string, namespaceId string) ([]*GitPullRequest, error)
 
@@ -126,6 +127,11 @@ func (a *AbstractGitProvider) GetRepositoryContext(repoContext GetRepositoryCont
 	}, nil
 }
 
+// Providers that do not expose token metadata report no scopes and no expiry
+func (a *AbstractGitProvider) GetTokenInfo() (*TokenInfo, error) {
+	return &TokenInfo{}, nil
+}
+
 func (a *AbstractGitProvider) ParseStaticGitContext(repoUrl string) (*StaticGitContext, error) {
 	isHttps := true
 	if strings.HasPrefix(repoUrl, "http://") {
@@ -11,6 +11,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/daytonaio/daytona/internal/util"
 	"github.com/google/go-github/github"
@@ -207,6 +208,41 @@ func (g *GitHubGitProvider) GetUser() (*GitUser, error) {
 	return response, nil
 }
 
+func (g *GitHubGitProvider) GetTokenInfo() (*TokenInfo, error) {
+	client := g.getApiClient()
+
+	_, res, err := client.Users.Get(context.Background(), "")
+	if err != nil {
+		return nil, err
+	}
+
+	return parseGitHubTokenInfo(res.Header), nil
+}
+
+// GitHub reports classic token scopes and the expiry of expiring tokens in response headers.
+// Fine-grained tokens have no scopes header
+func parseGitHubTokenInfo(header http.Header) *TokenInfo {
+	tokenInfo := &TokenInfo{}
+
+	for _, scope := range strings.Split(header.Get("X-OAuth-Scopes"), ",") {
+		scope = strings.TrimSpace(scope)
+		if scope != "" {
+			tokenInfo.Scopes = append(tokenInfo.Scopes, scope)
+		}
+	}
+
+	expiration := header.Get("GitHub-Authentication-Token-Expiration")
+	for _, layout := range []string{"2006-01-02 15:04:05 MST", "2006-01-02 15:04:05 -0700"} {
+		expiresAt, err := time.Parse(layout, expiration)
+		if err == nil {
+			tokenInfo.ExpiresAt = &expiresAt
+			break
+		}
+	}
+
+	return tokenInfo
+}
+
 func (g *GitHubGitProvider) GetLastCommitSha(staticContext *StaticGitContext) (string, error) {
 	client := g.getApiClient()
 
@@ -4,7 +4,9 @@
 package gitprovider
 
 import (
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/daytonaio/daytona/internal/util"
 	"github.com/stretchr/testify/suite"
@@ -234,6 +236,31 @@ func (g *GitHubGitProviderTestSuite) TestGetUrlFromRepo_Commit() {
 	require.Equal("https://github.com/daytonaio/daytona/commit/COMMIT_SHA", url)
 }
 
+func (g *GitHubGitProviderTestSuite) TestParseGitHubTokenInfo() {
+	require := g.Require()
+
+	header := http.Header{}
+	header.Set("X-OAuth-Scopes", "repo, read:org")
+	header.Set("GitHub-Authentication-Token-Expiration", "2024-07-01 12:00:00 UTC")
+
+	tokenInfo := parseGitHubTokenInfo(header)
+
+	require.Equal([]string{"repo", "read:org"}, tokenInfo.Scopes)
+	require.NotNil(tokenInfo.ExpiresAt)
+	require.True(time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC).Equal(*tokenInfo.ExpiresAt))
+
+	header.Set("GitHub-Authentication-Token-Expiration", "2024-07-01 12:00:00 +0200")
+
+	tokenInfo = parseGitHubTokenInfo(header)
+
+	require.True(time.Date(2024, 7, 1, 10, 0, 0, 0, time.UTC).Equal(*tokenInfo.ExpiresAt))
+
+	tokenInfo = parseGitHubTokenInfo(http.Header{})
+
+	require.Empty(tokenInfo.Scopes)
+	require.Nil(tokenInfo.ExpiresAt)
+}
+
 func TestGitHubGitProvider(t *testing.T) {
 	suite.Run(t, NewGitHubGitProviderTestSuite())
 }
@@ -13,6 +13,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/daytonaio/daytona/internal/util"
 	"github.com/xanzy/go-gitlab"
@@ -185,6 +186,27 @@ func (g *GitLabGitProvider) GetUser() (*GitUser, error) {
 	return response, nil
 }
 
+func (g *GitLabGitProvider) GetTokenInfo() (*TokenInfo, error) {
+	client := g.getApiClient()
+
+	token, _, err := client.PersonalAccessTokens.GetSinglePersonalAccessToken()
+	if err != nil {
+		// Instances older than GitLab 15.5 do not expose the token endpoint
+		return &TokenInfo{}, nil
+	}
+
+	tokenInfo := &TokenInfo{
+		Scopes: token.Scopes,
+	}
+
+	if token.ExpiresAt != nil {
+		expiresAt := time.Time(*token.ExpiresAt)
+		tokenInfo.ExpiresAt = &expiresAt
+	}
+
+	return tokenInfo, nil
+}
+
 func (g *GitLabGitProvider) GetBranchByCommit(staticContext *StaticGitContext) (string, error) {
 	client := g.getApiClient()
 
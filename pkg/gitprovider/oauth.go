@@ -0,0 +1,81 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package gitprovider
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/endpoints"
+)
+
+// OAuthApp holds the credentials of the OAuth application registered with a git provider.
+// GitHub requires the client secret to refresh expiring user tokens
+type OAuthApp struct {
+	ClientId     string
+	ClientSecret string
+}
+
+type OAuthDeviceCode struct {
+	DeviceCode      string    `json:"deviceCode" validate:"required"`
+	UserCode        string    `json:"userCode" validate:"required"`
+	VerificationUri string    `json:"verificationUri" validate:"required"`
+	ExpiresAt       time.Time `json:"expiresAt" validate:"required"`
+	// Seconds to wait between polls for the access token
+	Interval int64 `json:"interval" validate:"required"`
+} // @name OAuthDeviceCode
+
+// Git providers that support authorization through the OAuth device flow
+var OAuthProviderIds = []string{"github", "github-enterprise-server"}
+
+var (
+	ErrOAuthNotSupported = errors.New("git provider does not support OAuth")
+	ErrTokenExpired      = errors.New("git provider token expired")
+)
+
+func GetOAuthConfig(gitProviderId string, baseApiUrl *string, app OAuthApp) (*oauth2.Config, error) {
+	config := &oauth2.Config{
+		ClientID:     app.ClientId,
+		ClientSecret: app.ClientSecret,
+	}
+
+	switch gitProviderId {
+	case "github":
+		config.Endpoint = endpoints.GitHub
+		config.Scopes = []string{"repo", "read:org", "user:email", "admin:repo_hook"}
+	case "github-enterprise-server":
+		if baseApiUrl == nil || *baseApiUrl == "" {
+			return nil, errors.New("base API URL is required for GitHub Enterprise Server")
+		}
+
+		hostUrl := strings.TrimSuffix(strings.TrimSuffix(*baseApiUrl, "/"), "/api/v3")
+		config.Endpoint = oauth2.Endpoint{
+			AuthURL:       hostUrl + "/login/oauth/authorize",
+			TokenURL:      hostUrl + "/login/oauth/access_token",
+			DeviceAuthURL: hostUrl + "/login/device/code",
+		}
+		config.Scopes = []string{"repo", "read:org", "user:email", "admin:repo_hook"}
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrOAuthNotSupported, gitProviderId)
+	}
+
+	config.Endpoint.AuthStyle = oauth2.AuthStyleInParams
+
+	return config, nil
+}
+
+// ApplyOAuthToken stores the access token and its refresh data on the config
+func ApplyOAuthToken(config *GitProviderConfig, token *oauth2.Token) {
+	config.Token = token.AccessToken
+	config.RefreshToken = token.RefreshToken
+	config.TokenExpiresAt = nil
+
+	if !token.Expiry.IsZero() {
+		expiresAt := token.Expiry
+		config.TokenExpiresAt = &expiresAt
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package gitprovider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+func TestGetOAuthConfig(t *testing.T) {
+	app := OAuthApp{ClientId: "client-id", ClientSecret: "client-secret"}
+
+	config, err := GetOAuthConfig("github", nil, app)
+	require.Nil(t, err)
+	require.Equal(t, "client-id", config.ClientID)
+	require.Equal(t, "https://github.com/login/device/code", config.Endpoint.DeviceAuthURL)
+	require.Equal(t, "https://github.com/login/oauth/access_token", config.Endpoint.TokenURL)
+
+	config, err = GetOAuthConfig("github-enterprise-server", util.Pointer("https://github.example.com/api/v3/"), app)
+	require.Nil(t, err)
+	require.Equal(t, "https://github.example.com/login/device/code", config.Endpoint.DeviceAuthURL)
+	require.Equal(t, "https://github.example.com/login/oauth/access_token", config.Endpoint.TokenURL)
+
+	_, err = GetOAuthConfig("github-enterprise-server", nil, app)
+	require.NotNil(t, err)
+
+	_, err = GetOAuthConfig("bitbucket", nil, app)
+	require.ErrorIs(t, err, ErrOAuthNotSupported)
+}
+
+func TestApplyOAuthToken(t *testing.T) {
+	expiry := time.Now().Add(8 * time.Hour)
+	config := &GitProviderConfig{
+		Id:             "github",
+		TokenExpiresAt: util.Pointer(time.Now()),
+	}
+
+	ApplyOAuthToken(config, &oauth2.Token{
+		AccessToken:  "access-token",
+		RefreshToken: "refresh-token",
+		Expiry:       expiry,
+	})
+
+	require.Equal(t, "access-token", config.Token)
+	require.Equal(t, "refresh-token", config.RefreshToken)
+	require.Equal(t, expiry, *config.TokenExpiresAt)
+	require.False(t, config.IsTokenExpired())
+
+	ApplyOAuthToken(config, &oauth2.Token{AccessToken: "non-expiring"})
+
+	require.Equal(t, "non-expiring", config.Token)
+	require.Empty(t, config.RefreshToken)
+	require.Nil(t, config.TokenExpiresAt)
+}
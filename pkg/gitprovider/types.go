@@ -3,13 +3,47 @@
 
 package gitprovider
 
+import "time"
+
 type GitProviderConfig struct {
 	Id         string  `json:"id" validate:"required"`
 	Username   string  `json:"username" validate:"required"`
 	BaseApiUrl *string `json:"baseApiUrl,omitempty" validate:"optional"`
 	Token      string  `json:"token" validate:"required"`
+	// Set when the token was obtained through OAuth and can be refreshed
+	RefreshToken string `json:"refreshToken,omitempty" validate:"optional"`
+	// Scopes granted to the token, as reported by the provider
+	TokenScopes    []string   `json:"tokenScopes,omitempty" validate:"optional"`
+	TokenExpiresAt *time.Time `json:"tokenExpiresAt,omitempty" validate:"optional"`
 } // @name GitProvider
 
+func (c *GitProviderConfig) IsTokenExpired() bool {
+	return c.TokenExpiresAt != nil && !time.Now().Before(*c.TokenExpiresAt)
+}
+
+type TokenInfo struct {
+	Scopes    []string
+	ExpiresAt *time.Time
+}
+
+type TokenStatus string // @name TokenStatus
+
+const (
+	TokenStatusValid    TokenStatus = "valid"
+	TokenStatusExpiring TokenStatus = "expiring"
+	TokenStatusExpired  TokenStatus = "expired"
+	TokenStatusInvalid  TokenStatus = "invalid"
+)
+
+type TokenHealth struct {
+	Status      TokenStatus `json:"status" validate:"required"`
+	Scopes      []string    `json:"scopes,omitempty" validate:"optional"`
+	ExpiresAt   *time.Time  `json:"expiresAt,omitempty" validate:"optional"`
+	Refreshable bool        `json:"refreshable" validate:"required"`
+	// Reason the token was reported as invalid
+	Error *string `json:"error,omitempty" validate:"optional"`
+} // @name TokenHealth
+
 type GitUser struct {
 	Id       string `json:"id" validate:"required"`
 	Username string `json:"username" validate:"required"`
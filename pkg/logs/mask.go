@@ -0,0 +1,144 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package logs
+
+import (
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const MaskedValue = "********"
+
+// MinMaskedValueLength is the length of the shortest value that is masked.
+// Shorter values would mask unrelated parts of the logs
+const MinMaskedValueLength = 4
+
+// SecretValuesGetter returns the values that must not appear in logs
+type SecretValuesGetter func() ([]string, error)
+
+type maskingLoggerFactory struct {
+	LoggerFactory
+	getSecretValues []SecretValuesGetter
+}
+
+// NewMaskingLoggerFactory returns a logger factory whose loggers replace secret values with MaskedValue.
+// The values are read from all getters once when a logger is created
+func NewMaskingLoggerFactory(loggerFactory LoggerFactory, getSecretValues ...SecretValuesGetter) LoggerFactory {
+	return &maskingLoggerFactory{
+		LoggerFactory:   loggerFactory,
+		getSecretValues: getSecretValues,
+	}
+}
+
+func (f *maskingLoggerFactory) CreateWorkspaceLogger(workspaceId string, source LogSource) Logger {
+	return f.mask(f.LoggerFactory.CreateWorkspaceLogger(workspaceId, source))
+}
+
+func (f *maskingLoggerFactory) CreateProjectLogger(workspaceId, projectName string, source LogSource) Logger {
+	return f.mask(f.LoggerFactory.CreateProjectLogger(workspaceId, projectName, source))
+}
+
+func (f *maskingLoggerFactory) CreateBuildLogger(buildId string, source LogSource) Logger {
+	return f.mask(f.LoggerFactory.CreateBuildLogger(buildId, source))
+}
+
+func (f *maskingLoggerFactory) mask(logger Logger) Logger {
+	values := []string{}
+	for _, getSecretValues := range f.getSecretValues {
+		v, err := getSecretValues()
+		if err != nil {
+			log.Errorf("failed to get secret values to mask: %s", err)
+		}
+		values = append(values, v...)
+	}
+
+	maskedValues := []string{}
+	for _, value := range values {
+		if len(value) >= MinMaskedValueLength {
+			maskedValues = append(maskedValues, value)
+		}
+	}
+
+	if len(maskedValues) == 0 {
+		return logger
+	}
+
+	// Longer values first so that a value containing another one is masked entirely
+	sort.Slice(maskedValues, func(i, j int) bool {
+		return len(maskedValues[i]) > len(maskedValues[j])
+	})
+
+	return &maskingLogger{
+		Logger: logger,
+		values: maskedValues,
+	}
+}
+
+// maskingLogger holds back the end of a write if it could be the start of a secret value
+// so that values split across writes are masked too. The held back text is written on Close
+type maskingLogger struct {
+	Logger
+	values  []string
+	pending string
+	mutex   sync.Mutex
+}
+
+func (l *maskingLogger) Write(p []byte) (n int, err error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	var masked strings.Builder
+	text := l.pending + string(p)
+	l.pending = ""
+
+	for i := 0; i < len(text); {
+		matched := false
+		for _, value := range l.values {
+			if strings.HasPrefix(text[i:], value) {
+				masked.WriteString(MaskedValue)
+				i += len(value)
+				matched = true
+				break
+			}
+
+			if strings.HasPrefix(value, text[i:]) {
+				l.pending = text[i:]
+				i = len(text)
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			masked.WriteByte(text[i])
+			i++
+		}
+	}
+
+	if masked.Len() > 0 {
+		_, err = io.WriteString(l.Logger, masked.String())
+	}
+
+	return len(p), err
+}
+
+func (l *maskingLogger) Close() error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	// The held back text did not turn out to be a secret value
+	if l.pending != "" {
+		_, err := io.WriteString(l.Logger, l.pending)
+		l.pending = ""
+		if err != nil {
+			return err
+		}
+	}
+
+	return l.Logger.Close()
+}
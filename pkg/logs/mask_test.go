@@ -0,0 +1,68 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package logs
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type bufferLogger struct {
+	bytes.Buffer
+}
+
+func (l *bufferLogger) Close() error {
+	return nil
+}
+
+func (l *bufferLogger) Cleanup() error {
+	return nil
+}
+
+type bufferLoggerFactory struct {
+	LoggerFactory
+	logger *bufferLogger
+}
+
+func (f *bufferLoggerFactory) CreateProjectLogger(workspaceId, projectName string, source LogSource) Logger {
+	return f.logger
+}
+
+func TestMaskingLoggerFactory(t *testing.T) {
+	buffer := &bufferLogger{}
+	factory := NewMaskingLoggerFactory(
+		&bufferLoggerFactory{logger: buffer},
+		func() ([]string, error) { return []string{"hunter2", "abc"}, nil },
+		func() ([]string, error) { return []string{"vault-secret"}, nil },
+	)
+
+	logger := factory.CreateProjectLogger("ws1", "p1", LogSourceServer)
+	_, err := logger.Write([]byte("password hunter2, token vault-secret, abc\n"))
+	require.NoError(t, err)
+
+	// Values shorter than MinMaskedValueLength are not masked
+	require.Equal(t, "password ********, token ********, abc\n", buffer.String())
+}
+
+func TestMaskingLoggerSplitWrites(t *testing.T) {
+	buffer := &bufferLogger{}
+	factory := NewMaskingLoggerFactory(
+		&bufferLoggerFactory{logger: buffer},
+		func() ([]string, error) { return []string{"hunter2", "vault-secret"}, nil },
+	)
+
+	logger := factory.CreateProjectLogger("ws1", "p1", LogSourceServer)
+	for _, chunk := range []string{"password hun", "ter2, token va", "ult-sec", "ret\n", "last line h"} {
+		_, err := logger.Write([]byte(chunk))
+		require.NoError(t, err)
+	}
+
+	// The end of the last write could still be the start of a secret value
+	require.Equal(t, "password ********, token ********\nlast line ", buffer.String())
+
+	require.NoError(t, logger.Close())
+	require.Equal(t, "password ********, token ********\nlast line h", buffer.String())
+}
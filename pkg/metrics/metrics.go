@@ -0,0 +1,96 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "daytona"
+
+// Registry holds the server metrics.
+// A dedicated registry is used so that metrics registered globally by dependencies are not exposed.
+var Registry = prometheus.NewRegistry()
+
+var (
+	requestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "api",
+		Name:      "request_duration_seconds",
+		Help:      "Duration of API requests by route",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	buildDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "build",
+		Name:      "duration_seconds",
+		Help:      "Duration of builds by outcome",
+		// 10 seconds to about 1.5 hours
+		Buckets: prometheus.ExponentialBuckets(10, 2, 10),
+	}, []string{"outcome"})
+
+	providerCallDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "provider",
+		Name:      "call_duration_seconds",
+		Help:      "Duration of provider RPC calls",
+		Buckets:   prometheus.ExponentialBuckets(0.05, 2, 12),
+	}, []string{"provider", "method"})
+
+	providerCallErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "provider",
+		Name:      "call_errors_total",
+		Help:      "Number of failed provider RPC calls",
+	}, []string{"provider", "method"})
+)
+
+type BuildOutcome string
+
+const (
+	BuildOutcomeSuccess   BuildOutcome = "success"
+	BuildOutcomeError     BuildOutcome = "error"
+	BuildOutcomeCancelled BuildOutcome = "cancelled"
+	BuildOutcomeTimeout   BuildOutcome = "timeout"
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		requestDuration,
+		buildDuration,
+		providerCallDuration,
+		providerCallErrors,
+	)
+}
+
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{})
+}
+
+// ObserveRequest records an API request. The route is the path template, e.g. /workspace/:workspaceId
+func ObserveRequest(method, route string, status int, duration time.Duration) {
+	requestDuration.WithLabelValues(method, route, strconv.Itoa(status)).Observe(duration.Seconds())
+}
+
+func ObserveBuild(outcome BuildOutcome, duration time.Duration) {
+	buildDuration.WithLabelValues(string(outcome)).Observe(duration.Seconds())
+}
+
+// ObserveProviderCall records the duration of a provider call started at start and counts it as failed if err is set
+func ObserveProviderCall(providerName, method string, start time.Time, err error) {
+	providerCallDuration.WithLabelValues(providerName, method).Observe(time.Since(start).Seconds())
+
+	if err != nil {
+		providerCallErrors.WithLabelValues(providerName, method).Inc()
+	}
+}
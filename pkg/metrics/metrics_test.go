@@ -0,0 +1,36 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObserveProviderCall(t *testing.T) {
+	ObserveProviderCall("test-provider", "StartProject", time.Now(), nil)
+	ObserveProviderCall("test-provider", "StartProject", time.Now(), errors.New("failed"))
+
+	require.Equal(t, 1, testutil.CollectAndCount(providerCallDuration, "daytona_provider_call_duration_seconds"))
+	require.Equal(t, float64(1), testutil.ToFloat64(providerCallErrors.WithLabelValues("test-provider", "StartProject")))
+}
+
+func TestHandler(t *testing.T) {
+	ObserveRequest("GET", "/workspace/:workspaceId", 200, time.Second)
+	ObserveBuild(BuildOutcomeSuccess, time.Minute)
+
+	recorder := httptest.NewRecorder()
+	Handler().ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+
+	body := recorder.Body.String()
+	require.True(t, strings.Contains(body, `daytona_api_request_duration_seconds_count{method="GET",route="/workspace/:workspaceId",status="200"} 1`))
+	require.True(t, strings.Contains(body, `daytona_build_duration_seconds_count{outcome="success"} 1`))
+	require.True(t, strings.Contains(body, "go_goroutines"))
+}
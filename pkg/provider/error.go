@@ -0,0 +1,22 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	ErrSnapshotNotSupported = errors.New("provider does not support snapshots")
+)
+
+func IsSnapshotNotSupported(err error) bool {
+	return err.Error() == ErrSnapshotNotSupported.Error()
+}
+
+// Plugins built before a method was added to the Provider interface do not register it
+func isMethodNotFound(err error) bool {
+	return err != nil && strings.HasPrefix(err.Error(), "rpc: can't find method")
+}
@@ -30,6 +30,11 @@ type Provider interface {
 	StopProject(*ProjectRequest) (*util.Empty, error)
 	DestroyProject(*ProjectRequest) (*util.Empty, error)
 	GetProjectInfo(*ProjectRequest) (*project.ProjectInfo, error)
+
+	// Snapshots are optional, providers that do not support them return ErrSnapshotNotSupported
+	SnapshotProject(*ProjectSnapshotRequest) (*util.Empty, error)
+	RestoreProject(*ProjectSnapshotRequest) (*util.Empty, error)
+	DeleteSnapshot(*ProjectSnapshotRequest) (*util.Empty, error)
 }
 
 type ProviderPlugin struct {
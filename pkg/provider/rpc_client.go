@@ -90,3 +90,27 @@ func (m *ProviderRPCClient) GetProjectInfo(projectReq *ProjectRequest) (*project
 	err := m.client.Call("Plugin.GetProjectInfo", projectReq, &resp)
 	return &resp, err
 }
+
+func (m *ProviderRPCClient) SnapshotProject(snapshotReq *ProjectSnapshotRequest) (*util.Empty, error) {
+	err := m.client.Call("Plugin.SnapshotProject", snapshotReq, new(util.Empty))
+	if isMethodNotFound(err) {
+		return new(util.Empty), ErrSnapshotNotSupported
+	}
+	return new(util.Empty), err
+}
+
+func (m *ProviderRPCClient) RestoreProject(snapshotReq *ProjectSnapshotRequest) (*util.Empty, error) {
+	err := m.client.Call("Plugin.RestoreProject", snapshotReq, new(util.Empty))
+	if isMethodNotFound(err) {
+		return new(util.Empty), ErrSnapshotNotSupported
+	}
+	return new(util.Empty), err
+}
+
+func (m *ProviderRPCClient) DeleteSnapshot(snapshotReq *ProjectSnapshotRequest) (*util.Empty, error) {
+	err := m.client.Call("Plugin.DeleteSnapshot", snapshotReq, new(util.Empty))
+	if isMethodNotFound(err) {
+		return new(util.Empty), ErrSnapshotNotSupported
+	}
+	return new(util.Empty), err
+}
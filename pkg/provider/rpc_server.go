@@ -107,3 +107,18 @@ func (m *ProviderRPCServer) GetProjectInfo(arg *ProjectRequest, resp *project.Pr
 	*resp = *info
 	return nil
 }
+
+func (m *ProviderRPCServer) SnapshotProject(arg *ProjectSnapshotRequest, resp *util.Empty) error {
+	_, err := m.Impl.SnapshotProject(arg)
+	return err
+}
+
+func (m *ProviderRPCServer) RestoreProject(arg *ProjectSnapshotRequest, resp *util.Empty) error {
+	_, err := m.Impl.RestoreProject(arg)
+	return err
+}
+
+func (m *ProviderRPCServer) DeleteSnapshot(arg *ProjectSnapshotRequest, resp *util.Empty) error {
+	_, err := m.Impl.DeleteSnapshot(arg)
+	return err
+}
@@ -42,11 +42,19 @@ type ProjectRequest struct {
 	GitProviderConfig *gitprovider.GitProviderConfig
 }
 
+type ProjectSnapshotRequest struct {
+	TargetOptions string
+	Project       *project.Project
+	SnapshotId    string
+}
+
 type ProviderTarget struct {
 	Name         string       `json:"name" validate:"required"`
 	ProviderInfo ProviderInfo `json:"providerInfo" validate:"required"`
 	// JSON encoded map of options
 	Options string `json:"options" validate:"required"`
+	// Minutes of inactivity after which projects on the target are stopped, 0 disables auto-stop
+	AutoStop uint32 `json:"autoStop,omitempty" validate:"optional"`
 } // @name ProviderTarget
 
 type ProviderTargetManifest map[string]ProviderTargetProperty // @name ProviderTargetManifest
@@ -4,8 +4,11 @@
 package provisioner
 
 import (
+	"time"
+
 	"github.com/daytonaio/daytona/pkg/containerregistry"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/metrics"
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
@@ -17,10 +20,12 @@ func (p *Provisioner) CreateWorkspace(workspace *workspace.Workspace, target *pr
 		return err
 	}
 
+	start := time.Now()
 	_, err = (*targetProvider).CreateWorkspace(&provider.WorkspaceRequest{
 		TargetOptions: target.Options,
 		Workspace:     workspace,
 	})
+	metrics.ObserveProviderCall(target.ProviderInfo.Name, "CreateWorkspace", start, err)
 
 	return err
 }
@@ -31,12 +36,14 @@ func (p *Provisioner) CreateProject(proj *project.Project, target *provider.Prov
 		return err
 	}
 
+	start := time.Now()
 	_, err = (*targetProvider).CreateProject(&provider.ProjectRequest{
 		TargetOptions:     target.Options,
 		Project:           proj,
 		ContainerRegistry: cr,
 		GitProviderConfig: gc,
 	})
+	metrics.ObserveProviderCall(target.ProviderInfo.Name, "CreateProject", start, err)
 
 	return err
 }
@@ -4,6 +4,9 @@
 package provisioner
 
 import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/metrics"
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
@@ -15,10 +18,12 @@ func (p *Provisioner) DestroyWorkspace(workspace *workspace.Workspace, target *p
 		return err
 	}
 
+	start := time.Now()
 	_, err = (*targetProvider).DestroyWorkspace(&provider.WorkspaceRequest{
 		TargetOptions: target.Options,
 		Workspace:     workspace,
 	})
+	metrics.ObserveProviderCall(target.ProviderInfo.Name, "DestroyWorkspace", start, err)
 
 	return err
 }
@@ -29,10 +34,12 @@ func (p *Provisioner) DestroyProject(proj *project.Project, target *provider.Pro
 		return err
 	}
 
+	start := time.Now()
 	_, err = (*targetProvider).DestroyProject(&provider.ProjectRequest{
 		TargetOptions: target.Options,
 		Project:       proj,
 	})
+	metrics.ObserveProviderCall(target.ProviderInfo.Name, "DestroyProject", start, err)
 
 	return err
 }
@@ -4,6 +4,9 @@
 package provisioner
 
 import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/metrics"
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/workspace"
 )
@@ -14,8 +17,12 @@ func (p *Provisioner) GetWorkspaceInfo(workspace *workspace.Workspace, target *p
 		return nil, err
 	}
 
-	return (*targetProvider).GetWorkspaceInfo(&provider.WorkspaceRequest{
+	start := time.Now()
+	info, err := (*targetProvider).GetWorkspaceInfo(&provider.WorkspaceRequest{
 		TargetOptions: target.Options,
 		Workspace:     workspace,
 	})
+	metrics.ObserveProviderCall(target.ProviderInfo.Name, "GetWorkspaceInfo", start, err)
+
+	return info, err
 }
@@ -22,6 +22,9 @@ type IProvisioner interface {
 	StartWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error
 	StopProject(project *project.Project, target *provider.ProviderTarget) error
 	StopWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error
+	SnapshotProject(project *project.Project, target *provider.ProviderTarget, snapshotId string) error
+	RestoreProject(project *project.Project, target *provider.ProviderTarget, snapshotId string) error
+	DeleteSnapshot(project *project.Project, target *provider.ProviderTarget, snapshotId string) error
 }
 
 type ProvisionerConfig struct {
@@ -0,0 +1,63 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provisioner
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/metrics"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+func (p *Provisioner) SnapshotProject(proj *project.Project, target *provider.ProviderTarget, snapshotId string) error {
+	targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	_, err = (*targetProvider).SnapshotProject(&provider.ProjectSnapshotRequest{
+		TargetOptions: target.Options,
+		Project:       proj,
+		SnapshotId:    snapshotId,
+	})
+	metrics.ObserveProviderCall(target.ProviderInfo.Name, "SnapshotProject", start, err)
+
+	return err
+}
+
+func (p *Provisioner) RestoreProject(proj *project.Project, target *provider.ProviderTarget, snapshotId string) error {
+	targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	_, err = (*targetProvider).RestoreProject(&provider.ProjectSnapshotRequest{
+		TargetOptions: target.Options,
+		Project:       proj,
+		SnapshotId:    snapshotId,
+	})
+	metrics.ObserveProviderCall(target.ProviderInfo.Name, "RestoreProject", start, err)
+
+	return err
+}
+
+func (p *Provisioner) DeleteSnapshot(proj *project.Project, target *provider.ProviderTarget, snapshotId string) error {
+	targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	_, err = (*targetProvider).DeleteSnapshot(&provider.ProjectSnapshotRequest{
+		TargetOptions: target.Options,
+		Project:       proj,
+		SnapshotId:    snapshotId,
+	})
+	metrics.ObserveProviderCall(target.ProviderInfo.Name, "DeleteSnapshot", start, err)
+
+	return err
+}
@@ -4,6 +4,9 @@
 package provisioner
 
 import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/metrics"
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
@@ -15,10 +18,12 @@ func (p *Provisioner) StartWorkspace(workspace *workspace.Workspace, target *pro
 		return err
 	}
 
+	start := time.Now()
 	_, err = (*targetProvider).StartWorkspace(&provider.WorkspaceRequest{
 		TargetOptions: target.Options,
 		Workspace:     workspace,
 	})
+	metrics.ObserveProviderCall(target.ProviderInfo.Name, "StartWorkspace", start, err)
 
 	return err
 }
@@ -29,10 +34,12 @@ func (p *Provisioner) StartProject(proj *project.Project, target *provider.Provi
 		return err
 	}
 
+	start := time.Now()
 	_, err = (*targetProvider).StartProject(&provider.ProjectRequest{
 		TargetOptions: target.Options,
 		Project:       proj,
 	})
+	metrics.ObserveProviderCall(target.ProviderInfo.Name, "StartProject", start, err)
 
 	return err
 }
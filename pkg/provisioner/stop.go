@@ -4,6 +4,9 @@
 package provisioner
 
 import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/metrics"
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
@@ -15,10 +18,12 @@ func (p *Provisioner) StopWorkspace(workspace *workspace.Workspace, target *prov
 		return err
 	}
 
+	start := time.Now()
 	_, err = (*targetProvider).StopWorkspace(&provider.WorkspaceRequest{
 		TargetOptions: target.Options,
 		Workspace:     workspace,
 	})
+	metrics.ObserveProviderCall(target.ProviderInfo.Name, "StopWorkspace", start, err)
 
 	return err
 }
@@ -29,10 +34,12 @@ func (p *Provisioner) StopProject(proj *project.Project, target *provider.Provid
 		return err
 	}
 
+	start := time.Now()
 	_, err = (*targetProvider).StopProject(&provider.ProjectRequest{
 		TargetOptions: target.Options,
 		Project:       proj,
 	})
+	metrics.ObserveProviderCall(target.ProviderInfo.Name, "StopProject", start, err)
 
 	return err
 }
@@ -0,0 +1,71 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduler
+
+import (
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const DEFAULT_AUDIT_PRUNE_INTERVAL = "0 0 * * * *"
+
+type AuditLogPruner interface {
+	Prune(retention time.Duration) (int, error)
+}
+
+type AuditPrunerConfig struct {
+	Interval       string
+	Scheduler      IScheduler
+	AuditLogPruner AuditLogPruner
+	Retention      time.Duration
+}
+
+// AuditPruner periodically removes the audit records older than the retention
+type AuditPruner struct {
+	interval       string
+	scheduler      IScheduler
+	auditLogPruner AuditLogPruner
+	retention      time.Duration
+}
+
+func NewAuditPruner(config AuditPrunerConfig) *AuditPruner {
+	return &AuditPruner{
+		interval:       config.Interval,
+		scheduler:      config.Scheduler,
+		auditLogPruner: config.AuditLogPruner,
+		retention:      config.Retention,
+	}
+}
+
+// Start schedules the pruning. Records are kept indefinitely if the retention is not positive
+func (p *AuditPruner) Start() error {
+	if p.retention <= 0 {
+		return nil
+	}
+
+	err := p.scheduler.AddFunc(p.interval, func() { p.PruneAuditLog() })
+	if err != nil {
+		return err
+	}
+
+	p.scheduler.Start()
+	return nil
+}
+
+func (p *AuditPruner) Stop() {
+	p.scheduler.Stop()
+}
+
+func (p *AuditPruner) PruneAuditLog() {
+	deleted, err := p.auditLogPruner.Prune(p.retention)
+	if err != nil {
+		log.Errorf("failed to prune audit log: %s", err)
+		return
+	}
+
+	if deleted > 0 {
+		log.Debugf("Removed %d audit records older than %s", deleted, p.retention)
+	}
+}
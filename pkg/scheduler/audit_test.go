@@ -0,0 +1,39 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduler_test
+
+import (
+	"testing"
+	"time"
+
+	t_auditlog "github.com/daytonaio/daytona/internal/testing/server/auditlog"
+	"github.com/daytonaio/daytona/pkg/audit"
+	"github.com/daytonaio/daytona/pkg/scheduler"
+	"github.com/daytonaio/daytona/pkg/server/auditlog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditPruner(t *testing.T) {
+	auditLogService := auditlog.NewAuditLogService(auditlog.AuditLogServiceConfig{
+		AuditStore: t_auditlog.NewInMemoryAuditStore(),
+	})
+
+	old := &audit.Record{Id: "old", KeyName: "default", Timestamp: time.Now().Add(-48 * time.Hour)}
+	recent := &audit.Record{Id: "recent", KeyName: "default", Timestamp: time.Now().Add(-time.Hour)}
+
+	for _, r := range []*audit.Record{old, recent} {
+		require.Nil(t, auditLogService.Save(r))
+	}
+
+	scheduler.NewAuditPruner(scheduler.AuditPrunerConfig{
+		AuditLogPruner: auditLogService,
+		Retention:      24 * time.Hour,
+	}).PruneAuditLog()
+
+	records, err := auditLogService.List(nil)
+	require.Nil(t, err)
+	require.Equal(t, []*audit.Record{recent}, records)
+}
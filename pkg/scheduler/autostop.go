@@ -0,0 +1,161 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/lifecycle"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const DEFAULT_AUTO_STOP_INTERVAL = "0 * * * * *"
+
+type ProjectStopper interface {
+	StopProject(ctx context.Context, workspaceId string, projectName string) error
+}
+
+type AutoStopperConfig struct {
+	Interval       string
+	Scheduler      IScheduler
+	WorkspaceStore workspace.Store
+	TargetStore    provider.TargetStore
+	ProjectStopper ProjectStopper
+}
+
+// AutoStopper periodically stops projects that have been idle for longer than
+// the auto-stop timeout of their workspace or, if the workspace has none, of their target
+type AutoStopper struct {
+	interval       string
+	scheduler      IScheduler
+	workspaceStore workspace.Store
+	targetStore    provider.TargetStore
+	projectStopper ProjectStopper
+	stopping       map[string]bool
+	stoppingMutex  sync.Mutex
+}
+
+func NewAutoStopper(config AutoStopperConfig) *AutoStopper {
+	return &AutoStopper{
+		interval:       config.Interval,
+		scheduler:      config.Scheduler,
+		workspaceStore: config.WorkspaceStore,
+		targetStore:    config.TargetStore,
+		projectStopper: config.ProjectStopper,
+		stopping:       map[string]bool{},
+	}
+}
+
+func (a *AutoStopper) Start() error {
+	err := a.scheduler.AddFunc(a.interval, func() { a.StopIdleProjects() })
+	if err != nil {
+		return err
+	}
+
+	a.scheduler.Start()
+	return nil
+}
+
+func (a *AutoStopper) Stop() {
+	a.scheduler.Stop()
+}
+
+func (a *AutoStopper) StopIdleProjects() {
+	workspaces, err := a.workspaceStore.List()
+	if err != nil {
+		log.Error(err)
+		return
+	}
+
+	targetTimeouts := map[string]time.Duration{}
+	wg := sync.WaitGroup{}
+
+	for _, w := range workspaces {
+		for _, p := range w.Projects {
+			timeout, ok := targetTimeouts[p.Target]
+			if !ok {
+				timeout = a.getTargetTimeout(p.Target)
+				targetTimeouts[p.Target] = timeout
+			}
+
+			if w.AutoStop > 0 {
+				timeout = time.Duration(w.AutoStop) * time.Minute
+			}
+
+			if timeout == 0 || !isIdle(p, timeout) {
+				continue
+			}
+
+			wg.Add(1)
+			go func(workspaceId string, p *project.Project, timeout time.Duration) {
+				defer wg.Done()
+				a.stopProject(workspaceId, p.Name, timeout)
+			}(w.Id, p, timeout)
+		}
+	}
+
+	wg.Wait()
+}
+
+func (a *AutoStopper) stopProject(workspaceId, projectName string, timeout time.Duration) {
+	key := fmt.Sprintf("%s/%s", workspaceId, projectName)
+
+	a.stoppingMutex.Lock()
+	if a.stopping[key] {
+		a.stoppingMutex.Unlock()
+		return
+	}
+	a.stopping[key] = true
+	a.stoppingMutex.Unlock()
+
+	defer func() {
+		a.stoppingMutex.Lock()
+		delete(a.stopping, key)
+		a.stoppingMutex.Unlock()
+	}()
+
+	log.Infof("Stopping project %s in workspace %s after being idle for %s", projectName, workspaceId, timeout)
+
+	err := a.projectStopper.StopProject(context.Background(), workspaceId, projectName)
+	if err != nil {
+		log.Errorf("failed to auto-stop project %s in workspace %s: %s", projectName, workspaceId, err)
+	}
+}
+
+func (a *AutoStopper) getTargetTimeout(targetName string) time.Duration {
+	target, err := a.targetStore.Find(targetName)
+	if err != nil {
+		log.Tracef("failed to find target %s: %s", targetName, err)
+		return 0
+	}
+
+	return time.Duration(target.AutoStop) * time.Minute
+}
+
+// isIdle reports whether a started project has had no activity for the given timeout.
+// Projects whose agent does not report activity are never considered idle.
+func isIdle(p *project.Project, timeout time.Duration) bool {
+	if p.Lifecycle.State != lifecycle.StateStarted || p.State == nil || p.State.LastActivity == "" {
+		return false
+	}
+
+	lastActivity, err := time.Parse(time.RFC1123, p.State.LastActivity)
+	if err != nil {
+		return false
+	}
+
+	// Activity reported before the project was last started is not relevant
+	if p.Lifecycle.LastStartedAt != nil && p.Lifecycle.LastStartedAt.After(lastActivity) {
+		lastActivity = *p.Lifecycle.LastStartedAt
+	}
+
+	return time.Since(lastActivity) >= timeout
+}
@@ -0,0 +1,120 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduler_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/testing/provider/targets"
+	"github.com/daytonaio/daytona/internal/testing/server/workspaces"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/scheduler"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/lifecycle"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/stretchr/testify/require"
+)
+
+type projectStopper struct {
+	mutex   sync.Mutex
+	stopped []string
+}
+
+func (s *projectStopper) StopProject(ctx context.Context, workspaceId string, projectName string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.stopped = append(s.stopped, workspaceId+"/"+projectName)
+	return nil
+}
+
+func newProject(name string, state lifecycle.State, idleFor time.Duration) *project.Project {
+	startedAt := time.Now().Add(-24 * time.Hour)
+
+	return &project.Project{
+		Name:   name,
+		Target: "local",
+		Lifecycle: lifecycle.Lifecycle{
+			State:         state,
+			LastStartedAt: &startedAt,
+		},
+		State: &project.ProjectState{
+			LastActivity: time.Now().Add(-idleFor).Format(time.RFC1123),
+		},
+	}
+}
+
+func TestAutoStopper(t *testing.T) {
+	workspaceStore := workspaces.NewInMemoryWorkspaceStore()
+	targetStore := targets.NewInMemoryTargetStore()
+
+	require.Nil(t, targetStore.Save(&provider.ProviderTarget{Name: "local", AutoStop: 60}))
+
+	require.Nil(t, workspaceStore.Save(&workspace.Workspace{
+		Id:     "ws1",
+		Name:   "ws1",
+		Target: "local",
+		Projects: []*project.Project{
+			newProject("idle", lifecycle.StateStarted, 2*time.Hour),
+			newProject("active", lifecycle.StateStarted, 5*time.Minute),
+			newProject("stopped", lifecycle.StateStopped, 2*time.Hour),
+			{Name: "legacy-agent", Target: "local", Lifecycle: lifecycle.Lifecycle{State: lifecycle.StateStarted}, State: &project.ProjectState{}},
+		},
+	}))
+
+	require.Nil(t, workspaceStore.Save(&workspace.Workspace{
+		Id:       "ws2",
+		Name:     "ws2",
+		Target:   "local",
+		AutoStop: 1,
+		Projects: []*project.Project{
+			newProject("overridden", lifecycle.StateStarted, 5*time.Minute),
+		},
+	}))
+
+	stopper := &projectStopper{}
+
+	autoStopper := scheduler.NewAutoStopper(scheduler.AutoStopperConfig{
+		WorkspaceStore: workspaceStore,
+		TargetStore:    targetStore,
+		ProjectStopper: stopper,
+	})
+
+	autoStopper.StopIdleProjects()
+
+	require.ElementsMatch(t, []string{"ws1/idle", "ws2/overridden"}, stopper.stopped)
+}
+
+func TestAutoStopper_RecentlyStarted(t *testing.T) {
+	workspaceStore := workspaces.NewInMemoryWorkspaceStore()
+	targetStore := targets.NewInMemoryTargetStore()
+
+	require.Nil(t, targetStore.Save(&provider.ProviderTarget{Name: "local", AutoStop: 30}))
+
+	p := newProject("restarted", lifecycle.StateStarted, 2*time.Hour)
+	startedAt := time.Now()
+	p.Lifecycle.LastStartedAt = &startedAt
+
+	require.Nil(t, workspaceStore.Save(&workspace.Workspace{
+		Id:       "ws1",
+		Name:     "ws1",
+		Target:   "local",
+		Projects: []*project.Project{p},
+	}))
+
+	stopper := &projectStopper{}
+
+	scheduler.NewAutoStopper(scheduler.AutoStopperConfig{
+		WorkspaceStore: workspaceStore,
+		TargetStore:    targetStore,
+		ProjectStopper: stopper,
+	}).StopIdleProjects()
+
+	require.Empty(t, stopper.stopped)
+}
@@ -0,0 +1,166 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/workspace"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const DEFAULT_EXPIRY_INTERVAL = "0 * * * * *"
+
+// Time before the expiry at which a warning is logged
+const DEFAULT_EXPIRY_WARNING_PERIOD = time.Hour
+
+type WorkspaceRemover interface {
+	RemoveWorkspace(ctx context.Context, workspaceId string) error
+}
+
+type ApiKeyPurger interface {
+	PurgeExpired() (int, error)
+}
+
+type ExpiryEnforcerConfig struct {
+	Interval         string
+	Scheduler        IScheduler
+	WorkspaceStore   workspace.Store
+	WorkspaceRemover WorkspaceRemover
+	WarningPeriod    time.Duration
+	ApiKeyPurger     ApiKeyPurger
+}
+
+// ExpiryEnforcer periodically removes workspaces whose expiry has passed and expired API keys
+type ExpiryEnforcer struct {
+	interval         string
+	scheduler        IScheduler
+	workspaceStore   workspace.Store
+	workspaceRemover WorkspaceRemover
+	warningPeriod    time.Duration
+	apiKeyPurger     ApiKeyPurger
+	// Expiry times for which a warning was already logged, keyed by workspace id
+	warned   map[string]time.Time
+	removing map[string]bool
+	mutex    sync.Mutex
+}
+
+func NewExpiryEnforcer(config ExpiryEnforcerConfig) *ExpiryEnforcer {
+	return &ExpiryEnforcer{
+		interval:         config.Interval,
+		scheduler:        config.Scheduler,
+		workspaceStore:   config.WorkspaceStore,
+		workspaceRemover: config.WorkspaceRemover,
+		warningPeriod:    config.WarningPeriod,
+		apiKeyPurger:     config.ApiKeyPurger,
+		warned:           map[string]time.Time{},
+		removing:         map[string]bool{},
+	}
+}
+
+func (e *ExpiryEnforcer) Start() error {
+	err := e.scheduler.AddFunc(e.interval, func() {
+		e.RemoveExpiredWorkspaces()
+		e.PurgeExpiredApiKeys()
+	})
+	if err != nil {
+		return err
+	}
+
+	e.scheduler.Start()
+	return nil
+}
+
+func (e *ExpiryEnforcer) Stop() {
+	e.scheduler.Stop()
+}
+
+func (e *ExpiryEnforcer) RemoveExpiredWorkspaces() {
+	workspaces, err := e.workspaceStore.List()
+	if err != nil {
+		log.Error(err)
+		return
+	}
+
+	wg := sync.WaitGroup{}
+
+	for _, w := range workspaces {
+		if w.ExpiresAt == nil {
+			continue
+		}
+
+		expiresIn := time.Until(*w.ExpiresAt)
+
+		if expiresIn > 0 {
+			if expiresIn <= e.warningPeriod {
+				e.warn(w)
+			}
+			continue
+		}
+
+		wg.Add(1)
+		go func(w *workspace.Workspace) {
+			defer wg.Done()
+			e.removeWorkspace(w)
+		}(w)
+	}
+
+	wg.Wait()
+}
+
+// PurgeExpiredApiKeys removes the expired API keys, e.g. rotated keys whose grace period has ended
+func (e *ExpiryEnforcer) PurgeExpiredApiKeys() {
+	if e.apiKeyPurger == nil {
+		return
+	}
+
+	purged, err := e.apiKeyPurger.PurgeExpired()
+	if err != nil {
+		log.Errorf("failed to purge expired API keys: %s", err)
+		return
+	}
+
+	if purged > 0 {
+		log.Debugf("Purged %d expired API keys", purged)
+	}
+}
+
+func (e *ExpiryEnforcer) warn(w *workspace.Workspace) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if warnedAt, ok := e.warned[w.Id]; ok && warnedAt.Equal(*w.ExpiresAt) {
+		return
+	}
+	e.warned[w.Id] = *w.ExpiresAt
+
+	log.Warnf("Workspace %s expires at %s and will be removed", w.Name, w.ExpiresAt.Format(time.RFC1123))
+}
+
+func (e *ExpiryEnforcer) removeWorkspace(w *workspace.Workspace) {
+	e.mutex.Lock()
+	if e.removing[w.Id] {
+		e.mutex.Unlock()
+		return
+	}
+	e.removing[w.Id] = true
+	e.mutex.Unlock()
+
+	defer func() {
+		e.mutex.Lock()
+		delete(e.removing, w.Id)
+		delete(e.warned, w.Id)
+		e.mutex.Unlock()
+	}()
+
+	log.Infof("Removing expired workspace %s", w.Name)
+
+	err := e.workspaceRemover.RemoveWorkspace(context.Background(), w.Id)
+	if err != nil {
+		log.Errorf("failed to remove expired workspace %s: %s", w.Name, err)
+	}
+}
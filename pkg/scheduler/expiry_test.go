@@ -0,0 +1,85 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduler_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/testing/server/workspaces"
+	"github.com/daytonaio/daytona/pkg/scheduler"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/stretchr/testify/require"
+)
+
+type workspaceRemover struct {
+	mutex   sync.Mutex
+	removed []string
+}
+
+func (r *workspaceRemover) RemoveWorkspace(ctx context.Context, workspaceId string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.removed = append(r.removed, workspaceId)
+	return nil
+}
+
+func TestExpiryEnforcer(t *testing.T) {
+	workspaceStore := workspaces.NewInMemoryWorkspaceStore()
+
+	expired := time.Now().Add(-time.Minute)
+	expiringSoon := time.Now().Add(30 * time.Minute)
+	expiringLater := time.Now().Add(48 * time.Hour)
+
+	for _, w := range []*workspace.Workspace{
+		{Id: "expired", Name: "expired", ExpiresAt: &expired},
+		{Id: "expiring-soon", Name: "expiring-soon", ExpiresAt: &expiringSoon},
+		{Id: "expiring-later", Name: "expiring-later", ExpiresAt: &expiringLater},
+		{Id: "no-expiry", Name: "no-expiry"},
+	} {
+		require.Nil(t, workspaceStore.Save(w))
+	}
+
+	remover := &workspaceRemover{}
+
+	scheduler.NewExpiryEnforcer(scheduler.ExpiryEnforcerConfig{
+		WorkspaceStore:   workspaceStore,
+		WorkspaceRemover: remover,
+		WarningPeriod:    time.Hour,
+	}).RemoveExpiredWorkspaces()
+
+	require.Equal(t, []string{"expired"}, remover.removed)
+}
+
+type apiKeyPurger struct {
+	purged int
+}
+
+func (p *apiKeyPurger) PurgeExpired() (int, error) {
+	p.purged++
+	return 1, nil
+}
+
+func TestExpiryEnforcer_PurgeExpiredApiKeys(t *testing.T) {
+	purger := &apiKeyPurger{}
+
+	scheduler.NewExpiryEnforcer(scheduler.ExpiryEnforcerConfig{
+		WorkspaceStore:   workspaces.NewInMemoryWorkspaceStore(),
+		WorkspaceRemover: &workspaceRemover{},
+		ApiKeyPurger:     purger,
+	}).PurgeExpiredApiKeys()
+
+	require.Equal(t, 1, purger.purged)
+
+	// Enforcers without a purger only remove workspaces
+	scheduler.NewExpiryEnforcer(scheduler.ExpiryEnforcerConfig{
+		WorkspaceStore:   workspaces.NewInMemoryWorkspaceStore(),
+		WorkspaceRemover: &workspaceRemover{},
+	}).PurgeExpiredApiKeys()
+}
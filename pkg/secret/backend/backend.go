@@ -0,0 +1,57 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package backend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Backend reads secrets from an external secret store
+type Backend interface {
+	// GetSecret returns the value of the key stored at the path
+	GetSecret(ctx context.Context, path, key string) (string, error)
+}
+
+var (
+	ErrSecretNotFound   = errors.New("secret not found")
+	ErrInvalidReference = errors.New("invalid secret reference, expected <scheme>://<path>#<key>")
+)
+
+func IsSecretNotFound(err error) bool {
+	return err.Error() == ErrSecretNotFound.Error()
+}
+
+// Reference points to a key of a secret kept in a backend, e.g. vault://secret/myapp#password
+type Reference struct {
+	Scheme string
+	Path   string
+	Key    string
+}
+
+func (r Reference) String() string {
+	return fmt.Sprintf("%s://%s#%s", r.Scheme, r.Path, r.Key)
+}
+
+// ParseReference parses values of the form <scheme>://<path>#<key>
+func ParseReference(value string) (*Reference, error) {
+	u, err := url.Parse(value)
+	if err != nil || u.Scheme == "" {
+		return nil, ErrInvalidReference
+	}
+
+	path := strings.Trim(u.Host+u.Path, "/")
+	if path == "" || u.Fragment == "" {
+		return nil, ErrInvalidReference
+	}
+
+	return &Reference{
+		Scheme: u.Scheme,
+		Path:   path,
+		Key:    u.Fragment,
+	}, nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package backend
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+type IResolver interface {
+	// ResolveEnvVars returns a copy of the env vars with the secret references replaced by the secret values.
+	// Values that do not start with the scheme of a registered backend are copied as they are
+	ResolveEnvVars(ctx context.Context, envVars map[string]string) (map[string]string, error)
+	// ResolvedValues returns the secret values resolved so far so that they can be masked in logs
+	ResolvedValues() ([]string, error)
+}
+
+type ResolverConfig struct {
+	// Backends keyed by the scheme of the references they resolve
+	Backends map[string]Backend
+}
+
+type Resolver struct {
+	backends map[string]Backend
+
+	resolvedValues map[string]bool
+	mutex          sync.Mutex
+}
+
+func NewResolver(config ResolverConfig) IResolver {
+	return &Resolver{
+		backends:       config.Backends,
+		resolvedValues: map[string]bool{},
+	}
+}
+
+func (r *Resolver) ResolveEnvVars(ctx context.Context, envVars map[string]string) (map[string]string, error) {
+	if envVars == nil {
+		return nil, nil
+	}
+
+	resolved := map[string]string{}
+
+	for name, value := range envVars {
+		backend := r.getBackend(value)
+		if backend == nil {
+			resolved[name] = value
+			continue
+		}
+
+		ref, err := ParseReference(value)
+		if err != nil {
+			return nil, fmt.Errorf("env var %s: %w", name, err)
+		}
+
+		secret, err := backend.GetSecret(ctx, ref.Path, ref.Key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve env var %s from %s: %w", name, ref, err)
+		}
+
+		resolved[name] = secret
+
+		r.mutex.Lock()
+		r.resolvedValues[secret] = true
+		r.mutex.Unlock()
+	}
+
+	return resolved, nil
+}
+
+func (r *Resolver) ResolvedValues() ([]string, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	values := []string{}
+	for value := range r.resolvedValues {
+		values = append(values, value)
+	}
+
+	return values, nil
+}
+
+func (r *Resolver) getBackend(value string) Backend {
+	for scheme, backend := range r.backends {
+		if strings.HasPrefix(value, scheme+"://") {
+			return backend
+		}
+	}
+
+	return nil
+}
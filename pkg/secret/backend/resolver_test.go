@@ -0,0 +1,75 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package backend_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/secret/backend"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseReference(t *testing.T) {
+	ref, err := backend.ParseReference("vault://secret/myapp/db#password")
+	require.Nil(t, err)
+	require.Equal(t, backend.Reference{Scheme: "vault", Path: "secret/myapp/db", Key: "password"}, *ref)
+
+	_, err = backend.ParseReference("vault://secret/myapp")
+	require.ErrorIs(t, err, backend.ErrInvalidReference)
+
+	_, err = backend.ParseReference("vault://#password")
+	require.ErrorIs(t, err, backend.ErrInvalidReference)
+}
+
+func TestResolveEnvVars(t *testing.T) {
+	server := newVaultServer(t)
+	defer server.Close()
+
+	resolver := backend.NewResolver(backend.ResolverConfig{
+		Backends: map[string]backend.Backend{
+			backend.SchemeVault: backend.NewVaultBackend(backend.VaultBackendConfig{
+				Address: server.URL,
+				Token:   testVaultToken,
+			}),
+		},
+	})
+
+	t.Run("ResolveReferences", func(t *testing.T) {
+		envVars := map[string]string{
+			"DB_PASSWORD": "vault://secret/myapp#password",
+			"DB_HOST":     "localhost",
+			"DOCS_URL":    "https://example.com/docs#setup",
+		}
+
+		resolved, err := resolver.ResolveEnvVars(context.Background(), envVars)
+		require.Nil(t, err)
+		require.Equal(t, map[string]string{
+			"DB_PASSWORD": "hunter2",
+			"DB_HOST":     "localhost",
+			"DOCS_URL":    "https://example.com/docs#setup",
+		}, resolved)
+
+		// The references are kept in the original env vars
+		require.Equal(t, "vault://secret/myapp#password", envVars["DB_PASSWORD"])
+
+		values, err := resolver.ResolvedValues()
+		require.Nil(t, err)
+		require.Equal(t, []string{"hunter2"}, values)
+	})
+
+	t.Run("ResolveMissingSecret", func(t *testing.T) {
+		_, err := resolver.ResolveEnvVars(context.Background(), map[string]string{
+			"DB_USER": "vault://secret/myapp#username",
+		})
+		require.ErrorIs(t, err, backend.ErrSecretNotFound)
+	})
+
+	t.Run("ResolveInvalidReference", func(t *testing.T) {
+		_, err := resolver.ResolveEnvVars(context.Background(), map[string]string{
+			"DB_PASSWORD": "vault://secret/myapp",
+		})
+		require.ErrorIs(t, err, backend.ErrInvalidReference)
+	})
+}
@@ -0,0 +1,116 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package backend
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const SchemeVault = "vault"
+
+// VaultBackendConfig configures the access to a Vault KV version 2 secrets engine.
+// The server reads it from the VAULT_ADDR, VAULT_TOKEN and VAULT_NAMESPACE environment variables
+type VaultBackendConfig struct {
+	Address   string
+	Token     string
+	Namespace string
+	// Defaults to a client with a 10 second timeout
+	HttpClient *http.Client
+}
+
+// VaultBackend reads secrets from a Vault KV version 2 secrets engine over HTTP.
+// The first segment of a secret path is the mount of the secrets engine,
+// e.g. vault://secret/myapp#password reads the password key of the myapp secret in the secret mount
+type VaultBackend struct {
+	address    string
+	token      string
+	namespace  string
+	httpClient *http.Client
+}
+
+func NewVaultBackend(config VaultBackendConfig) Backend {
+	httpClient := config.HttpClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	return &VaultBackend{
+		address:    strings.TrimSuffix(config.Address, "/"),
+		token:      config.Token,
+		namespace:  config.Namespace,
+		httpClient: httpClient,
+	}
+}
+
+type vaultKvResponse struct {
+	Data struct {
+		Data map[string]json.RawMessage `json:"data"`
+	} `json:"data"`
+}
+
+func (v *VaultBackend) GetSecret(ctx context.Context, path, key string) (string, error) {
+	if v.address == "" {
+		return "", errors.New("the Vault address is not configured, set VAULT_ADDR before starting the server")
+	}
+
+	mount, secretPath, ok := strings.Cut(path, "/")
+	if !ok || secretPath == "" {
+		return "", fmt.Errorf("%w: the path must start with the mount of the secrets engine", ErrInvalidReference)
+	}
+
+	endpoint, err := url.JoinPath(v.address, "v1", mount, "data", secretPath)
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return "", err
+	}
+
+	req.Header.Set("X-Vault-Token", v.token)
+	if v.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", v.namespace)
+	}
+
+	res, err := v.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return "", ErrSecretNotFound
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("vault responded with status code %d", res.StatusCode)
+	}
+
+	var kvResponse vaultKvResponse
+	err = json.NewDecoder(res.Body).Decode(&kvResponse)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode the vault response: %w", err)
+	}
+
+	value, ok := kvResponse.Data.Data[key]
+	if !ok {
+		return "", ErrSecretNotFound
+	}
+
+	// Values that are not strings are returned as JSON
+	var stringValue string
+	if json.Unmarshal(value, &stringValue) == nil {
+		return stringValue, nil
+	}
+
+	return string(value), nil
+}
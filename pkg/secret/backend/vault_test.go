@@ -0,0 +1,84 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package backend_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/secret/backend"
+	"github.com/stretchr/testify/require"
+)
+
+const testVaultToken = "test-token"
+
+// newVaultServer returns a stand-in for the Vault KV version 2 API with a single secret at secret/myapp
+func newVaultServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != testVaultToken {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		if r.URL.Path != "/v1/secret/data/myapp" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"data":{"data":{"password":"hunter2","port":5432},"metadata":{"version":3}}}`))
+		require.Nil(t, err)
+	}))
+}
+
+func TestVaultBackend(t *testing.T) {
+	server := newVaultServer(t)
+	defer server.Close()
+
+	vault := backend.NewVaultBackend(backend.VaultBackendConfig{
+		Address: server.URL,
+		Token:   testVaultToken,
+	})
+
+	t.Run("GetSecret", func(t *testing.T) {
+		value, err := vault.GetSecret(context.Background(), "secret/myapp", "password")
+		require.Nil(t, err)
+		require.Equal(t, "hunter2", value)
+	})
+
+	t.Run("GetNonStringSecret", func(t *testing.T) {
+		value, err := vault.GetSecret(context.Background(), "secret/myapp", "port")
+		require.Nil(t, err)
+		require.Equal(t, "5432", value)
+	})
+
+	t.Run("GetMissingKey", func(t *testing.T) {
+		_, err := vault.GetSecret(context.Background(), "secret/myapp", "username")
+		require.True(t, backend.IsSecretNotFound(err))
+	})
+
+	t.Run("GetMissingPath", func(t *testing.T) {
+		_, err := vault.GetSecret(context.Background(), "secret/other", "password")
+		require.True(t, backend.IsSecretNotFound(err))
+	})
+
+	t.Run("GetWithInvalidToken", func(t *testing.T) {
+		vault := backend.NewVaultBackend(backend.VaultBackendConfig{
+			Address: server.URL,
+			Token:   "invalid",
+		})
+
+		_, err := vault.GetSecret(context.Background(), "secret/myapp", "password")
+		require.ErrorContains(t, err, "403")
+	})
+
+	t.Run("GetWithoutAddress", func(t *testing.T) {
+		vault := backend.NewVaultBackend(backend.VaultBackendConfig{})
+
+		_, err := vault.GetSecret(context.Background(), "secret/myapp", "password")
+		require.NotNil(t, err)
+	})
+}
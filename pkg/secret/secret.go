@@ -0,0 +1,48 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secret
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/logs"
+)
+
+// Secret is a named credential that projects reference by name.
+// The value is never returned by the API
+type Secret struct {
+	Name      string    `json:"name" validate:"required"`
+	Value     string    `json:"-"`
+	UpdatedAt time.Time `json:"updatedAt" validate:"required"`
+} // @name Secret
+
+// MinValueLength is the length of the shortest value that is masked in logs
+const MinValueLength = logs.MinMaskedValueLength
+
+var (
+	ErrInvalidSecretName = errors.New("secret names can only contain letters, digits and underscores and can not start with a digit")
+	ErrSecretTooShort    = fmt.Errorf("secret values must be at least %d characters long", MinValueLength)
+)
+
+// Secrets are injected as environment variables so their names have to be valid variable names
+var validName = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+func ValidateName(name string) error {
+	if !validName.MatchString(name) {
+		return ErrInvalidSecretName
+	}
+
+	return nil
+}
+
+func ValidateValue(value string) error {
+	if len(value) < MinValueLength {
+		return ErrSecretTooShort
+	}
+
+	return nil
+}
@@ -4,6 +4,9 @@
 package apikeys
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/daytonaio/daytona/internal/apikeys"
 	"github.com/daytonaio/daytona/pkg/apikey"
 )
@@ -35,18 +38,84 @@ func (s *ApiKeyService) Revoke(name string) error {
 }
 
 func (s *ApiKeyService) Generate(keyType apikey.ApiKeyType, name string) (string, error) {
-	key := apikeys.GenerateRandomKey()
+	return s.GenerateWithRole(keyType, name, apikey.GetDefaultRole(keyType), nil)
+}
+
+func (s *ApiKeyService) GenerateWithRole(keyType apikey.ApiKeyType, name string, role apikey.ApiKeyRole, expiresAt *time.Time) (string, error) {
+	key, apiKey, err := newApiKey(keyType, name, role, expiresAt)
+	if err != nil {
+		return "", err
+	}
+
+	err = s.apiKeyStore.Save(apiKey)
+	if err != nil {
+		return "", err
+	}
+
+	return key, nil
+}
+
+func (s *ApiKeyService) Rotate(name string, gracePeriod time.Duration, expiresAt *time.Time) (string, error) {
+	oldKey, err := s.apiKeyStore.FindByName(name)
+	if err != nil {
+		return "", err
+	}
+
+	key, newKey, err := newApiKey(oldKey.Type, name, oldKey.Role, expiresAt)
+	if err != nil {
+		return "", err
+	}
 
-	apiKey := &apikey.ApiKey{
-		KeyHash: apikeys.HashKey(key),
-		Type:    keyType,
-		Name:    name,
+	// Without a grace period the old key expires immediately and is purged with the other expired keys
+	graceExpiresAt := time.Now().Add(max(gracePeriod, 0))
+	if oldKey.ExpiresAt == nil || graceExpiresAt.Before(*oldKey.ExpiresAt) {
+		oldKey.ExpiresAt = &graceExpiresAt
 	}
+	// Frees the name for the new key
+	oldKey.Name = fmt.Sprintf("%s-rotated-%d", name, time.Now().Unix())
 
-	err := s.apiKeyStore.Save(apiKey)
+	err = s.apiKeyStore.Rotate(oldKey, newKey)
 	if err != nil {
 		return "", err
 	}
 
 	return key, nil
 }
+
+func (s *ApiKeyService) PurgeExpired() (int, error) {
+	keys, err := s.apiKeyStore.List()
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for _, key := range keys {
+		if !key.IsExpired() {
+			continue
+		}
+
+		err = s.apiKeyStore.Delete(key)
+		if err != nil {
+			return purged, err
+		}
+		purged++
+	}
+
+	return purged, nil
+}
+
+func newApiKey(keyType apikey.ApiKeyType, name string, role apikey.ApiKeyRole, expiresAt *time.Time) (string, *apikey.ApiKey, error) {
+	if !role.IsValidFor(keyType) {
+		return "", nil, apikey.ErrInvalidRole
+	}
+
+	key := apikeys.GenerateRandomKey()
+
+	return key, &apikey.ApiKey{
+		KeyHash:   apikeys.HashKey(key),
+		Type:      keyType,
+		Role:      role,
+		Name:      name,
+		ExpiresAt: expiresAt,
+	}, nil
+}
@@ -3,7 +3,11 @@
 
 package apikeys_test
 
-import "github.com/daytonaio/daytona/pkg/apikey"
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/apikey"
+)
 
 func (s *ApiKeyServiceTestSuite) TestListClientKeys() {
 	expectedKeys := []*apikey.ApiKey{}
@@ -70,3 +74,110 @@ func (s *ApiKeyServiceTestSuite) TestGenerate() {
 	require.Nil(err)
 	require.ElementsMatch(expectedKeys, apiKeys)
 }
+
+func (s *ApiKeyServiceTestSuite) TestGenerateWithRole() {
+	keyName := "developer"
+
+	require := s.Require()
+
+	_, err := s.apiKeyService.GenerateWithRole(apikey.ApiKeyTypeClient, keyName, apikey.ApiKeyRoleDeveloper, nil)
+	require.Nil(err)
+
+	apiKey, err := s.apiKeyStore.FindByName(keyName)
+	require.Nil(err)
+	require.Equal(apikey.ApiKeyRoleDeveloper, apiKey.Role)
+
+	_, err = s.apiKeyService.GenerateWithRole(apikey.ApiKeyTypeClient, "project-role", apikey.ApiKeyRoleProject, nil)
+	require.ErrorIs(err, apikey.ErrInvalidRole)
+}
+
+func (s *ApiKeyServiceTestSuite) TestGenerate_DefaultRole() {
+	require := s.Require()
+
+	clientKey, err := s.apiKeyStore.FindByName(clientKeyNames[0])
+	require.Nil(err)
+	require.Equal(apikey.ApiKeyRoleAdmin, clientKey.Role)
+
+	projectKey, err := s.apiKeyStore.FindByName(projectKeyNames[0])
+	require.Nil(err)
+	require.Equal(apikey.ApiKeyRoleProject, projectKey.Role)
+}
+
+func (s *ApiKeyServiceTestSuite) TestRotate() {
+	keyName := "rotated"
+
+	require := s.Require()
+
+	oldKey, err := s.apiKeyService.GenerateWithRole(apikey.ApiKeyTypeClient, keyName, apikey.ApiKeyRoleDeveloper, nil)
+	require.Nil(err)
+
+	newKey, err := s.apiKeyService.Rotate(keyName, time.Hour, nil)
+	require.Nil(err)
+	require.NotEqual(oldKey, newKey)
+
+	apiKey, err := s.apiKeyService.Authenticate(newKey)
+	require.Nil(err)
+	require.Equal(keyName, apiKey.Name)
+	require.Equal(apikey.ApiKeyRoleDeveloper, apiKey.Role)
+	require.Nil(apiKey.ExpiresAt)
+
+	rotatedKey, err := s.apiKeyService.Authenticate(oldKey)
+	require.Nil(err)
+	require.NotEqual(keyName, rotatedKey.Name)
+	require.NotNil(rotatedKey.ExpiresAt)
+	require.WithinDuration(time.Now().Add(time.Hour), *rotatedKey.ExpiresAt, time.Minute)
+}
+
+func (s *ApiKeyServiceTestSuite) TestRotate_NoGracePeriod() {
+	keyName := "rotated"
+
+	require := s.Require()
+
+	oldKey, err := s.apiKeyService.Generate(apikey.ApiKeyTypeClient, keyName)
+	require.Nil(err)
+
+	newKey, err := s.apiKeyService.Rotate(keyName, 0, nil)
+	require.Nil(err)
+
+	require.False(s.apiKeyService.IsValidApiKey(oldKey))
+	require.True(s.apiKeyService.IsValidApiKey(newKey))
+
+	_, err = s.apiKeyService.Rotate("unknown", time.Hour, nil)
+	require.True(apikey.IsApiKeyNotFound(err))
+}
+
+func (s *ApiKeyServiceTestSuite) TestPurgeExpired() {
+	require := s.Require()
+
+	expiredAt := time.Now().Add(-time.Minute)
+	_, err := s.apiKeyService.GenerateWithRole(apikey.ApiKeyTypeClient, "expired", apikey.ApiKeyRoleReadOnly, &expiredAt)
+	require.Nil(err)
+
+	_, err = s.apiKeyService.Generate(apikey.ApiKeyTypeClient, "rotated")
+	require.Nil(err)
+	_, err = s.apiKeyService.Rotate("rotated", 0, nil)
+	require.Nil(err)
+
+	_, err = s.apiKeyService.Generate(apikey.ApiKeyTypeClient, "in-grace-period")
+	require.Nil(err)
+	_, err = s.apiKeyService.Rotate("in-grace-period", time.Hour, nil)
+	require.Nil(err)
+
+	keys, err := s.apiKeyStore.List()
+	require.Nil(err)
+
+	purged, err := s.apiKeyService.PurgeExpired()
+	require.Nil(err)
+	require.Equal(2, purged)
+
+	remainingKeys, err := s.apiKeyStore.List()
+	require.Nil(err)
+	require.Len(remainingKeys, len(keys)-2)
+
+	for _, key := range remainingKeys {
+		require.False(key.IsExpired())
+	}
+
+	_, err = s.apiKeyStore.FindByName("expired")
+	require.True(apikey.IsApiKeyNotFound(err))
+}
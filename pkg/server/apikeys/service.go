@@ -3,13 +3,28 @@
 
 package apikeys
 
-import "github.com/daytonaio/daytona/pkg/apikey"
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/apikey"
+)
 
 type IApiKeyService interface {
+	// Generate generates a key with the default role of the key type
 	Generate(keyType apikey.ApiKeyType, name string) (string, error)
+	// GenerateWithRole generates a key that expires at expiresAt, or never if it is nil
+	GenerateWithRole(keyType apikey.ApiKeyType, name string, role apikey.ApiKeyRole, expiresAt *time.Time) (string, error)
+	// Rotate generates a replacement for the key with the given name. The replaced key is renamed
+	// and stays valid for the grace period so that its users can switch to the new key
+	Rotate(name string, gracePeriod time.Duration, expiresAt *time.Time) (string, error)
+	// PurgeExpired removes the expired keys, including rotated keys whose grace period has ended,
+	// and returns how many were removed
+	PurgeExpired() (int, error)
 	IsProjectApiKey(apiKey string) bool
 	IsWorkspaceApiKey(apiKey string) bool
 	IsValidApiKey(apiKey string) bool
+	// Authenticate returns the key if it is valid and records its use
+	Authenticate(apiKey string) (*apikey.ApiKey, error)
 	ListClientKeys() ([]*apikey.ApiKey, error)
 	Revoke(name string) error
 }
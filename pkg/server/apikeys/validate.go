@@ -4,21 +4,41 @@
 package apikeys
 
 import (
+	"time"
+
 	"github.com/daytonaio/daytona/internal/apikeys"
 	"github.com/daytonaio/daytona/pkg/apikey"
+	log "github.com/sirupsen/logrus"
 )
 
-func (s *ApiKeyService) IsValidApiKey(apiKey string) bool {
-	keyHash := apikeys.HashKey(apiKey)
+// Limits how often the last use of a key is written to the store
+const lastUsedUpdateInterval = time.Minute
 
-	_, err := s.apiKeyStore.Find(keyHash)
+func (s *ApiKeyService) IsValidApiKey(apiKey string) bool {
+	_, err := s.findValidKey(apiKey)
 	return err == nil
 }
 
-func (s *ApiKeyService) IsProjectApiKey(apiKey string) bool {
-	keyHash := apikeys.HashKey(apiKey)
+func (s *ApiKeyService) Authenticate(apiKey string) (*apikey.ApiKey, error) {
+	key, err := s.findValidKey(apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= lastUsedUpdateInterval {
+		key.LastUsedAt = &now
+		err = s.apiKeyStore.Save(key)
+		if err != nil {
+			log.Errorf("failed to update last use of api key %s: %s", key.Name, err)
+		}
+	}
+
+	return key, nil
+}
 
-	key, err := s.apiKeyStore.Find(keyHash)
+func (s *ApiKeyService) IsProjectApiKey(apiKey string) bool {
+	key, err := s.findValidKey(apiKey)
 	if err != nil {
 		return false
 	}
@@ -31,9 +51,7 @@ func (s *ApiKeyService) IsProjectApiKey(apiKey string) bool {
 }
 
 func (s *ApiKeyService) IsWorkspaceApiKey(apiKey string) bool {
-	keyHash := apikeys.HashKey(apiKey)
-
-	key, err := s.apiKeyStore.Find(keyHash)
+	key, err := s.findValidKey(apiKey)
 	if err != nil {
 		return false
 	}
@@ -44,3 +62,16 @@ func (s *ApiKeyService) IsWorkspaceApiKey(apiKey string) bool {
 
 	return true
 }
+
+func (s *ApiKeyService) findValidKey(apiKey string) (*apikey.ApiKey, error) {
+	key, err := s.apiKeyStore.Find(apikeys.HashKey(apiKey))
+	if err != nil {
+		return nil, err
+	}
+
+	if key.IsExpired() {
+		return nil, apikey.ErrApiKeyExpired
+	}
+
+	return key, nil
+}
@@ -3,7 +3,11 @@
 
 package apikeys_test
 
-import "github.com/daytonaio/daytona/pkg/apikey"
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/apikey"
+)
 
 func (s *ApiKeyServiceTestSuite) TestIsValidKey_True() {
 	keyName := "api-key"
@@ -73,3 +77,33 @@ func (s *ApiKeyServiceTestSuite) TestIsWorkspaceApiKey_False() {
 	res := s.apiKeyService.IsWorkspaceApiKey(apiKey)
 	require.False(res)
 }
+
+func (s *ApiKeyServiceTestSuite) TestAuthenticate() {
+	keyName := "clientKey"
+
+	require := s.Require()
+
+	key, err := s.apiKeyService.Generate(apikey.ApiKeyTypeClient, keyName)
+	require.Nil(err)
+
+	apiKey, err := s.apiKeyService.Authenticate(key)
+	require.Nil(err)
+	require.Equal(keyName, apiKey.Name)
+	require.Equal(apikey.ApiKeyTypeClient, apiKey.Type)
+	require.NotNil(apiKey.LastUsedAt)
+
+	_, err = s.apiKeyService.Authenticate("unknown")
+	require.True(apikey.IsApiKeyNotFound(err))
+}
+
+func (s *ApiKeyServiceTestSuite) TestAuthenticate_Expired() {
+	require := s.Require()
+
+	expiresAt := time.Now().Add(-time.Minute)
+	key, err := s.apiKeyService.GenerateWithRole(apikey.ApiKeyTypeClient, "expired", apikey.ApiKeyRoleAdmin, &expiresAt)
+	require.Nil(err)
+
+	_, err = s.apiKeyService.Authenticate(key)
+	require.True(apikey.IsApiKeyExpired(err))
+	require.False(s.apiKeyService.IsValidApiKey(key))
+}
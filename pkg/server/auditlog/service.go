@@ -0,0 +1,64 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package auditlog
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/audit"
+	"github.com/docker/docker/pkg/stringid"
+)
+
+type IAuditLogService interface {
+	// Save assigns an ID and a timestamp to the record if they are not set and stores it
+	Save(record *audit.Record) error
+	List(filter *audit.Filter) ([]*audit.Record, error)
+	// Prune removes the records older than the retention and returns how many were removed
+	Prune(retention time.Duration) (int, error)
+}
+
+type AuditLogServiceConfig struct {
+	AuditStore audit.Store
+}
+
+func NewAuditLogService(config AuditLogServiceConfig) IAuditLogService {
+	return &AuditLogService{
+		auditStore: config.AuditStore,
+	}
+}
+
+type AuditLogService struct {
+	auditStore audit.Store
+}
+
+func (s *AuditLogService) Save(record *audit.Record) error {
+	if record.Id == "" {
+		record.Id = stringid.TruncateID(stringid.GenerateRandomID())
+	}
+
+	if record.Timestamp.IsZero() {
+		record.Timestamp = time.Now()
+	}
+
+	if record.Result == "" {
+		record.Result = audit.ResultSuccess
+		if record.StatusCode >= 400 {
+			record.Result = audit.ResultFailure
+		}
+	}
+
+	return s.auditStore.Save(record)
+}
+
+func (s *AuditLogService) List(filter *audit.Filter) ([]*audit.Record, error) {
+	return s.auditStore.List(filter)
+}
+
+func (s *AuditLogService) Prune(retention time.Duration) (int, error) {
+	if retention <= 0 {
+		return 0, audit.ErrInvalidRetention
+	}
+
+	return s.auditStore.DeleteBefore(time.Now().Add(-retention))
+}
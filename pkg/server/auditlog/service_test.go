@@ -0,0 +1,113 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package auditlog_test
+
+import (
+	"testing"
+	"time"
+
+	t_auditlog "github.com/daytonaio/daytona/internal/testing/server/auditlog"
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/audit"
+	"github.com/daytonaio/daytona/pkg/server/auditlog"
+	"github.com/stretchr/testify/suite"
+)
+
+type AuditLogServiceTestSuite struct {
+	suite.Suite
+	auditLogService auditlog.IAuditLogService
+}
+
+func NewAuditLogServiceTestSuite() *AuditLogServiceTestSuite {
+	return &AuditLogServiceTestSuite{}
+}
+
+func (s *AuditLogServiceTestSuite) SetupTest() {
+	s.auditLogService = auditlog.NewAuditLogService(auditlog.AuditLogServiceConfig{
+		AuditStore: t_auditlog.NewInMemoryAuditStore(),
+	})
+}
+
+func TestAuditLogService(t *testing.T) {
+	suite.Run(t, NewAuditLogServiceTestSuite())
+}
+
+func (s *AuditLogServiceTestSuite) TestSave() {
+	require := s.Require()
+
+	record := &audit.Record{
+		KeyName:    "default",
+		KeyType:    apikey.ApiKeyTypeClient,
+		Method:     "DELETE",
+		Route:      "/workspace/:workspaceId",
+		Resource:   "/workspace/123",
+		StatusCode: 404,
+	}
+
+	err := s.auditLogService.Save(record)
+	require.Nil(err)
+
+	require.NotEmpty(record.Id)
+	require.False(record.Timestamp.IsZero())
+	require.Equal(audit.ResultFailure, record.Result)
+
+	records, err := s.auditLogService.List(nil)
+	require.Nil(err)
+	require.Equal([]*audit.Record{record}, records)
+}
+
+func (s *AuditLogServiceTestSuite) TestList() {
+	require := s.Require()
+
+	now := time.Now()
+
+	old := &audit.Record{KeyName: "default", Resource: "/workspace/123", StatusCode: 200, Timestamp: now.Add(-2 * time.Hour)}
+	recent := &audit.Record{KeyName: "ci", Resource: "/workspace/123/project/start", StatusCode: 200, Timestamp: now.Add(-time.Minute)}
+	other := &audit.Record{KeyName: "default", Resource: "/apikey/ci", StatusCode: 200, Timestamp: now}
+
+	for _, r := range []*audit.Record{old, recent, other} {
+		require.Nil(s.auditLogService.Save(r))
+	}
+
+	records, err := s.auditLogService.List(nil)
+	require.Nil(err)
+	require.Equal([]*audit.Record{other, recent, old}, records)
+
+	since := now.Add(-time.Hour)
+	records, err = s.auditLogService.List(&audit.Filter{Since: &since})
+	require.Nil(err)
+	require.Equal([]*audit.Record{other, recent}, records)
+
+	keyName := "default"
+	records, err = s.auditLogService.List(&audit.Filter{KeyName: &keyName})
+	require.Nil(err)
+	require.Equal([]*audit.Record{other, old}, records)
+
+	resource := "/workspace/123"
+	records, err = s.auditLogService.List(&audit.Filter{Resource: &resource, KeyName: &keyName})
+	require.Nil(err)
+	require.Equal([]*audit.Record{old}, records)
+}
+
+func (s *AuditLogServiceTestSuite) TestPrune() {
+	require := s.Require()
+
+	old := &audit.Record{KeyName: "default", StatusCode: 200, Timestamp: time.Now().Add(-48 * time.Hour)}
+	recent := &audit.Record{KeyName: audit.AnonymousKeyName, StatusCode: 401, Timestamp: time.Now()}
+
+	for _, r := range []*audit.Record{old, recent} {
+		require.Nil(s.auditLogService.Save(r))
+	}
+
+	_, err := s.auditLogService.Prune(0)
+	require.Equal(audit.ErrInvalidRetention, err)
+
+	deleted, err := s.auditLogService.Prune(24 * time.Hour)
+	require.Nil(err)
+	require.Equal(1, deleted)
+
+	records, err := s.auditLogService.List(nil)
+	require.Nil(err)
+	require.Equal([]*audit.Record{recent}, records)
+}
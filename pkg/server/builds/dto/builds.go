@@ -15,4 +15,6 @@ type BuildCreationData struct {
 	Repository  *gitprovider.GitRepository `json:"repository" validate:"optional"`
 	EnvVars     map[string]string          `json:"envVars" validate:"required"`
 	PrebuildId  string                     `json:"prebuildId" validate:"required"`
+	Priority    int                        `json:"priority" validate:"required"`
+	MaxRetries  *int                       `json:"maxRetries,omitempty" validate:"optional"`
 } // @name BuildCreationData
@@ -5,6 +5,7 @@ package builds
 
 import (
 	"errors"
+	"fmt"
 	"io"
 	"time"
 
@@ -14,11 +15,18 @@ import (
 	"github.com/docker/docker/pkg/stringid"
 )
 
+var (
+	ErrBuildNotCancellable = errors.New("only pending and running builds can be cancelled")
+	ErrBuildNotRetryable   = errors.New("only failed and cancelled builds can be retried")
+)
+
 type IBuildService interface {
 	Create(dto.BuildCreationData) (string, error)
 	Find(filter *build.Filter) (*build.Build, error)
 	List(filter *build.Filter) ([]*build.Build, error)
 	MarkForDeletion(filter *build.Filter) []error
+	Cancel(id string) error
+	Retry(id string) error
 	Delete(id string) error
 	AwaitEmptyList(time.Duration) error
 	GetBuildLogReader(buildId string) (io.Reader, error)
@@ -55,6 +63,8 @@ func (s *BuildService) Create(b dto.BuildCreationData) (string, error) {
 	newBuild.Repository = b.Repository
 	newBuild.EnvVars = b.EnvVars
 	newBuild.PrebuildId = b.PrebuildId
+	newBuild.Priority = b.Priority
+	newBuild.MaxRetries = b.MaxRetries
 
 	err := s.buildStore.Save(&newBuild)
 	if err != nil {
@@ -65,11 +75,43 @@ func (s *BuildService) Create(b dto.BuildCreationData) (string, error) {
 }
 
 func (s *BuildService) Find(filter *build.Filter) (*build.Build, error) {
-	return s.buildStore.Find(filter)
+	b, err := s.buildStore.Find(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.setQueuePositions([]*build.Build{b})
+	if err != nil {
+		return nil, err
+	}
+
+	return b, nil
 }
 
 func (s *BuildService) List(filter *build.Filter) ([]*build.Build, error) {
-	return s.buildStore.List(filter)
+	builds, err := s.buildStore.List(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.setQueuePositions(builds)
+	if err != nil {
+		return nil, err
+	}
+
+	return builds, nil
+}
+
+func (s *BuildService) setQueuePositions(builds []*build.Build) error {
+	pendingBuilds, err := s.buildStore.List(&build.Filter{
+		States: &[]build.BuildState{build.BuildStatePendingRun},
+	})
+	if err != nil {
+		return err
+	}
+
+	build.SetQueuePositions(builds, build.GetBuildQueue(pendingBuilds))
+	return nil
 }
 
 func (s *BuildService) MarkForDeletion(filter *build.Filter) []error {
@@ -91,6 +133,54 @@ func (s *BuildService) MarkForDeletion(filter *build.Filter) []error {
 	return errors
 }
 
+// Cancel marks a pending or running build as cancelled. The build runner tears down running builds once it picks up the change.
+func (s *BuildService) Cancel(id string) error {
+	b, err := s.buildStore.Find(&build.Filter{
+		Id: &id,
+	})
+	if err != nil {
+		return err
+	}
+
+	if b.State != build.BuildStatePendingRun && b.State != build.BuildStateRunning {
+		return fmt.Errorf("%w: build is in state %s", ErrBuildNotCancellable, b.State)
+	}
+
+	b.State = build.BuildStateCancelled
+	// The build runner might have changed the state since it was read
+	err = s.buildStore.SaveIfState(b, []build.BuildState{build.BuildStatePendingRun, build.BuildStateRunning})
+	if err != nil && build.IsBuildStateChanged(err) {
+		return fmt.Errorf("%w: %w", ErrBuildNotCancellable, err)
+	}
+
+	return err
+}
+
+// Retry re-queues a failed or cancelled build with a fresh set of automatic retries
+func (s *BuildService) Retry(id string) error {
+	b, err := s.buildStore.Find(&build.Filter{
+		Id: &id,
+	})
+	if err != nil {
+		return err
+	}
+
+	if b.State != build.BuildStateError && b.State != build.BuildStateCancelled {
+		return fmt.Errorf("%w: build is in state %s", ErrBuildNotRetryable, b.State)
+	}
+
+	b.State = build.BuildStatePendingRun
+	b.Priority = build.BuildPriorityHigh
+	b.RetryCount = 0
+	b.NextRetryAt = nil
+	err = s.buildStore.SaveIfState(b, []build.BuildState{build.BuildStateError, build.BuildStateCancelled})
+	if err != nil && build.IsBuildStateChanged(err) {
+		return fmt.Errorf("%w: %w", ErrBuildNotRetryable, err)
+	}
+
+	return err
+}
+
 func (s *BuildService) Delete(id string) error {
 	return s.buildStore.Delete(id)
 }
@@ -5,6 +5,7 @@ package builds_test
 
 import (
 	"testing"
+	"time"
 
 	build_internal "github.com/daytonaio/daytona/internal/testing/build"
 	"github.com/daytonaio/daytona/pkg/build"
@@ -181,3 +182,66 @@ func (s *BuildServiceTestSuite) TestDelete() {
 	require.Nil(err)
 	require.ElementsMatch(expectedBuilds, builds)
 }
+
+func (s *BuildServiceTestSuite) TestCancel() {
+	require := s.Require()
+
+	runningBuild := &build.Build{
+		Id:    "id5",
+		Image: "image5",
+		User:  "user5",
+		Repository: &gitprovider.GitRepository{
+			Sha: "sha5",
+		},
+		State: build.BuildStateRunning,
+	}
+	err := s.buildStore.Save(runningBuild)
+	require.Nil(err)
+
+	err = s.buildService.Cancel(runningBuild.Id)
+	require.Nil(err)
+
+	b, err := s.buildService.Find(&build.Filter{
+		Id: &runningBuild.Id,
+	})
+	require.Nil(err)
+	require.Equal(build.BuildStateCancelled, b.State)
+
+	err = s.buildService.Cancel(build1.Id)
+	require.ErrorIs(err, builds.ErrBuildNotCancellable)
+}
+
+func (s *BuildServiceTestSuite) TestRetry() {
+	require := s.Require()
+
+	nextRetryAt := time.Now().Add(time.Minute)
+	failedBuild := &build.Build{
+		Id:    "id6",
+		Image: "image6",
+		User:  "user6",
+		Repository: &gitprovider.GitRepository{
+			Sha: "sha6",
+		},
+		State:       build.BuildStateError,
+		Priority:    build.BuildPriorityLow,
+		RetryCount:  3,
+		NextRetryAt: &nextRetryAt,
+	}
+	err := s.buildStore.Save(failedBuild)
+	require.Nil(err)
+
+	err = s.buildService.Retry(failedBuild.Id)
+	require.Nil(err)
+
+	b, err := s.buildService.Find(&build.Filter{
+		Id: &failedBuild.Id,
+	})
+	require.Nil(err)
+	require.Equal(build.BuildStatePendingRun, b.State)
+	require.Equal(build.BuildPriorityHigh, b.Priority)
+	require.Equal(0, b.RetryCount)
+	require.Nil(b.NextRetryAt)
+
+	err = s.buildService.Retry(build1.Id)
+	require.ErrorIs(err, builds.ErrBuildNotRetryable)
+}
@@ -30,6 +30,10 @@ const defaultLocalBuilderRegistryPort = 3988
 const defaultLocalBuilderRegistryImage = "registry:2.8.3"
 const defaultBuilderRegistryServer = "local"
 const defaultBuildImageNamespace = ""
+const defaultBuildTimeout = 60
+const defaultMaxConcurrentBuilds = 4
+const defaultMaxBuildRetries = 3
+const defaultAuditRetentionDays = 90
 
 var us_defaultFrpsConfig = FRPSConfig{
 	Domain:   "try-us.daytona.app",
@@ -119,7 +123,11 @@ func getDefaultConfig() (*Config, error) {
 		LocalBuilderRegistryImage: defaultLocalBuilderRegistryImage,
 		BuilderRegistryServer:     defaultBuilderRegistryServer,
 		BuildImageNamespace:       defaultBuildImageNamespace,
+		BuildTimeout:              defaultBuildTimeout,
+		MaxConcurrentBuilds:       defaultMaxConcurrentBuilds,
+		MaxBuildRetries:           defaultMaxBuildRetries,
 		SamplesIndexUrl:           defaultSamplesIndexUrl,
+		AuditRetentionDays:        defaultAuditRetentionDays,
 	}
 
 	if os.Getenv("DEFAULT_REGISTRY_URL") != "" {
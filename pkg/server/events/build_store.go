@@ -0,0 +1,62 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"github.com/daytonaio/daytona/pkg/build"
+)
+
+// BuildStore wraps a build store and publishes an event whenever a saved build changes state
+type BuildStore struct {
+	build.Store
+	eventBus IEventBus
+}
+
+func NewBuildStore(store build.Store, eventBus IEventBus) build.Store {
+	if eventBus == nil {
+		return store
+	}
+
+	return &BuildStore{
+		Store:    store,
+		eventBus: eventBus,
+	}
+}
+
+func (s *BuildStore) Save(b *build.Build) error {
+	return s.save(b, func() error {
+		return s.Store.Save(b)
+	})
+}
+
+func (s *BuildStore) SaveIfState(b *build.Build, states []build.BuildState) error {
+	return s.save(b, func() error {
+		return s.Store.SaveIfState(b, states)
+	})
+}
+
+func (s *BuildStore) save(b *build.Build, save func() error) error {
+	var previousState build.BuildState
+
+	// A failed lookup means the build is new, so any saved state counts as a change
+	existing, err := s.Store.Find(&build.Filter{Id: &b.Id})
+	if err == nil {
+		previousState = existing.State
+	}
+
+	err = save()
+	if err != nil {
+		return err
+	}
+
+	if previousState != b.State {
+		s.eventBus.Publish(Event{
+			Type:    EventTypeBuildStateChanged,
+			BuildId: b.Id,
+			State:   string(b.State),
+		})
+	}
+
+	return nil
+}
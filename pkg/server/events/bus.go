@@ -0,0 +1,90 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"sync"
+	"time"
+
+	"github.com/docker/docker/pkg/stringid"
+	log "github.com/sirupsen/logrus"
+)
+
+// Subscribers that fall this many events behind start dropping events instead of blocking publishers
+const subscriberBufferSize = 64
+
+type IEventBus interface {
+	Publish(event Event)
+	Subscribe(filter *EventFilter) (<-chan Event, func())
+}
+
+type subscriber struct {
+	filter *EventFilter
+	ch     chan Event
+}
+
+type EventBus struct {
+	subscribers map[int]*subscriber
+	nextId      int
+	mutex       sync.RWMutex
+}
+
+func NewEventBus() *EventBus {
+	return &EventBus{
+		subscribers: map[int]*subscriber{},
+	}
+}
+
+func (b *EventBus) Publish(event Event) {
+	if event.Id == "" {
+		event.Id = stringid.TruncateID(stringid.GenerateRandomID())
+	}
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	for _, s := range b.subscribers {
+		if !s.filter.Match(event) {
+			continue
+		}
+
+		select {
+		case s.ch <- event:
+		default:
+			log.Debugf("Dropping event %s for a slow subscriber", event.Type)
+		}
+	}
+}
+
+// Subscribe returns a channel receiving the events matching the filter and a function that
+// removes the subscription and closes the channel
+func (b *EventBus) Subscribe(filter *EventFilter) (<-chan Event, func()) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	id := b.nextId
+	b.nextId++
+
+	s := &subscriber{
+		filter: filter,
+		ch:     make(chan Event, subscriberBufferSize),
+	}
+	b.subscribers[id] = s
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			b.mutex.Lock()
+			defer b.mutex.Unlock()
+
+			delete(b.subscribers, id)
+			close(s.ch)
+		})
+	}
+
+	return s.ch, unsubscribe
+}
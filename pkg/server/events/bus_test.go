@@ -0,0 +1,82 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package events_test
+
+import (
+	"testing"
+	"time"
+
+	t_build "github.com/daytonaio/daytona/internal/testing/build"
+	"github.com/daytonaio/daytona/pkg/build"
+	"github.com/daytonaio/daytona/pkg/server/events"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventBus(t *testing.T) {
+	bus := events.NewEventBus()
+
+	workspaceId := "workspace1"
+	all, unsubscribeAll := bus.Subscribe(nil)
+	filtered, unsubscribeFiltered := bus.Subscribe(&events.EventFilter{
+		Types:       []events.EventType{events.EventTypeWorkspaceStarted},
+		WorkspaceId: &workspaceId,
+	})
+	defer unsubscribeFiltered()
+
+	bus.Publish(events.Event{Type: events.EventTypeWorkspaceStopped, WorkspaceId: workspaceId})
+	bus.Publish(events.Event{Type: events.EventTypeWorkspaceStarted, WorkspaceId: "workspace2"})
+	bus.Publish(events.Event{Type: events.EventTypeWorkspaceStarted, WorkspaceId: workspaceId})
+
+	require.Equal(t, events.EventTypeWorkspaceStopped, receive(t, all).Type)
+	require.Equal(t, "workspace2", receive(t, all).WorkspaceId)
+	require.Equal(t, workspaceId, receive(t, all).WorkspaceId)
+
+	event := receive(t, filtered)
+	require.Equal(t, events.EventTypeWorkspaceStarted, event.Type)
+	require.Equal(t, workspaceId, event.WorkspaceId)
+	require.NotEmpty(t, event.Id)
+	require.False(t, event.Timestamp.IsZero())
+	require.Empty(t, filtered)
+
+	unsubscribeAll()
+	_, ok := <-all
+	require.False(t, ok)
+
+	// Publishing after unsubscribing must not panic on the closed channel
+	bus.Publish(events.Event{Type: events.EventTypeWorkspaceRemoved})
+}
+
+func TestBuildStore(t *testing.T) {
+	bus := events.NewEventBus()
+	store := events.NewBuildStore(t_build.NewInMemoryBuildStore(), bus)
+
+	eventCh, unsubscribe := bus.Subscribe(&events.EventFilter{
+		Types: []events.EventType{events.EventTypeBuildStateChanged},
+	})
+	defer unsubscribe()
+
+	require.Nil(t, store.Save(&build.Build{Id: "1", State: build.BuildStatePendingRun}))
+	require.Nil(t, store.Save(&build.Build{Id: "1", State: build.BuildStatePendingRun, Image: "image"}))
+	require.Nil(t, store.Save(&build.Build{Id: "1", State: build.BuildStateRunning}))
+
+	event := receive(t, eventCh)
+	require.Equal(t, "1", event.BuildId)
+	require.Equal(t, string(build.BuildStatePendingRun), event.State)
+
+	event = receive(t, eventCh)
+	require.Equal(t, string(build.BuildStateRunning), event.State)
+	require.Empty(t, eventCh)
+}
+
+func receive(t *testing.T, ch <-chan events.Event) events.Event {
+	select {
+	case event := <-ch:
+		return event
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for event")
+		return events.Event{}
+	}
+}
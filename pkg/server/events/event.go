@@ -0,0 +1,59 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"slices"
+	"time"
+)
+
+type EventType string // @name EventType
+
+const (
+	EventTypeWorkspaceCreated    EventType = "workspace.created"
+	EventTypeWorkspaceStarted    EventType = "workspace.started"
+	EventTypeWorkspaceStopped    EventType = "workspace.stopped"
+	EventTypeWorkspaceRemoved    EventType = "workspace.removed"
+	EventTypeWorkspaceError      EventType = "workspace.error"
+	EventTypeProjectStateUpdated EventType = "project.state.updated"
+	EventTypeBuildStateChanged   EventType = "build.state.changed"
+	EventTypeWebhookTest         EventType = "webhook.test"
+)
+
+type Event struct {
+	Id          string    `json:"id" validate:"required"`
+	Type        EventType `json:"type" validate:"required"`
+	Timestamp   time.Time `json:"timestamp" validate:"required"`
+	WorkspaceId string    `json:"workspaceId,omitempty" validate:"optional"`
+	ProjectName string    `json:"projectName,omitempty" validate:"optional"`
+	BuildId     string    `json:"buildId,omitempty" validate:"optional"`
+	State       string    `json:"state,omitempty" validate:"optional"`
+	Error       string    `json:"error,omitempty" validate:"optional"`
+} // @name Event
+
+type EventFilter struct {
+	Types       []EventType
+	WorkspaceId *string
+	BuildId     *string
+}
+
+func (f *EventFilter) Match(e Event) bool {
+	if f == nil {
+		return true
+	}
+
+	if len(f.Types) > 0 && !slices.Contains(f.Types, e.Type) {
+		return false
+	}
+
+	if f.WorkspaceId != nil && *f.WorkspaceId != e.WorkspaceId {
+		return false
+	}
+
+	if f.BuildId != nil && *f.BuildId != e.BuildId {
+		return false
+	}
+
+	return true
+}
@@ -80,16 +80,12 @@ func (s *GitProviderService) GetConfigForUrl(repoUrl string) (*gitprovider.GitPr
 	}
 
 	for _, p := range gitProviders {
-		p.Token = url.QueryEscape(p.Token)
-		p.Username = url.QueryEscape(p.Username)
-
 		if p.Id == "aws-codecommit" && strings.Contains(repoUrl, "git-codecommit") {
-			return p, nil
+			return s.escapeConfigCredentials(p)
 		}
 
 		if strings.Contains(repoUrl, fmt.Sprintf("%s.", p.Id)) {
-
-			return p, nil
+			return s.escapeConfigCredentials(p)
 		}
 
 		if p.BaseApiUrl == nil || *p.BaseApiUrl == "" {
@@ -102,7 +98,7 @@ func (s *GitProviderService) GetConfigForUrl(repoUrl string) (*gitprovider.GitPr
 		}
 
 		if p.BaseApiUrl != nil && strings.Contains(repoUrl, hostname) {
-			return p, nil
+			return s.escapeConfigCredentials(p)
 		}
 	}
 
@@ -140,9 +136,15 @@ func (s *GitProviderService) GetGitProviderForHttpRequest(req *http.Request) (gi
 		return nil, errors.New("git provider for HTTP request not found")
 	}
 
+	err = s.ensureValidToken(provider)
+	if err != nil {
+		return nil, err
+	}
+
 	return s.newGitProvider(provider)
 }
 
+// SetGitProviderConfig validates the token against the provider and records its scopes and expiry before saving
 func (s *GitProviderService) SetGitProviderConfig(providerConfig *gitprovider.GitProviderConfig) error {
 	gitProvider, err := s.newGitProvider(providerConfig)
 	if err != nil {
@@ -151,13 +153,40 @@ func (s *GitProviderService) SetGitProviderConfig(providerConfig *gitprovider.Gi
 
 	userData, err := gitProvider.GetUser()
 	if err != nil {
-		return err
+		return fmt.Errorf("token validation failed: %w", err)
 	}
 	providerConfig.Username = userData.Username
 
+	tokenInfo, err := gitProvider.GetTokenInfo()
+	if err != nil {
+		return fmt.Errorf("failed to get token info: %w", err)
+	}
+
+	providerConfig.TokenScopes = tokenInfo.Scopes
+	if tokenInfo.ExpiresAt != nil {
+		providerConfig.TokenExpiresAt = tokenInfo.ExpiresAt
+	}
+
+	if providerConfig.IsTokenExpired() {
+		return gitprovider.ErrTokenExpired
+	}
+
 	return s.configStore.Save(providerConfig)
 }
 
+// escapeConfigCredentials returns the config with a valid token, with the credentials escaped for use in clone URLs
+func (s *GitProviderService) escapeConfigCredentials(providerConfig *gitprovider.GitProviderConfig) (*gitprovider.GitProviderConfig, error) {
+	err := s.ensureValidToken(providerConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	providerConfig.Token = url.QueryEscape(providerConfig.Token)
+	providerConfig.Username = url.QueryEscape(providerConfig.Username)
+
+	return providerConfig, nil
+}
+
 func getHostnameFromUrl(urlToParse string) (string, error) {
 	parsed, err := url.Parse(urlToParse)
 	if err != nil {
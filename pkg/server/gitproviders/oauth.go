@@ -0,0 +1,67 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package gitproviders
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"golang.org/x/oauth2"
+)
+
+func (s *GitProviderService) StartOAuthDeviceFlow(gitProviderId string, baseApiUrl *string) (*gitprovider.OAuthDeviceCode, error) {
+	oauthConfig, err := s.getOAuthConfig(gitProviderId, baseApiUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	deviceAuth, err := oauthConfig.DeviceAuth(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to request a device code: %w", err)
+	}
+
+	return &gitprovider.OAuthDeviceCode{
+		DeviceCode:      deviceAuth.DeviceCode,
+		UserCode:        deviceAuth.UserCode,
+		VerificationUri: deviceAuth.VerificationURI,
+		ExpiresAt:       deviceAuth.Expiry,
+		Interval:        deviceAuth.Interval,
+	}, nil
+}
+
+// CompleteOAuthDeviceFlow polls the provider until the user authorizes the device code,
+// then saves the obtained tokens as the git provider config
+func (s *GitProviderService) CompleteOAuthDeviceFlow(ctx context.Context, gitProviderId string, baseApiUrl *string, deviceCode *gitprovider.OAuthDeviceCode) error {
+	oauthConfig, err := s.getOAuthConfig(gitProviderId, baseApiUrl)
+	if err != nil {
+		return err
+	}
+
+	token, err := oauthConfig.DeviceAccessToken(ctx, &oauth2.DeviceAuthResponse{
+		DeviceCode: deviceCode.DeviceCode,
+		Expiry:     deviceCode.ExpiresAt,
+		Interval:   deviceCode.Interval,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to obtain an access token: %w", err)
+	}
+
+	providerConfig := &gitprovider.GitProviderConfig{
+		Id:         gitProviderId,
+		BaseApiUrl: baseApiUrl,
+	}
+	gitprovider.ApplyOAuthToken(providerConfig, token)
+
+	return s.SetGitProviderConfig(providerConfig)
+}
+
+func (s *GitProviderService) getOAuthConfig(gitProviderId string, baseApiUrl *string) (*oauth2.Config, error) {
+	app, ok := s.oauthApps[gitProviderId]
+	if !ok || app.ClientId == "" {
+		return nil, fmt.Errorf("no OAuth app is configured for %s", gitProviderId)
+	}
+
+	return gitprovider.GetOAuthConfig(gitProviderId, baseApiUrl, app)
+}
@@ -4,10 +4,12 @@
 package gitproviders
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
 	"strings"
+	"sync"
 
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 )
@@ -27,6 +29,9 @@ type IGitProviderService interface {
 	RemoveGitProvider(gitProviderId string) error
 	SetGitProviderConfig(providerConfig *gitprovider.GitProviderConfig) error
 	GetLastCommitSha(repo *gitprovider.GitRepository) (string, error)
+	GetTokenHealth(gitProviderId string) (*gitprovider.TokenHealth, error)
+	StartOAuthDeviceFlow(gitProviderId string, baseApiUrl *string) (*gitprovider.OAuthDeviceCode, error)
+	CompleteOAuthDeviceFlow(ctx context.Context, gitProviderId string, baseApiUrl *string, deviceCode *gitprovider.OAuthDeviceCode) error
 	RegisterPrebuildWebhook(gitProviderId string, repo *gitprovider.GitRepository, endpointUrl string) (string, error)
 	GetPrebuildWebhook(gitProviderId string, repo *gitprovider.GitRepository, endpointUrl string) (*string, error)
 	UnregisterPrebuildWebhook(gitProviderId string, repo *gitprovider.GitRepository, id string) error
@@ -34,15 +39,25 @@ type IGitProviderService interface {
 
 type GitProviderServiceConfig struct {
 	ConfigStore gitprovider.ConfigStore
+	// OAuth applications used for the device flow, keyed by git provider ID
+	OAuthApps map[string]gitprovider.OAuthApp
 }
 
 type GitProviderService struct {
 	configStore gitprovider.ConfigStore
+	oauthApps   map[string]gitprovider.OAuthApp
+
+	// newGitProvider creates the git provider client for a config, tests replace it with a mock
+	newGitProvider func(config *gitprovider.GitProviderConfig) (gitprovider.GitProvider, error)
+
+	refreshMutex sync.Mutex
 }
 
 func NewGitProviderService(config GitProviderServiceConfig) IGitProviderService {
 	return &GitProviderService{
-		configStore: config.ConfigStore,
+		configStore:    config.ConfigStore,
+		oauthApps:      config.OAuthApps,
+		newGitProvider: newGitProvider,
 	}
 }
 
@@ -64,6 +79,11 @@ func (s *GitProviderService) GetGitProvider(id string) (gitprovider.GitProvider,
 		}
 	}
 
+	err = s.ensureValidToken(providerConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	return s.newGitProvider(providerConfig)
 }
 
@@ -72,13 +92,23 @@ func (s *GitProviderService) ListConfigs() ([]*gitprovider.GitProviderConfig, er
 }
 
 func (s *GitProviderService) GetConfig(id string) (*gitprovider.GitProviderConfig, error) {
-	return s.configStore.Find(id)
+	providerConfig, err := s.configStore.Find(id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.ensureValidToken(providerConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return providerConfig, nil
 }
 
 func (s *GitProviderService) GetLastCommitSha(repo *gitprovider.GitRepository) (string, error) {
 	var err error
 	var provider gitprovider.GitProvider
-	providerFound := false
+	var providerId string
 
 	gitProviders, err := s.configStore.List()
 	if err != nil {
@@ -86,45 +116,40 @@ func (s *GitProviderService) GetLastCommitSha(repo *gitprovider.GitRepository) (
 	}
 
 	for _, p := range gitProviders {
-
 		isAwsUrl := strings.Contains(repo.Url, ".amazonaws.com/") || strings.Contains(repo.Url, ".console.aws.amazon.com/")
 		if p.Id == "aws-codecommit" && isAwsUrl {
-			provider, err = s.GetGitProvider(p.Id)
-			if err == nil {
-				return "", err
-			}
-			providerFound = true
+			providerId = p.Id
 			break
 		}
 
 		if strings.Contains(repo.Url, fmt.Sprintf("%s.", p.Id)) {
-			provider, err = s.GetGitProvider(p.Id)
-			if err == nil {
-				return "", err
-			}
-			providerFound = true
+			providerId = p.Id
 			break
 		}
 
+		if p.BaseApiUrl == nil || *p.BaseApiUrl == "" {
+			continue
+		}
+
 		hostname, err := getHostnameFromUrl(*p.BaseApiUrl)
 		if err != nil {
 			return "", err
 		}
 
-		if p.BaseApiUrl != nil && strings.Contains(repo.Url, hostname) {
-			provider, err = s.GetGitProvider(p.Id)
-			if err == nil {
-				return "", err
-			}
-			providerFound = true
+		if strings.Contains(repo.Url, hostname) {
+			providerId = p.Id
 			break
 		}
-
 	}
 
-	if !providerFound {
+	if providerId != "" {
+		provider, err = s.GetGitProvider(providerId)
+		if err != nil {
+			return "", err
+		}
+	} else {
 		hostname := strings.TrimPrefix(repo.Source, "www.")
-		providerId := strings.Split(hostname, ".")[0]
+		providerId = strings.Split(hostname, ".")[0]
 
 		provider, err = s.newGitProvider(&gitprovider.GitProviderConfig{
 			Id:         providerId,
@@ -137,7 +162,7 @@ func (s *GitProviderService) GetLastCommitSha(repo *gitprovider.GitRepository) (
 		}
 	}
 
-	return provider.GetLastCommitSha(&gitprovider.StaticGitContext{
+	sha, err := provider.GetLastCommitSha(&gitprovider.StaticGitContext{
 		Id:       repo.Id,
 		Url:      repo.Url,
 		Name:     repo.Name,
@@ -148,9 +173,14 @@ func (s *GitProviderService) GetLastCommitSha(repo *gitprovider.GitRepository) (
 		Source:   repo.Source,
 		Path:     repo.Path,
 	})
+	if err != nil {
+		return "", fmt.Errorf("failed to get last commit of %s from %s: %w", repo.Url, providerId, err)
+	}
+
+	return sha, nil
 }
 
-func (s *GitProviderService) newGitProvider(config *gitprovider.GitProviderConfig) (gitprovider.GitProvider, error) {
+func newGitProvider(config *gitprovider.GitProviderConfig) (gitprovider.GitProvider, error) {
 	switch config.Id {
 	case "github":
 		return gitprovider.NewGitHubGitProvider(config.Token, nil), nil
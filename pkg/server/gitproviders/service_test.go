@@ -0,0 +1,333 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package gitproviders
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	t_gitprovider "github.com/daytonaio/daytona/internal/testing/gitprovider"
+	"github.com/daytonaio/daytona/internal/testing/gitprovider/mocks"
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/stretchr/testify/require"
+)
+
+const gitProviderId = "github-enterprise-server"
+
+// tokenServer is a GitHub Enterprise Server OAuth token endpoint that counts the refreshes
+type tokenServer struct {
+	*httptest.Server
+	refreshes atomic.Int32
+	fail      bool
+}
+
+func newTokenServer(t *testing.T) *tokenServer {
+	s := &tokenServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/login/oauth/access_token" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		s.refreshes.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+
+		if s.fail || r.FormValue("refresh_token") != "refresh-token" {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "bad_refresh_token"})
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token":  "refreshed-token",
+			"refresh_token": "new-refresh-token",
+			"token_type":    "bearer",
+			"expires_in":    8 * 3600,
+		})
+	}))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func newTestService(gitProvider *mocks.MockGitProvider) *GitProviderService {
+	return &GitProviderService{
+		configStore: t_gitprovider.NewInMemoryGitProviderConfigStore(),
+		oauthApps: map[string]gitprovider.OAuthApp{
+			gitProviderId: {ClientId: "client-id", ClientSecret: "client-secret"},
+		},
+		newGitProvider: func(config *gitprovider.GitProviderConfig) (gitprovider.GitProvider, error) {
+			return gitProvider, nil
+		},
+	}
+}
+
+func newExpiringConfig(baseApiUrl string, expiresIn time.Duration) *gitprovider.GitProviderConfig {
+	expiresAt := time.Now().Add(expiresIn)
+	apiUrl := baseApiUrl + "/api/v3"
+
+	return &gitprovider.GitProviderConfig{
+		Id:             gitProviderId,
+		Username:       "user",
+		Token:          "token",
+		BaseApiUrl:     &apiUrl,
+		RefreshToken:   "refresh-token",
+		TokenExpiresAt: &expiresAt,
+	}
+}
+
+func TestSetGitProviderConfig(t *testing.T) {
+	expiresAt := time.Now().Add(30 * 24 * time.Hour)
+
+	t.Run("saves the username, scopes and expiry of the token", func(t *testing.T) {
+		gitProvider := &mocks.MockGitProvider{}
+		gitProvider.On("GetUser").Return(&gitprovider.GitUser{Username: "octocat"}, nil)
+		gitProvider.On("GetTokenInfo").Return(&gitprovider.TokenInfo{Scopes: []string{"repo"}, ExpiresAt: &expiresAt}, nil)
+		service := newTestService(gitProvider)
+
+		err := service.SetGitProviderConfig(&gitprovider.GitProviderConfig{Id: "github", Token: "token"})
+		require.NoError(t, err)
+
+		config, err := service.configStore.Find("github")
+		require.NoError(t, err)
+		require.Equal(t, "octocat", config.Username)
+		require.Equal(t, []string{"repo"}, config.TokenScopes)
+		require.Equal(t, expiresAt, *config.TokenExpiresAt)
+	})
+
+	t.Run("rejects tokens that fail validation", func(t *testing.T) {
+		gitProvider := &mocks.MockGitProvider{}
+		gitProvider.On("GetUser").Return((*gitprovider.GitUser)(nil), errors.New("401 Bad credentials"))
+		service := newTestService(gitProvider)
+
+		err := service.SetGitProviderConfig(&gitprovider.GitProviderConfig{Id: "github", Token: "token"})
+		require.ErrorContains(t, err, "token validation failed")
+
+		_, err = service.configStore.Find("github")
+		require.True(t, gitprovider.IsGitProviderNotFound(err))
+	})
+
+	t.Run("rejects expired tokens", func(t *testing.T) {
+		expiredAt := time.Now().Add(-time.Hour)
+
+		gitProvider := &mocks.MockGitProvider{}
+		gitProvider.On("GetUser").Return(&gitprovider.GitUser{Username: "octocat"}, nil)
+		gitProvider.On("GetTokenInfo").Return(&gitprovider.TokenInfo{ExpiresAt: &expiredAt}, nil)
+		service := newTestService(gitProvider)
+
+		err := service.SetGitProviderConfig(&gitprovider.GitProviderConfig{Id: "github", Token: "token"})
+		require.ErrorIs(t, err, gitprovider.ErrTokenExpired)
+
+		_, err = service.configStore.Find("github")
+		require.True(t, gitprovider.IsGitProviderNotFound(err))
+	})
+}
+
+func TestEnsureValidToken(t *testing.T) {
+	t.Run("keeps tokens that do not expire soon", func(t *testing.T) {
+		tokenServer := newTokenServer(t)
+		service := newTestService(&mocks.MockGitProvider{})
+
+		config := newExpiringConfig(tokenServer.URL, time.Hour)
+		require.NoError(t, service.ensureValidToken(config))
+		require.Equal(t, "token", config.Token)
+		require.Zero(t, tokenServer.refreshes.Load())
+	})
+
+	t.Run("refreshes tokens that are about to expire", func(t *testing.T) {
+		tokenServer := newTokenServer(t)
+		service := newTestService(&mocks.MockGitProvider{})
+
+		config := newExpiringConfig(tokenServer.URL, time.Minute)
+		require.NoError(t, service.configStore.Save(config))
+
+		require.NoError(t, service.ensureValidToken(config))
+		require.Equal(t, "refreshed-token", config.Token)
+		require.Equal(t, "new-refresh-token", config.RefreshToken)
+		require.True(t, time.Until(*config.TokenExpiresAt) > tokenRefreshWindow)
+
+		stored, err := service.configStore.Find(gitProviderId)
+		require.NoError(t, err)
+		require.Equal(t, "refreshed-token", stored.Token)
+		require.Equal(t, "new-refresh-token", stored.RefreshToken)
+	})
+
+	t.Run("fails when the refresh fails", func(t *testing.T) {
+		tokenServer := newTokenServer(t)
+		tokenServer.fail = true
+		service := newTestService(&mocks.MockGitProvider{})
+
+		config := newExpiringConfig(tokenServer.URL, time.Minute)
+		require.NoError(t, service.configStore.Save(config))
+
+		err := service.ensureValidToken(config)
+		require.ErrorContains(t, err, "failed to refresh")
+
+		stored, err := service.configStore.Find(gitProviderId)
+		require.NoError(t, err)
+		require.Equal(t, "token", stored.Token)
+	})
+
+	t.Run("fails for expired tokens that can not be refreshed", func(t *testing.T) {
+		service := newTestService(&mocks.MockGitProvider{})
+
+		config := newExpiringConfig("https://github.example.com", -time.Hour)
+		config.RefreshToken = ""
+
+		err := service.ensureValidToken(config)
+		require.ErrorIs(t, err, gitprovider.ErrTokenExpired)
+		require.ErrorContains(t, err, "daytona git-providers update")
+	})
+}
+
+func TestRefreshTokenOnce(t *testing.T) {
+	t.Run("refreshes concurrent requests once", func(t *testing.T) {
+		tokenServer := newTokenServer(t)
+		service := newTestService(&mocks.MockGitProvider{})
+		require.NoError(t, service.configStore.Save(newExpiringConfig(tokenServer.URL, time.Minute)))
+
+		tokens := make([]string, 10)
+		errs := make([]error, 10)
+
+		var wg sync.WaitGroup
+		for i := range tokens {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				// Every request read the config before it was refreshed
+				config := newExpiringConfig(tokenServer.URL, time.Minute)
+				errs[i] = service.ensureValidToken(config)
+				tokens[i] = config.Token
+			}(i)
+		}
+		wg.Wait()
+
+		for i := range tokens {
+			require.NoError(t, errs[i])
+			require.Equal(t, "refreshed-token", tokens[i])
+		}
+		require.Equal(t, int32(1), tokenServer.refreshes.Load())
+	})
+
+	t.Run("uses a token that was refreshed after the config was read", func(t *testing.T) {
+		tokenServer := newTokenServer(t)
+		service := newTestService(&mocks.MockGitProvider{})
+
+		refreshed := newExpiringConfig(tokenServer.URL, time.Hour)
+		refreshed.Token = "refreshed-token"
+		refreshed.RefreshToken = "new-refresh-token"
+		require.NoError(t, service.configStore.Save(refreshed))
+
+		config := newExpiringConfig(tokenServer.URL, time.Minute)
+		require.NoError(t, service.ensureValidToken(config))
+		require.Equal(t, "refreshed-token", config.Token)
+		require.Equal(t, "new-refresh-token", config.RefreshToken)
+		require.Zero(t, tokenServer.refreshes.Load())
+	})
+}
+
+func TestGetTokenHealth(t *testing.T) {
+	tokenServer := newTokenServer(t)
+	farExpiry := time.Now().Add(30 * 24 * time.Hour)
+	nearExpiry := time.Now().Add(24 * time.Hour)
+
+	tests := []struct {
+		name     string
+		config   *gitprovider.GitProviderConfig
+		setup    func(gitProvider *mocks.MockGitProvider)
+		expected gitprovider.TokenStatus
+		hasError bool
+	}{
+		{
+			name: "valid",
+			config: &gitprovider.GitProviderConfig{
+				Id:    "github",
+				Token: "token",
+			},
+			setup: func(gitProvider *mocks.MockGitProvider) {
+				gitProvider.On("GetUser").Return(&gitprovider.GitUser{Username: "octocat"}, nil)
+				gitProvider.On("GetTokenInfo").Return(&gitprovider.TokenInfo{ExpiresAt: &farExpiry}, nil)
+			},
+			expected: gitprovider.TokenStatusValid,
+		},
+		{
+			name: "expiring",
+			config: &gitprovider.GitProviderConfig{
+				Id:    "github",
+				Token: "token",
+			},
+			setup: func(gitProvider *mocks.MockGitProvider) {
+				gitProvider.On("GetUser").Return(&gitprovider.GitUser{Username: "octocat"}, nil)
+				gitProvider.On("GetTokenInfo").Return(&gitprovider.TokenInfo{ExpiresAt: &nearExpiry}, nil)
+			},
+			expected: gitprovider.TokenStatusExpiring,
+		},
+		{
+			name:   "refreshable tokens are not expiring",
+			config: newExpiringConfig(tokenServer.URL, 24*time.Hour),
+			setup: func(gitProvider *mocks.MockGitProvider) {
+				gitProvider.On("GetUser").Return(&gitprovider.GitUser{Username: "octocat"}, nil)
+				gitProvider.On("GetTokenInfo").Return(&gitprovider.TokenInfo{}, nil)
+			},
+			expected: gitprovider.TokenStatusValid,
+		},
+		{
+			name: "expired",
+			config: func() *gitprovider.GitProviderConfig {
+				config := newExpiringConfig(tokenServer.URL, -time.Hour)
+				config.RefreshToken = ""
+				return config
+			}(),
+			setup:    func(gitProvider *mocks.MockGitProvider) {},
+			expected: gitprovider.TokenStatusExpired,
+			hasError: true,
+		},
+		{
+			name: "invalid when the refresh fails",
+			config: func() *gitprovider.GitProviderConfig {
+				config := newExpiringConfig(tokenServer.URL, -time.Hour)
+				config.RefreshToken = "revoked-refresh-token"
+				return config
+			}(),
+			setup:    func(gitProvider *mocks.MockGitProvider) {},
+			expected: gitprovider.TokenStatusInvalid,
+			hasError: true,
+		},
+		{
+			name: "invalid when the provider rejects the token",
+			config: &gitprovider.GitProviderConfig{
+				Id:    "github",
+				Token: "token",
+			},
+			setup: func(gitProvider *mocks.MockGitProvider) {
+				gitProvider.On("GetUser").Return((*gitprovider.GitUser)(nil), errors.New("401 Bad credentials"))
+			},
+			expected: gitprovider.TokenStatusInvalid,
+			hasError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gitProvider := &mocks.MockGitProvider{}
+			tt.setup(gitProvider)
+			service := newTestService(gitProvider)
+			require.NoError(t, service.configStore.Save(tt.config))
+
+			health, err := service.GetTokenHealth(tt.config.Id)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, health.Status)
+			require.Equal(t, tt.hasError, health.Error != nil)
+			require.Equal(t, tt.config.RefreshToken != "", health.Refreshable)
+
+			gitProvider.AssertExpectations(t)
+		})
+	}
+}
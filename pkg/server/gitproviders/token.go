@@ -0,0 +1,129 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package gitproviders
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"golang.org/x/oauth2"
+)
+
+// Refreshable tokens are refreshed when they expire within this window
+const tokenRefreshWindow = 5 * time.Minute
+
+// Tokens that can not be refreshed are reported as expiring within this window
+const tokenExpiryWarningWindow = 7 * 24 * time.Hour
+
+func (s *GitProviderService) GetTokenHealth(gitProviderId string) (*gitprovider.TokenHealth, error) {
+	providerConfig, err := s.configStore.Find(gitProviderId)
+	if err != nil {
+		return nil, err
+	}
+
+	health := &gitprovider.TokenHealth{
+		Scopes:      providerConfig.TokenScopes,
+		ExpiresAt:   providerConfig.TokenExpiresAt,
+		Refreshable: providerConfig.RefreshToken != "",
+	}
+
+	err = s.ensureValidToken(providerConfig)
+	if err != nil {
+		health.Status = gitprovider.TokenStatusExpired
+		if health.Refreshable {
+			health.Status = gitprovider.TokenStatusInvalid
+		}
+		errMessage := err.Error()
+		health.Error = &errMessage
+		return health, nil
+	}
+
+	gitProvider, err := s.newGitProvider(providerConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = gitProvider.GetUser()
+	if err != nil {
+		health.Status = gitprovider.TokenStatusInvalid
+		errMessage := err.Error()
+		health.Error = &errMessage
+		return health, nil
+	}
+
+	tokenInfo, err := gitProvider.GetTokenInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tokenInfo.Scopes) > 0 {
+		health.Scopes = tokenInfo.Scopes
+	}
+	if tokenInfo.ExpiresAt != nil {
+		health.ExpiresAt = tokenInfo.ExpiresAt
+	}
+
+	health.Status = gitprovider.TokenStatusValid
+	if !health.Refreshable && health.ExpiresAt != nil && time.Until(*health.ExpiresAt) < tokenExpiryWarningWindow {
+		health.Status = gitprovider.TokenStatusExpiring
+	}
+
+	return health, nil
+}
+
+// ensureValidToken refreshes OAuth tokens that are about to expire and fails early with
+// an actionable error for expired tokens, instead of letting provider calls fail later
+func (s *GitProviderService) ensureValidToken(providerConfig *gitprovider.GitProviderConfig) error {
+	if providerConfig.RefreshToken != "" && providerConfig.TokenExpiresAt != nil && time.Until(*providerConfig.TokenExpiresAt) < tokenRefreshWindow {
+		err := s.refreshToken(providerConfig)
+		if err != nil {
+			return err
+		}
+	}
+
+	if providerConfig.IsTokenExpired() {
+		return fmt.Errorf("%w: the %s token expired on %s, run 'daytona git-providers update' to set a new one", gitprovider.ErrTokenExpired, providerConfig.Id, providerConfig.TokenExpiresAt.Format(time.RFC1123))
+	}
+
+	return nil
+}
+
+func (s *GitProviderService) refreshToken(providerConfig *gitprovider.GitProviderConfig) error {
+	s.refreshMutex.Lock()
+	defer s.refreshMutex.Unlock()
+
+	storedConfig, err := s.configStore.Find(providerConfig.Id)
+	if err != nil {
+		return err
+	}
+
+	// The token might have been refreshed while waiting for the lock.
+	// Refresh tokens are single use, so it must not be refreshed again
+	if storedConfig.TokenExpiresAt == nil || time.Until(*storedConfig.TokenExpiresAt) >= tokenRefreshWindow {
+		*providerConfig = *storedConfig
+		return nil
+	}
+
+	oauthConfig, err := s.getOAuthConfig(storedConfig.Id, storedConfig.BaseApiUrl)
+	if err != nil {
+		return fmt.Errorf("failed to refresh the %s token: %w", storedConfig.Id, err)
+	}
+
+	token, err := oauthConfig.TokenSource(context.Background(), &oauth2.Token{RefreshToken: storedConfig.RefreshToken}).Token()
+	if err != nil {
+		return fmt.Errorf("failed to refresh the %s token: %w", storedConfig.Id, err)
+	}
+
+	gitprovider.ApplyOAuthToken(storedConfig, token)
+
+	err = s.configStore.Save(storedConfig)
+	if err != nil {
+		return err
+	}
+
+	*providerConfig = *storedConfig
+	return nil
+}
@@ -0,0 +1,86 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/build"
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+)
+
+// Agents report their state every 2 seconds; an agent that has not reported for longer is considered disconnected
+const agentConnectionTimeout = 30 * time.Second
+
+var (
+	workspacesDesc = prometheus.NewDesc("daytona_workspaces", "Number of workspaces by state and target", []string{"state", "target"}, nil)
+	agentsDesc     = prometheus.NewDesc("daytona_agents_connected", "Number of project agents that recently reported their state", nil, nil)
+	buildQueueDesc = prometheus.NewDesc("daytona_build_queue_depth", "Number of builds waiting to run", nil, nil)
+)
+
+// metricsCollector reads the current state of the server's workspaces and builds when the metrics are scraped
+type metricsCollector struct {
+	server *Server
+}
+
+func (c *metricsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- workspacesDesc
+	ch <- agentsDesc
+	ch <- buildQueueDesc
+}
+
+func (c *metricsCollector) Collect(ch chan<- prometheus.Metric) {
+	c.collectWorkspaces(ch)
+	c.collectBuildQueue(ch)
+}
+
+func (c *metricsCollector) collectWorkspaces(ch chan<- prometheus.Metric) {
+	workspaces, err := c.server.WorkspaceService.ListWorkspaces(false)
+	if err != nil {
+		log.Errorf("failed to collect workspace metrics: %s", err)
+		return
+	}
+
+	type workspaceLabels struct {
+		state  string
+		target string
+	}
+
+	counts := map[workspaceLabels]int{}
+	agents := 0
+
+	for _, w := range workspaces {
+		counts[workspaceLabels{state: string(w.Lifecycle.State), target: w.Target}]++
+
+		for _, p := range w.Projects {
+			if p.State == nil {
+				continue
+			}
+
+			updatedAt, err := time.Parse(time.RFC1123, p.State.UpdatedAt)
+			if err == nil && time.Since(updatedAt) < agentConnectionTimeout {
+				agents++
+			}
+		}
+	}
+
+	for labels, count := range counts {
+		ch <- prometheus.MustNewConstMetric(workspacesDesc, prometheus.GaugeValue, float64(count), labels.state, labels.target)
+	}
+
+	ch <- prometheus.MustNewConstMetric(agentsDesc, prometheus.GaugeValue, float64(agents))
+}
+
+func (c *metricsCollector) collectBuildQueue(ch chan<- prometheus.Metric) {
+	builds, err := c.server.BuildService.List(&build.Filter{
+		States: &[]build.BuildState{build.BuildStatePendingRun},
+	})
+	if err != nil {
+		log.Errorf("failed to collect build metrics: %s", err)
+		return
+	}
+
+	ch <- prometheus.MustNewConstMetric(buildQueueDesc, prometheus.GaugeValue, float64(len(builds)))
+}
@@ -4,6 +4,7 @@
 package dto
 
 import (
+	"github.com/daytonaio/daytona/pkg/workspace/project"
 	"github.com/daytonaio/daytona/pkg/workspace/project/buildconfig"
 )
 
@@ -12,8 +13,10 @@ type CreateProjectConfigDTO struct {
 	Image         *string                  `json:"image,omitempty" validate:"optional"`
 	User          *string                  `json:"user,omitempty" validate:"optional"`
 	BuildConfig   *buildconfig.BuildConfig `json:"buildConfig,omitempty" validate:"optional"`
+	Resources     *project.ResourceLimits  `json:"resources,omitempty" validate:"optional"`
 	RepositoryUrl string                   `json:"repositoryUrl" validate:"required"`
 	EnvVars       map[string]string        `json:"envVars" validate:"required"`
+	Secrets       []string                 `json:"secrets,omitempty" validate:"optional"`
 } // @name CreateProjectConfigDTO
 
 type PrebuildDTO struct {
@@ -23,6 +26,7 @@ type PrebuildDTO struct {
 	CommitInterval    *int     `json:"commitInterval" validate:"optional"`
 	TriggerFiles      []string `json:"triggerFiles" validate:"optional"`
 	Retention         int      `json:"retention" validate:"required"`
+	MaxRetries        *int     `json:"maxRetries,omitempty" validate:"optional"`
 } // @name PrebuildDTO
 
 type CreatePrebuildDTO struct {
@@ -31,4 +35,5 @@ type CreatePrebuildDTO struct {
 	CommitInterval *int     `json:"commitInterval" validate:"optional"`
 	TriggerFiles   []string `json:"triggerFiles" validate:"optional"`
 	Retention      int      `json:"retention" validate:"required"`
+	MaxRetries     *int     `json:"maxRetries,omitempty" validate:"optional"`
 } // @name CreatePrebuildDTO
@@ -53,6 +53,7 @@ func (s *ProjectConfigService) SetPrebuild(projectConfigName string, createPrebu
 		CommitInterval: createPrebuildDto.CommitInterval,
 		TriggerFiles:   createPrebuildDto.TriggerFiles,
 		Retention:      createPrebuildDto.Retention,
+		MaxRetries:     createPrebuildDto.MaxRetries,
 	}
 
 	if createPrebuildDto.Id != nil {
@@ -103,6 +104,7 @@ func (s *ProjectConfigService) SetPrebuild(projectConfigName string, createPrebu
 		CommitInterval:    prebuild.CommitInterval,
 		TriggerFiles:      prebuild.TriggerFiles,
 		Retention:         prebuild.Retention,
+		MaxRetries:        prebuild.MaxRetries,
 	}, nil
 }
 
@@ -124,6 +126,7 @@ func (s *ProjectConfigService) FindPrebuild(projectConfigFilter *config.ProjectC
 		CommitInterval:    prebuild.CommitInterval,
 		TriggerFiles:      prebuild.TriggerFiles,
 		Retention:         prebuild.Retention,
+		MaxRetries:        prebuild.MaxRetries,
 	}, nil
 }
 
@@ -143,6 +146,7 @@ func (s *ProjectConfigService) ListPrebuilds(projectConfigFilter *config.Project
 				CommitInterval:    prebuild.CommitInterval,
 				TriggerFiles:      prebuild.TriggerFiles,
 				Retention:         prebuild.Retention,
+				MaxRetries:        prebuild.MaxRetries,
 			})
 		}
 	}
@@ -284,6 +288,7 @@ func (s *ProjectConfigService) ProcessGitEvent(data gitprovider.GitEventData) er
 				Repository:  repo,
 				EnvVars:     projectConfig.EnvVars,
 				PrebuildId:  prebuild.Id,
+				MaxRetries:  prebuild.MaxRetries,
 			})
 			continue
 		}
@@ -302,18 +307,21 @@ func (s *ProjectConfigService) ProcessGitEvent(data gitprovider.GitEventData) er
 				Repository:  repo,
 				EnvVars:     projectConfig.EnvVars,
 				PrebuildId:  prebuild.Id,
+				MaxRetries:  prebuild.MaxRetries,
 			})
 		}
 	}
 
-	for _, build := range buildsToTrigger {
+	for _, b := range buildsToTrigger {
 		createBuildDto := build_dto.BuildCreationData{
-			Image:       build.Image,
-			User:        build.User,
-			BuildConfig: build.BuildConfig,
-			Repository:  build.Repository,
-			EnvVars:     build.EnvVars,
-			PrebuildId:  build.PrebuildId,
+			Image:       b.Image,
+			User:        b.User,
+			BuildConfig: b.BuildConfig,
+			Repository:  b.Repository,
+			EnvVars:     b.EnvVars,
+			PrebuildId:  b.PrebuildId,
+			Priority:    build.BuildPriorityLow,
+			MaxRetries:  b.MaxRetries,
 		}
 
 		_, err = s.buildService.Create(createBuildDto)
@@ -0,0 +1,98 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/secret"
+)
+
+type ISecretService interface {
+	List() ([]*secret.Secret, error)
+	Set(name, value string) error
+	Delete(name string) error
+	// Resolve returns the values of the given secrets keyed by name
+	Resolve(names []string) (map[string]string, error)
+	// Values returns the values of all secrets
+	Values() ([]string, error)
+}
+
+type SecretServiceConfig struct {
+	SecretStore secret.Store
+}
+
+type SecretService struct {
+	secretStore secret.Store
+}
+
+func NewSecretService(config SecretServiceConfig) ISecretService {
+	return &SecretService{
+		secretStore: config.SecretStore,
+	}
+}
+
+func (s *SecretService) List() ([]*secret.Secret, error) {
+	return s.secretStore.List()
+}
+
+func (s *SecretService) Set(name, value string) error {
+	err := secret.ValidateName(name)
+	if err != nil {
+		return err
+	}
+
+	err = secret.ValidateValue(value)
+	if err != nil {
+		return err
+	}
+
+	return s.secretStore.Save(&secret.Secret{
+		Name:      name,
+		Value:     value,
+		UpdatedAt: time.Now(),
+	})
+}
+
+func (s *SecretService) Delete(name string) error {
+	sec, err := s.secretStore.Find(name)
+	if err != nil {
+		return err
+	}
+
+	return s.secretStore.Delete(sec)
+}
+
+func (s *SecretService) Resolve(names []string) (map[string]string, error) {
+	values := map[string]string{}
+
+	for _, name := range names {
+		sec, err := s.secretStore.Find(name)
+		if err != nil {
+			if secret.IsSecretNotFound(err) {
+				return nil, fmt.Errorf("%w: %s", err, name)
+			}
+			return nil, err
+		}
+
+		values[name] = sec.Value
+	}
+
+	return values, nil
+}
+
+func (s *SecretService) Values() ([]string, error) {
+	secrets, err := s.secretStore.List()
+	if err != nil {
+		return nil, err
+	}
+
+	values := []string{}
+	for _, sec := range secrets {
+		values = append(values, sec.Value)
+	}
+
+	return values, nil
+}
@@ -0,0 +1,64 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets_test
+
+import (
+	"errors"
+	"testing"
+
+	t_secrets "github.com/daytonaio/daytona/internal/testing/server/secrets"
+	"github.com/daytonaio/daytona/pkg/secret"
+	"github.com/daytonaio/daytona/pkg/server/secrets"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretService(t *testing.T) {
+	service := secrets.NewSecretService(secrets.SecretServiceConfig{
+		SecretStore: t_secrets.NewInMemorySecretStore(),
+	})
+
+	t.Run("SetSecret", func(t *testing.T) {
+		err := service.Set("NPM_TOKEN", "token")
+		require.Nil(t, err)
+
+		err = service.Set("AWS_SECRET_ACCESS_KEY", "secret-key")
+		require.Nil(t, err)
+
+		list, err := service.List()
+		require.Nil(t, err)
+		require.Len(t, list, 2)
+		require.Equal(t, "AWS_SECRET_ACCESS_KEY", list[0].Name)
+	})
+
+	t.Run("SetSecretInvalidName", func(t *testing.T) {
+		err := service.Set("1-TOKEN", "token")
+		require.ErrorIs(t, err, secret.ErrInvalidSecretName)
+	})
+
+	t.Run("SetSecretTooShort", func(t *testing.T) {
+		err := service.Set("SHORT_TOKEN", "abc")
+		require.ErrorIs(t, err, secret.ErrSecretTooShort)
+	})
+
+	t.Run("ResolveSecrets", func(t *testing.T) {
+		values, err := service.Resolve([]string{"NPM_TOKEN"})
+		require.Nil(t, err)
+		require.Equal(t, map[string]string{"NPM_TOKEN": "token"}, values)
+
+		_, err = service.Resolve([]string{"NPM_TOKEN", "MISSING"})
+		require.True(t, errors.Is(err, secret.ErrSecretNotFound))
+	})
+
+	t.Run("DeleteSecret", func(t *testing.T) {
+		err := service.Delete("NPM_TOKEN")
+		require.Nil(t, err)
+
+		values, err := service.Values()
+		require.Nil(t, err)
+		require.Equal(t, []string{"secret-key"}, values)
+
+		err = service.Delete("NPM_TOKEN")
+		require.True(t, secret.IsSecretNotFound(err))
+	})
+}
@@ -11,15 +11,20 @@ import (
 	"time"
 
 	"github.com/daytonaio/daytona/pkg/frpc"
+	"github.com/daytonaio/daytona/pkg/metrics"
 	"github.com/daytonaio/daytona/pkg/provider/manager"
 	"github.com/daytonaio/daytona/pkg/server/apikeys"
+	"github.com/daytonaio/daytona/pkg/server/auditlog"
 	"github.com/daytonaio/daytona/pkg/server/builds"
 	"github.com/daytonaio/daytona/pkg/server/containerregistries"
+	"github.com/daytonaio/daytona/pkg/server/events"
 	"github.com/daytonaio/daytona/pkg/server/gitproviders"
 	"github.com/daytonaio/daytona/pkg/server/profiledata"
 	"github.com/daytonaio/daytona/pkg/server/projectconfig"
 	"github.com/daytonaio/daytona/pkg/server/providertargets"
 	"github.com/daytonaio/daytona/pkg/server/registry"
+	"github.com/daytonaio/daytona/pkg/server/secrets"
+	"github.com/daytonaio/daytona/pkg/server/webhooks"
 	"github.com/daytonaio/daytona/pkg/server/workspaces"
 	"github.com/daytonaio/daytona/pkg/telemetry"
 	"github.com/hashicorp/go-plugin"
@@ -41,6 +46,10 @@ type ServerInstanceConfig struct {
 	ProviderManager          manager.IProviderManager
 	ProfileDataService       profiledata.IProfileDataService
 	TelemetryService         telemetry.TelemetryService
+	EventBus                 events.IEventBus
+	WebhookService           webhooks.IWebhookService
+	AuditLogService          auditlog.IAuditLogService
+	SecretService            secrets.ISecretService
 }
 
 var server *Server
@@ -69,6 +78,10 @@ func GetInstance(serverConfig *ServerInstanceConfig) *Server {
 			ProviderManager:          serverConfig.ProviderManager,
 			ProfileDataService:       serverConfig.ProfileDataService,
 			TelemetryService:         serverConfig.TelemetryService,
+			EventBus:                 serverConfig.EventBus,
+			WebhookService:           serverConfig.WebhookService,
+			AuditLogService:          serverConfig.AuditLogService,
+			SecretService:            serverConfig.SecretService,
 		}
 	}
 
@@ -90,6 +103,10 @@ type Server struct {
 	ProviderManager          manager.IProviderManager
 	ProfileDataService       profiledata.IProfileDataService
 	TelemetryService         telemetry.TelemetryService
+	EventBus                 events.IEventBus
+	WebhookService           webhooks.IWebhookService
+	AuditLogService          auditlog.IAuditLogService
+	SecretService            secrets.ISecretService
 }
 
 func (s *Server) Start(errCh chan error) error {
@@ -100,6 +117,11 @@ func (s *Server) Start(errCh chan error) error {
 
 	log.Info("Starting Daytona server")
 
+	err = metrics.Registry.Register(&metricsCollector{server: s})
+	if err != nil {
+		return err
+	}
+
 	headscaleFrpcHealthCheck, headscaleFrpcService, err := frpc.GetService(frpc.FrpcConnectParams{
 		ServerDomain: s.config.Frps.Domain,
 		ServerPort:   int(s.config.Frps.Port),
@@ -50,5 +50,9 @@ type Config struct {
 	LocalBuilderRegistryImage string      `json:"localBuilderRegistryImage" validate:"required"`
 	BuilderRegistryServer     string      `json:"builderRegistryServer" validate:"required"`
 	BuildImageNamespace       string      `json:"buildImageNamespace" validate:"optional"`
+	BuildTimeout              uint32      `json:"buildTimeout" validate:"required"`
+	MaxConcurrentBuilds       uint32      `json:"maxConcurrentBuilds" validate:"required"`
+	MaxBuildRetries           uint32      `json:"maxBuildRetries" validate:"required"`
 	SamplesIndexUrl           string      `json:"samplesIndexUrl" validate:"optional"`
+	AuditRetentionDays        uint32      `json:"auditRetentionDays" validate:"required"`
 } // @name ServerConfig
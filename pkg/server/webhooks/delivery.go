@@ -0,0 +1,252 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhooks
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/server/events"
+	"github.com/daytonaio/daytona/pkg/webhook"
+	"github.com/docker/docker/pkg/stringid"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	SignatureHeader  = "X-Daytona-Signature"
+	EventHeader      = "X-Daytona-Event"
+	DeliveryIdHeader = "X-Daytona-Delivery"
+)
+
+// Start subscribes to the server event bus and delivers every event to the subscribed webhooks
+func (s *WebhookService) Start() error {
+	if s.eventBus == nil {
+		return nil
+	}
+
+	eventCh, unsubscribe := s.eventBus.Subscribe(nil)
+	s.unsubscribe = unsubscribe
+
+	for i := 0; i < deliveryWorkers; i++ {
+		go func() {
+			for job := range s.deliveryQueue {
+				s.attemptDelivery(job)
+			}
+		}()
+	}
+
+	go func() {
+		for event := range eventCh {
+			s.dispatch(event)
+		}
+		s.closeQueue()
+	}()
+
+	return nil
+}
+
+func (s *WebhookService) Stop() {
+	if s.unsubscribe != nil {
+		s.unsubscribe()
+	}
+}
+
+func (s *WebhookService) dispatch(event events.Event) {
+	webhooks, err := s.getWebhooks()
+	if err != nil {
+		log.Errorf("failed to list webhooks: %s", err)
+		return
+	}
+
+	for _, w := range webhooks {
+		if !w.IsSubscribed(event.Type) {
+			continue
+		}
+
+		job := s.newDeliveryJob(w, event)
+		if job != nil {
+			s.enqueue(job)
+		}
+	}
+}
+
+// enqueue queues a delivery attempt without blocking. Attempts are dropped once the queue is full or closed
+func (s *WebhookService) enqueue(job *deliveryJob) {
+	s.queueMutex.RLock()
+	defer s.queueMutex.RUnlock()
+
+	if s.queueClosed {
+		return
+	}
+
+	select {
+	case s.deliveryQueue <- job:
+	default:
+		log.Errorf("webhook delivery queue is full, dropping %s event for webhook %s", job.event.Type, job.webhook.Id)
+	}
+}
+
+func (s *WebhookService) closeQueue() {
+	s.queueMutex.Lock()
+	defer s.queueMutex.Unlock()
+
+	s.queueClosed = true
+	close(s.deliveryQueue)
+}
+
+func (s *WebhookService) getWebhooks() ([]*webhook.Webhook, error) {
+	s.cacheMutex.Lock()
+	defer s.cacheMutex.Unlock()
+
+	if s.webhooksCache != nil {
+		return s.webhooksCache, nil
+	}
+
+	webhooks, err := s.webhookStore.List()
+	if err != nil {
+		return nil, err
+	}
+
+	if webhooks == nil {
+		webhooks = []*webhook.Webhook{}
+	}
+
+	s.webhooksCache = webhooks
+	return webhooks, nil
+}
+
+func (s *WebhookService) invalidateCache() {
+	s.cacheMutex.Lock()
+	defer s.cacheMutex.Unlock()
+
+	s.webhooksCache = nil
+}
+
+// Test sends a single test event to the webhook and returns the resulting delivery
+func (s *WebhookService) Test(id string) (*webhook.Delivery, error) {
+	w, err := s.webhookStore.Find(id)
+	if err != nil {
+		return nil, err
+	}
+
+	event := events.Event{
+		Id:        stringid.TruncateID(stringid.GenerateRandomID()),
+		Type:      events.EventTypeWebhookTest,
+		Timestamp: time.Now(),
+	}
+
+	job := s.newDeliveryJob(w, event)
+	if job == nil {
+		return nil, fmt.Errorf("failed to marshal %s event", event.Type)
+	}
+
+	s.attempt(job)
+	return job.delivery, nil
+}
+
+// newDeliveryJob returns nil if the event can not be delivered, the failure is recorded in the delivery log
+func (s *WebhookService) newDeliveryJob(w *webhook.Webhook, event events.Event) *deliveryJob {
+	delivery := &webhook.Delivery{
+		Id:        stringid.TruncateID(stringid.GenerateRandomID()),
+		WebhookId: w.Id,
+		EventId:   event.Id,
+		EventType: event.Type,
+		CreatedAt: time.Now(),
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		delivery.Error = err.Error()
+		s.saveDelivery(delivery)
+		return nil
+	}
+
+	return &deliveryJob{
+		webhook:  w,
+		event:    event,
+		delivery: delivery,
+		payload:  payload,
+	}
+}
+
+// attemptDelivery sends the delivery once. Failed deliveries are queued again after an exponential backoff
+// instead of waiting in the worker, so that failing endpoints do not hold up deliveries to other webhooks
+func (s *WebhookService) attemptDelivery(job *deliveryJob) {
+	s.attempt(job)
+
+	if job.delivery.Success || job.delivery.Attempts >= s.maxDeliveryAttempts {
+		return
+	}
+
+	backoff := s.retryBackoff * time.Duration(1<<(job.delivery.Attempts-1))
+	time.AfterFunc(backoff, func() {
+		s.enqueue(job)
+	})
+}
+
+func (s *WebhookService) attempt(job *deliveryJob) {
+	var err error
+
+	delivery := job.delivery
+	delivery.Attempts++
+	delivery.StatusCode, err = s.send(job.webhook, delivery.Id, job.event.Type, job.payload)
+	delivery.Success = err == nil
+	delivery.Error = ""
+	if err != nil {
+		delivery.Error = err.Error()
+	}
+	s.saveDelivery(delivery)
+}
+
+func (s *WebhookService) send(w *webhook.Webhook, deliveryId string, eventType events.EventType, payload []byte) (int, error) {
+	req, err := http.NewRequest(http.MethodPost, w.Url, bytes.NewReader(payload))
+	if err != nil {
+		return 0, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, string(eventType))
+	req.Header.Set(DeliveryIdHeader, deliveryId)
+	req.Header.Set(SignatureHeader, "sha256="+Sign(w.Secret, payload))
+
+	res, err := s.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return res.StatusCode, fmt.Errorf("unexpected status code %d", res.StatusCode)
+	}
+
+	return res.StatusCode, nil
+}
+
+func (s *WebhookService) saveDelivery(delivery *webhook.Delivery) {
+	delivery.UpdatedAt = time.Now()
+
+	err := s.deliveryStore.Save(delivery)
+	if err != nil {
+		log.Errorf("failed to save webhook delivery %s: %s", delivery.Id, err)
+		return
+	}
+
+	err = s.deliveryStore.Prune(delivery.WebhookId, deliveryLogSize)
+	if err != nil {
+		log.Errorf("failed to prune deliveries of webhook %s: %s", delivery.WebhookId, err)
+	}
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of the payload, keyed with the webhook secret
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
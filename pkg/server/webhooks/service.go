@@ -0,0 +1,93 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhooks
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/server/events"
+	"github.com/daytonaio/daytona/pkg/webhook"
+)
+
+const (
+	defaultMaxDeliveryAttempts = 5
+	defaultRetryBackoff        = 5 * time.Second
+	deliveryTimeout            = 10 * time.Second
+	// Number of deliveries kept in the delivery log of each webhook
+	deliveryLogSize = 50
+	// Number of concurrent deliveries
+	deliveryWorkers = 4
+	// Deliveries queued beyond this size are dropped instead of blocking the event bus
+	deliveryQueueSize = 256
+)
+
+type IWebhookService interface {
+	Create(url string, eventTypes []events.EventType, secret string) (*webhook.Webhook, error)
+	Find(id string) (*webhook.Webhook, error)
+	List() ([]*webhook.Webhook, error)
+	Delete(id string) error
+	ListDeliveries(id string) ([]*webhook.Delivery, error)
+	Test(id string) (*webhook.Delivery, error)
+	Start() error
+	Stop()
+}
+
+type WebhookServiceConfig struct {
+	WebhookStore  webhook.Store
+	DeliveryStore webhook.DeliveryStore
+	EventBus      events.IEventBus
+	// Defaults to 5 attempts
+	MaxDeliveryAttempts int
+	// Backoff before the first retry, doubled on every following attempt. Defaults to 5 seconds
+	RetryBackoff time.Duration
+}
+
+func NewWebhookService(config WebhookServiceConfig) IWebhookService {
+	maxDeliveryAttempts := config.MaxDeliveryAttempts
+	if maxDeliveryAttempts <= 0 {
+		maxDeliveryAttempts = defaultMaxDeliveryAttempts
+	}
+
+	retryBackoff := config.RetryBackoff
+	if retryBackoff <= 0 {
+		retryBackoff = defaultRetryBackoff
+	}
+
+	return &WebhookService{
+		webhookStore:        config.WebhookStore,
+		deliveryStore:       config.DeliveryStore,
+		eventBus:            config.EventBus,
+		maxDeliveryAttempts: maxDeliveryAttempts,
+		retryBackoff:        retryBackoff,
+		httpClient: &http.Client{
+			Timeout: deliveryTimeout,
+		},
+		deliveryQueue: make(chan *deliveryJob, deliveryQueueSize),
+	}
+}
+
+type WebhookService struct {
+	webhookStore        webhook.Store
+	deliveryStore       webhook.DeliveryStore
+	eventBus            events.IEventBus
+	maxDeliveryAttempts int
+	retryBackoff        time.Duration
+	httpClient          *http.Client
+	unsubscribe         func()
+	deliveryQueue       chan *deliveryJob
+	queueClosed         bool
+	queueMutex          sync.RWMutex
+	// Webhooks are cached for event dispatching and reloaded after they change
+	webhooksCache []*webhook.Webhook
+	cacheMutex    sync.Mutex
+}
+
+type deliveryJob struct {
+	webhook  *webhook.Webhook
+	event    events.Event
+	delivery *webhook.Delivery
+	payload  []byte
+}
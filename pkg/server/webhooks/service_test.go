@@ -0,0 +1,267 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhooks_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	t_webhooks "github.com/daytonaio/daytona/internal/testing/server/webhooks"
+	"github.com/daytonaio/daytona/pkg/server/events"
+	"github.com/daytonaio/daytona/pkg/server/webhooks"
+	"github.com/daytonaio/daytona/pkg/webhook"
+	"github.com/stretchr/testify/suite"
+)
+
+type receivedRequest struct {
+	eventType string
+	signature string
+	event     events.Event
+	body      []byte
+}
+
+type WebhookServiceTestSuite struct {
+	suite.Suite
+	webhookService webhooks.IWebhookService
+	eventBus       *events.EventBus
+	httpServer     *httptest.Server
+	requests       chan receivedRequest
+	failures       atomic.Int32
+}
+
+func NewWebhookServiceTestSuite() *WebhookServiceTestSuite {
+	return &WebhookServiceTestSuite{}
+}
+
+func (s *WebhookServiceTestSuite) SetupTest() {
+	s.requests = make(chan receivedRequest, 10)
+	s.failures.Store(0)
+
+	s.httpServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.failures.Load() > 0 {
+			s.failures.Add(-1)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		var event events.Event
+		_ = json.Unmarshal(body, &event)
+
+		s.requests <- receivedRequest{
+			eventType: r.Header.Get(webhooks.EventHeader),
+			signature: r.Header.Get(webhooks.SignatureHeader),
+			event:     event,
+			body:      body,
+		}
+	}))
+
+	s.eventBus = events.NewEventBus()
+	s.webhookService = webhooks.NewWebhookService(webhooks.WebhookServiceConfig{
+		WebhookStore:        t_webhooks.NewInMemoryWebhookStore(),
+		DeliveryStore:       t_webhooks.NewInMemoryDeliveryStore(),
+		EventBus:            s.eventBus,
+		MaxDeliveryAttempts: 3,
+		RetryBackoff:        time.Millisecond,
+	})
+
+	s.Require().Nil(s.webhookService.Start())
+}
+
+func (s *WebhookServiceTestSuite) TearDownTest() {
+	s.webhookService.Stop()
+	s.httpServer.Close()
+}
+
+func TestWebhookService(t *testing.T) {
+	suite.Run(t, NewWebhookServiceTestSuite())
+}
+
+func (s *WebhookServiceTestSuite) TestCreate() {
+	require := s.Require()
+
+	w, err := s.webhookService.Create(s.httpServer.URL, nil, "")
+	require.Nil(err)
+	require.NotEmpty(w.Secret)
+	require.Empty(w.Events)
+
+	found, err := s.webhookService.Find(w.Id)
+	require.Nil(err)
+	require.Empty(found.Secret)
+
+	list, err := s.webhookService.List()
+	require.Nil(err)
+	require.Len(list, 1)
+	require.Empty(list[0].Secret)
+
+	_, err = s.webhookService.Create("not-a-url", nil, "")
+	require.ErrorIs(err, webhooks.ErrInvalidWebhookUrl)
+}
+
+func (s *WebhookServiceTestSuite) TestDelivery() {
+	require := s.Require()
+
+	w, err := s.webhookService.Create(s.httpServer.URL, []events.EventType{events.EventTypeWorkspaceStarted}, "secret")
+	require.Nil(err)
+
+	s.eventBus.Publish(events.Event{Type: events.EventTypeWorkspaceStopped, WorkspaceId: "ws1"})
+	s.eventBus.Publish(events.Event{Type: events.EventTypeWorkspaceStarted, WorkspaceId: "ws1"})
+
+	req := s.receive()
+	require.Equal(string(events.EventTypeWorkspaceStarted), req.eventType)
+	require.Equal("ws1", req.event.WorkspaceId)
+	require.Equal("sha256="+webhooks.Sign("secret", req.body), req.signature)
+
+	require.Eventually(func() bool {
+		deliveries, err := s.webhookService.ListDeliveries(w.Id)
+		return err == nil && len(deliveries) == 1 && deliveries[0].Success
+	}, time.Second, 10*time.Millisecond)
+
+	require.Empty(s.requests)
+}
+
+func (s *WebhookServiceTestSuite) TestDefaultSubscription() {
+	require := s.Require()
+
+	_, err := s.webhookService.Create(s.httpServer.URL, nil, "")
+	require.Nil(err)
+
+	s.eventBus.Publish(events.Event{Type: events.EventTypeProjectStateUpdated, WorkspaceId: "ws1"})
+	s.eventBus.Publish(events.Event{Type: events.EventTypeWorkspaceStarted, WorkspaceId: "ws1"})
+
+	require.Equal(string(events.EventTypeWorkspaceStarted), s.receive().eventType)
+
+	_, err = s.webhookService.Create(s.httpServer.URL, []events.EventType{events.EventTypeProjectStateUpdated}, "")
+	require.Nil(err)
+
+	s.eventBus.Publish(events.Event{Type: events.EventTypeProjectStateUpdated, WorkspaceId: "ws1"})
+
+	require.Equal(string(events.EventTypeProjectStateUpdated), s.receive().eventType)
+	require.Never(func() bool { return len(s.requests) > 0 }, 100*time.Millisecond, 10*time.Millisecond)
+}
+
+func (s *WebhookServiceTestSuite) TestDeliveryRetry() {
+	require := s.Require()
+
+	w, err := s.webhookService.Create(s.httpServer.URL, nil, "")
+	require.Nil(err)
+
+	s.failures.Store(2)
+	s.eventBus.Publish(events.Event{Type: events.EventTypeBuildStateChanged, BuildId: "build1", State: "success"})
+
+	req := s.receive()
+	require.Equal("build1", req.event.BuildId)
+
+	var deliveries []*webhook.Delivery
+	require.Eventually(func() bool {
+		deliveries, err = s.webhookService.ListDeliveries(w.Id)
+		return err == nil && len(deliveries) == 1 && deliveries[0].Success
+	}, time.Second, 10*time.Millisecond)
+	require.Equal(3, deliveries[0].Attempts)
+	require.Equal(http.StatusOK, deliveries[0].StatusCode)
+}
+
+func (s *WebhookServiceTestSuite) TestFailingWebhookDoesNotDelayOthers() {
+	require := s.Require()
+
+	failingServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failingServer.Close()
+
+	eventBus := events.NewEventBus()
+	webhookService := webhooks.NewWebhookService(webhooks.WebhookServiceConfig{
+		WebhookStore:  t_webhooks.NewInMemoryWebhookStore(),
+		DeliveryStore: t_webhooks.NewInMemoryDeliveryStore(),
+		EventBus:      eventBus,
+		// Retrying in the delivery workers would keep all of them busy for the whole test
+		RetryBackoff: time.Hour,
+	})
+	require.Nil(webhookService.Start())
+	defer webhookService.Stop()
+
+	failing := []*webhook.Webhook{}
+	for i := 0; i < 8; i++ {
+		w, err := webhookService.Create(failingServer.URL, nil, "")
+		require.Nil(err)
+		failing = append(failing, w)
+	}
+
+	_, err := webhookService.Create(s.httpServer.URL, nil, "")
+	require.Nil(err)
+
+	eventBus.Publish(events.Event{Type: events.EventTypeWorkspaceStarted, WorkspaceId: "ws1"})
+	eventBus.Publish(events.Event{Type: events.EventTypeWorkspaceStopped, WorkspaceId: "ws1"})
+
+	require.Equal(string(events.EventTypeWorkspaceStarted), s.receive().eventType)
+	require.Equal(string(events.EventTypeWorkspaceStopped), s.receive().eventType)
+
+	for _, w := range failing {
+		deliveries, err := webhookService.ListDeliveries(w.Id)
+		require.Nil(err)
+		for _, d := range deliveries {
+			require.False(d.Success)
+			require.Equal(1, d.Attempts)
+		}
+	}
+}
+
+func (s *WebhookServiceTestSuite) TestTest() {
+	require := s.Require()
+
+	w, err := s.webhookService.Create(s.httpServer.URL, []events.EventType{events.EventTypeWorkspaceStarted}, "")
+	require.Nil(err)
+
+	delivery, err := s.webhookService.Test(w.Id)
+	require.Nil(err)
+	require.True(delivery.Success)
+	require.Equal(1, delivery.Attempts)
+	require.Equal(events.EventTypeWebhookTest, s.receive().event.Type)
+
+	s.failures.Store(1)
+	delivery, err = s.webhookService.Test(w.Id)
+	require.Nil(err)
+	require.False(delivery.Success)
+	require.Equal(http.StatusInternalServerError, delivery.StatusCode)
+
+	_, err = s.webhookService.Test("unknown")
+	require.ErrorIs(err, webhook.ErrWebhookNotFound)
+}
+
+func (s *WebhookServiceTestSuite) TestDelete() {
+	require := s.Require()
+
+	w, err := s.webhookService.Create(s.httpServer.URL, nil, "")
+	require.Nil(err)
+
+	_, err = s.webhookService.Test(w.Id)
+	require.Nil(err)
+	s.receive()
+
+	require.Nil(s.webhookService.Delete(w.Id))
+
+	_, err = s.webhookService.Find(w.Id)
+	require.ErrorIs(err, webhook.ErrWebhookNotFound)
+
+	_, err = s.webhookService.ListDeliveries(w.Id)
+	require.ErrorIs(err, webhook.ErrWebhookNotFound)
+
+	s.eventBus.Publish(events.Event{Type: events.EventTypeWorkspaceStarted, WorkspaceId: "ws1"})
+	require.Never(func() bool { return len(s.requests) > 0 }, 100*time.Millisecond, 10*time.Millisecond)
+}
+
+func (s *WebhookServiceTestSuite) receive() receivedRequest {
+	select {
+	case req := <-s.requests:
+		return req
+	case <-time.After(2 * time.Second):
+		s.FailNow("timed out waiting for webhook request")
+		return receivedRequest{}
+	}
+}
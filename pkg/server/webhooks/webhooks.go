@@ -0,0 +1,115 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhooks
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net/url"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/server/events"
+	"github.com/daytonaio/daytona/pkg/webhook"
+	"github.com/docker/docker/pkg/stringid"
+)
+
+var ErrInvalidWebhookUrl = errors.New("webhook url must be an absolute http or https url")
+
+func (s *WebhookService) Create(webhookUrl string, eventTypes []events.EventType, secret string) (*webhook.Webhook, error) {
+	u, err := url.Parse(webhookUrl)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, ErrInvalidWebhookUrl
+	}
+
+	if secret == "" {
+		secret, err = generateSecret()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if eventTypes == nil {
+		eventTypes = []events.EventType{}
+	}
+
+	w := &webhook.Webhook{
+		Id:        stringid.TruncateID(stringid.GenerateRandomID()),
+		Url:       webhookUrl,
+		Events:    eventTypes,
+		Secret:    secret,
+		CreatedAt: time.Now(),
+	}
+
+	err = s.webhookStore.Save(w)
+	if err != nil {
+		return nil, err
+	}
+	s.invalidateCache()
+
+	return w, nil
+}
+
+func (s *WebhookService) Find(id string) (*webhook.Webhook, error) {
+	w, err := s.webhookStore.Find(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return withoutSecret(w), nil
+}
+
+func (s *WebhookService) List() ([]*webhook.Webhook, error) {
+	webhooks, err := s.webhookStore.List()
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*webhook.Webhook{}
+	for _, w := range webhooks {
+		result = append(result, withoutSecret(w))
+	}
+
+	return result, nil
+}
+
+func (s *WebhookService) Delete(id string) error {
+	w, err := s.webhookStore.Find(id)
+	if err != nil {
+		return err
+	}
+
+	err = s.webhookStore.Delete(w)
+	if err != nil {
+		return err
+	}
+	s.invalidateCache()
+
+	return s.deliveryStore.DeleteAll(w.Id)
+}
+
+func (s *WebhookService) ListDeliveries(id string) ([]*webhook.Delivery, error) {
+	_, err := s.webhookStore.Find(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deliveryStore.List(id)
+}
+
+func withoutSecret(w *webhook.Webhook) *webhook.Webhook {
+	result := *w
+	result.Secret = ""
+	return &result
+}
+
+func generateSecret() (string, error) {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
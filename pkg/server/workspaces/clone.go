@@ -0,0 +1,85 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+
+	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+// CloneWorkspace creates a new workspace on the same target with the same projects as an existing workspace.
+// If requested, the uncommitted changes of the projects are fetched from their agents and applied to the new projects,
+// which are cloned at the same commit. This requires the projects to be started and their commits to be pushed.
+func (s *WorkspaceService) CloneWorkspace(ctx context.Context, workspaceId string, req dto.CloneWorkspaceDTO) (*workspace.Workspace, error) {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	createWorkspaceDto := dto.CreateWorkspaceDTO{
+		Id:       req.Id,
+		Name:     req.Name,
+		Target:   w.Target,
+		Projects: []dto.CreateProjectDTO{},
+	}
+
+	for _, p := range w.Projects {
+		projectDto := getCloneProjectDto(p)
+
+		if req.WithChanges {
+			patch, err := s.getProjectPatch(ctx, p)
+			if err != nil {
+				return nil, err
+			}
+
+			projectDto.Source.Repository, err = withPatch(projectDto.Source.Repository, p.Name, patch)
+			if err != nil {
+				return nil, err
+			}
+
+			if patch.Diff != "" {
+				projectDto.Patch = &patch.Diff
+			}
+		}
+
+		createWorkspaceDto.Projects = append(createWorkspaceDto.Projects, projectDto)
+	}
+
+	return s.CreateWorkspace(ctx, createWorkspaceDto)
+}
+
+func getCloneProjectDto(p *project.Project) dto.CreateProjectDTO {
+	repository := *p.Repository
+
+	projectDto := dto.CreateProjectDTO{
+		Name:      p.Name,
+		Image:     &p.Image,
+		User:      &p.User,
+		Resources: p.Resources,
+		Source: dto.CreateProjectSourceDTO{
+			Repository: &repository,
+		},
+		EnvVars: map[string]string{},
+		Secrets: p.Secrets,
+	}
+
+	if p.BuildConfig != nil {
+		// The cached build is looked up again for the new project
+		buildConfig := *p.BuildConfig
+		buildConfig.CachedBuild = nil
+		projectDto.BuildConfig = &buildConfig
+	}
+
+	// Env vars set by the server are generated again for the new project
+	for k, v := range p.EnvVars {
+		if !project.IsServerEnvVar(k) {
+			projectDto.EnvVars[k] = v
+		}
+	}
+
+	return projectDto
+}
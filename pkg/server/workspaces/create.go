@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"regexp"
+	"time"
 
 	"github.com/daytonaio/daytona/internal/util"
 	"github.com/daytonaio/daytona/internal/util/apiclient/conversion"
@@ -17,9 +18,11 @@ import (
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/logs"
 	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/server/events"
 	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
 	"github.com/daytonaio/daytona/pkg/telemetry"
 	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/lifecycle"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
 	"github.com/daytonaio/daytona/pkg/workspace/project/buildconfig"
 
@@ -55,9 +58,19 @@ func (s *WorkspaceService) CreateWorkspace(ctx context.Context, req dto.CreateWo
 	}
 
 	w := &workspace.Workspace{
-		Id:     req.Id,
-		Name:   req.Name,
-		Target: req.Target,
+		Id:        req.Id,
+		Name:      req.Name,
+		Target:    req.Target,
+		Lifecycle: lifecycle.New(),
+	}
+
+	if req.AutoStop != nil {
+		w.AutoStop = *req.AutoStop
+	}
+
+	if req.Ttl != nil && *req.Ttl > 0 {
+		expiresAt := time.Now().Add(time.Duration(*req.Ttl) * time.Minute)
+		w.ExpiresAt = &expiresAt
 	}
 
 	apiKey, err := s.apiKeyService.Generate(apikey.ApiKeyTypeWorkspace, w.Id)
@@ -69,45 +82,11 @@ func (s *WorkspaceService) CreateWorkspace(ctx context.Context, req dto.CreateWo
 	w.Projects = []*project.Project{}
 
 	for _, projectDto := range req.Projects {
-		p := conversion.CreateDtoToProject(projectDto)
-
-		isValidProjectName := regexp.MustCompile(`^[a-zA-Z0-9-_.]+$`).MatchString
-		if !isValidProjectName(p.Name) {
-			return nil, ErrInvalidProjectName
-		}
-
-		p.Repository.Url = util.CleanUpRepositoryUrl(p.Repository.Url)
-		if p.Repository.Sha == "" {
-			sha, err := s.gitProviderService.GetLastCommitSha(p.Repository)
-			if err != nil {
-				return nil, err
-			}
-			p.Repository.Sha = sha
-		}
-
-		if p.BuildConfig != nil {
-			cachedBuild, err := s.getCachedBuildForProject(p)
-			if err == nil {
-				p.BuildConfig.CachedBuild = cachedBuild
-			}
-		}
-
-		if p.Image == "" {
-			p.Image = s.defaultProjectImage
-		}
-
-		if p.User == "" {
-			p.User = s.defaultProjectUser
-		}
-
-		apiKey, err := s.apiKeyService.Generate(apikey.ApiKeyTypeProject, fmt.Sprintf("%s/%s", w.Id, p.Name))
+		p, err := s.newProject(w, projectDto)
 		if err != nil {
 			return nil, err
 		}
 
-		p.WorkspaceId = w.Id
-		p.ApiKey = apiKey
-		p.Target = w.Target
 		w.Projects = append(w.Projects, p)
 	}
 
@@ -118,10 +97,15 @@ func (s *WorkspaceService) CreateWorkspace(ctx context.Context, req dto.CreateWo
 
 	target, err := s.targetStore.Find(w.Target)
 	if err != nil {
+		s.failWorkspace(w, err)
 		return w, err
 	}
 
-	w, err = s.createWorkspace(ctx, w, target)
+	createdWorkspace, err := s.createWorkspace(ctx, w, target)
+	if err != nil {
+		s.failWorkspace(w, err)
+	}
+	s.publishWorkspaceEvent(events.EventTypeWorkspaceCreated, w.Id, err)
 
 	if !telemetry.TelemetryEnabled(ctx) {
 		return w, err
@@ -140,10 +124,64 @@ func (s *WorkspaceService) CreateWorkspace(ctx context.Context, req dto.CreateWo
 		log.Trace(err)
 	}
 
-	return w, err
+	return createdWorkspace, err
 }
 
-func (s *WorkspaceService) createProject(p *project.Project, target *provider.ProviderTarget, logWriter io.Writer) error {
+// newProject validates the project DTO and prepares the project to be provisioned in the given workspace
+func (s *WorkspaceService) newProject(w *workspace.Workspace, projectDto dto.CreateProjectDTO) (*project.Project, error) {
+	p := conversion.CreateDtoToProject(projectDto)
+
+	isValidProjectName := regexp.MustCompile(`^[a-zA-Z0-9-_.]+$`).MatchString
+	if !isValidProjectName(p.Name) {
+		return nil, ErrInvalidProjectName
+	}
+
+	p.Repository.Url = util.CleanUpRepositoryUrl(p.Repository.Url)
+	if p.Repository.Sha == "" {
+		sha, err := s.gitProviderService.GetLastCommitSha(p.Repository)
+		if err != nil {
+			return nil, err
+		}
+		p.Repository.Sha = sha
+	}
+
+	if p.BuildConfig != nil {
+		cachedBuild, err := s.getCachedBuildForProject(p)
+		if err == nil {
+			p.BuildConfig.CachedBuild = cachedBuild
+		}
+	}
+
+	if p.Image == "" {
+		p.Image = s.defaultProjectImage
+	}
+
+	if p.User == "" {
+		p.User = s.defaultProjectUser
+	}
+
+	if len(p.Secrets) > 0 {
+		// The values are only read by the project agent, this checks that all referenced secrets exist
+		_, err := s.secretService.Resolve(p.Secrets)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	apiKey, err := s.apiKeyService.Generate(apikey.ApiKeyTypeProject, fmt.Sprintf("%s/%s", w.Id, p.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	p.WorkspaceId = w.Id
+	p.ApiKey = apiKey
+	p.Target = w.Target
+	p.Lifecycle = lifecycle.New()
+
+	return p, nil
+}
+
+func (s *WorkspaceService) createProject(ctx context.Context, p *project.Project, target *provider.ProviderTarget, logWriter io.Writer) error {
 	logWriter.Write([]byte(fmt.Sprintf("Creating project %s\n", p.Name)))
 
 	cr, err := s.containerRegistryService.FindByImageName(p.Image)
@@ -156,7 +194,12 @@ func (s *WorkspaceService) createProject(p *project.Project, target *provider.Pr
 		return err
 	}
 
-	err = s.provisioner.CreateProject(p, target, cr, gc)
+	projectToCreate, err := s.withResolvedEnvVars(ctx, s.withProjectEnvVars(ctx, p))
+	if err != nil {
+		return err
+	}
+
+	err = s.provisioner.CreateProject(projectToCreate, target, cr, gc)
 	if err != nil {
 		return err
 	}
@@ -183,35 +226,24 @@ func (s *WorkspaceService) createWorkspace(ctx context.Context, ws *workspace.Wo
 		return nil, err
 	}
 
-	for i, p := range ws.Projects {
+	err = s.workspaceStore.Save(ws)
+	if err != nil {
+		return nil, err
+	}
+
+	err = forEachProject(ws.Projects, func(p *project.Project) error {
 		projectLogger := s.loggerFactory.CreateProjectLogger(ws.Id, p.Name, logs.LogSourceServer)
 		defer projectLogger.Close()
 
-		projectWithEnv := *p
-		projectWithEnv.EnvVars = project.GetProjectEnvVars(p, project.ProjectEnvVarParams{
-			ApiUrl:    s.serverApiUrl,
-			ServerUrl: s.serverUrl,
-			ClientId:  telemetry.ClientId(ctx),
-		}, telemetry.TelemetryEnabled(ctx))
-
-		for k, v := range p.EnvVars {
-			projectWithEnv.EnvVars[k] = v
-		}
-
-		var err error
-
-		p = &projectWithEnv
-
-		ws.Projects[i] = p
-		err = s.workspaceStore.Save(ws)
+		err := s.createProject(ctx, p, target, projectLogger)
 		if err != nil {
-			return nil, err
+			s.failProject(ws, p, err)
 		}
 
-		err = s.createProject(p, target, projectLogger)
-		if err != nil {
-			return nil, err
-		}
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	wsLogger.Write([]byte("Workspace creation complete. Pending start...\n"))
@@ -224,6 +256,42 @@ func (s *WorkspaceService) createWorkspace(ctx context.Context, ws *workspace.Wo
 	return ws, nil
 }
 
+// withProjectEnvVars returns a copy of the project with the server provided env vars set.
+// Env vars defined on the project take precedence. The copy is only passed to the provisioner
+// so that the server provided env vars, e.g. the project API key, are never stored or returned.
+func (s *WorkspaceService) withProjectEnvVars(ctx context.Context, p *project.Project) *project.Project {
+	projectWithEnv := *p
+	projectWithEnv.EnvVars = project.GetProjectEnvVars(p, project.ProjectEnvVarParams{
+		ApiUrl:    s.serverApiUrl,
+		ServerUrl: s.serverUrl,
+		ClientId:  telemetry.ClientId(ctx),
+	}, telemetry.TelemetryEnabled(ctx))
+
+	for k, v := range p.EnvVars {
+		projectWithEnv.EnvVars[k] = v
+	}
+
+	return &projectWithEnv
+}
+
+// withResolvedEnvVars returns a copy of the project with the secret references in its env vars replaced
+// by the secret values. The stored project keeps the references.
+func (s *WorkspaceService) withResolvedEnvVars(ctx context.Context, p *project.Project) (*project.Project, error) {
+	if s.secretResolver == nil {
+		return p, nil
+	}
+
+	envVars, err := s.secretResolver.ResolveEnvVars(ctx, p.EnvVars)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvedProject := *p
+	resolvedProject.EnvVars = envVars
+
+	return &resolvedProject, nil
+}
+
 func (s *WorkspaceService) getCachedBuildForProject(p *project.Project) (*buildconfig.CachedBuild, error) {
 	validStates := &[]build.BuildState{
 		build.BuildState(build.BuildStatePublished),
@@ -25,6 +25,9 @@ type CreateWorkspaceDTO struct {
 	Name     string             `json:"name" validate:"required"`
 	Target   string             `json:"target" validate:"required"`
 	Projects []CreateProjectDTO `json:"projects" validate:"required,gt=0,dive"`
+	AutoStop *uint32            `json:"autoStop,omitempty" validate:"optional"`
+	// Minutes after which the workspace is removed
+	Ttl *uint32 `json:"ttl,omitempty" validate:"optional"`
 } //	@name	CreateWorkspaceDTO
 
 type CreateProjectDTO struct {
@@ -32,10 +35,21 @@ type CreateProjectDTO struct {
 	Image       *string                  `json:"image,omitempty" validate:"optional"`
 	User        *string                  `json:"user,omitempty" validate:"optional"`
 	BuildConfig *buildconfig.BuildConfig `json:"buildConfig,omitempty" validate:"optional"`
+	Resources   *project.ResourceLimits  `json:"resources,omitempty" validate:"optional"`
 	Source      CreateProjectSourceDTO   `json:"source" validate:"required"`
 	EnvVars     map[string]string        `json:"envVars" validate:"required"`
+	Secrets     []string                 `json:"secrets,omitempty" validate:"optional"`
+	// Uncommitted changes applied to the repository once it is cloned
+	Patch *string `json:"patch,omitempty" validate:"optional"`
 } //	@name	CreateProjectDTO
 
+type CloneWorkspaceDTO struct {
+	Id   string `json:"id" validate:"required"`
+	Name string `json:"name" validate:"required"`
+	// Carry over the uncommitted changes of the projects
+	WithChanges bool `json:"withChanges,omitempty" validate:"optional"`
+} //	@name	CloneWorkspaceDTO
+
 type CreateProjectSourceDTO struct {
 	Repository *gitprovider.GitRepository `json:"repository" validate:"required"`
 } // @name CreateProjectSourceDTO
@@ -8,12 +8,20 @@ import (
 )
 
 var (
-	ErrWorkspaceAlreadyExists = errors.New("workspace already exists")
-	ErrInvalidWorkspaceName   = errors.New("name is not a valid alphanumeric string")
-	ErrWorkspaceNotFound      = errors.New("workspace not found")
-	ErrProjectNotFound        = errors.New("project not found")
-	ErrInvalidProjectName     = errors.New("project name is not valid. Only [a-zA-Z0-9-_.] are allowed")
-	ErrInvalidProjectConfig   = errors.New("project config is invalid")
+	ErrWorkspaceAlreadyExists   = errors.New("workspace already exists")
+	ErrInvalidWorkspaceName     = errors.New("name is not a valid alphanumeric string")
+	ErrWorkspaceNotFound        = errors.New("workspace not found")
+	ErrProjectNotFound          = errors.New("project not found")
+	ErrProjectAlreadyExists     = errors.New("project already exists")
+	ErrLastProject              = errors.New("cannot remove the last project of a workspace")
+	ErrWorkspaceNotStarted      = errors.New("workspace must be started")
+	ErrProjectNotStarted        = errors.New("project must be started")
+	ErrSnapshotAlreadyExists    = errors.New("snapshot with the same name already exists")
+	ErrInvalidProjectName       = errors.New("project name is not valid. Only [a-zA-Z0-9-_.] are allowed")
+	ErrInvalidProjectConfig     = errors.New("project config is invalid")
+	ErrWorkspaceWithoutExpiry   = errors.New("workspace does not expire")
+	ErrWorkspaceAlreadyOnTarget = errors.New("workspace is already on the target")
+	ErrProjectChangesNotPushed  = errors.New("the commit of the project has not been pushed, push it before copying the uncommitted changes")
 )
 
 func IsWorkspaceAlreadyExists(err error) bool {
@@ -28,6 +36,34 @@ func IsProjectNotFound(err error) bool {
 	return err.Error() == ErrProjectNotFound.Error()
 }
 
+func IsProjectAlreadyExists(err error) bool {
+	return err.Error() == ErrProjectAlreadyExists.Error()
+}
+
+func IsLastProject(err error) bool {
+	return err.Error() == ErrLastProject.Error()
+}
+
+func IsWorkspaceNotStarted(err error) bool {
+	return err.Error() == ErrWorkspaceNotStarted.Error()
+}
+
+func IsProjectNotStarted(err error) bool {
+	return err.Error() == ErrProjectNotStarted.Error()
+}
+
+func IsSnapshotAlreadyExists(err error) bool {
+	return err.Error() == ErrSnapshotAlreadyExists.Error()
+}
+
 func IsInvalidWorkspaceName(err error) bool {
 	return err.Error() == ErrInvalidWorkspaceName.Error()
 }
+
+func IsWorkspaceWithoutExpiry(err error) bool {
+	return err.Error() == ErrWorkspaceWithoutExpiry.Error()
+}
+
+func IsWorkspaceAlreadyOnTarget(err error) bool {
+	return err.Error() == ErrWorkspaceAlreadyOnTarget.Error()
+}
@@ -0,0 +1,37 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/workspace"
+)
+
+// ExtendWorkspace postpones the expiry of the workspace by the given duration.
+// Workspaces that have already expired but were not removed yet are extended from the current time.
+func (s *WorkspaceService) ExtendWorkspace(workspaceId string, duration time.Duration) (*workspace.Workspace, error) {
+	ws, err := s.updateWorkspace(workspaceId, func(ws *workspace.Workspace) error {
+		if ws.ExpiresAt == nil {
+			return ErrWorkspaceWithoutExpiry
+		}
+
+		expiresAt := *ws.ExpiresAt
+		if expiresAt.Before(time.Now()) {
+			expiresAt = time.Now()
+		}
+		expiresAt = expiresAt.Add(duration)
+		ws.ExpiresAt = &expiresAt
+
+		return nil
+	})
+	if err != nil {
+		if workspace.IsWorkspaceNotFound(err) {
+			return nil, ErrWorkspaceNotFound
+		}
+		return nil, err
+	}
+
+	return ws, nil
+}
@@ -0,0 +1,125 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/lifecycle"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	log "github.com/sirupsen/logrus"
+)
+
+// updateWorkspace applies the update to the stored workspace and saves it.
+// All workspace writes go through it so that concurrent operations, e.g. a project heartbeat during a start,
+// do not overwrite each other's changes with a stale copy of the workspace.
+func (s *WorkspaceService) updateWorkspace(workspaceId string, update func(ws *workspace.Workspace) error) (*workspace.Workspace, error) {
+	s.lifecycleMutex.Lock()
+	defer s.lifecycleMutex.Unlock()
+
+	ws, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = update(ws)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.workspaceStore.Save(ws)
+	if err != nil {
+		return nil, err
+	}
+
+	return ws, nil
+}
+
+// updateProject applies the update to the project of the stored workspace and saves the workspace
+func (s *WorkspaceService) updateProject(workspaceId, projectName string, update func(p *project.Project) error) (*workspace.Workspace, error) {
+	return s.updateWorkspace(workspaceId, func(ws *workspace.Workspace) error {
+		for _, p := range ws.Projects {
+			if p.Name == projectName {
+				return update(p)
+			}
+		}
+
+		return ErrProjectNotFound
+	})
+}
+
+// transitionWorkspace moves the workspace to the given lifecycle state and persists it
+func (s *WorkspaceService) transitionWorkspace(ws *workspace.Workspace, state lifecycle.State) error {
+	stored, err := s.updateWorkspace(ws.Id, func(stored *workspace.Workspace) error {
+		return stored.Lifecycle.Transition(state)
+	})
+	if err != nil {
+		return err
+	}
+
+	ws.Lifecycle = stored.Lifecycle
+	return nil
+}
+
+// transitionProject moves the project to the given lifecycle state and persists its workspace
+func (s *WorkspaceService) transitionProject(ws *workspace.Workspace, p *project.Project, state lifecycle.State) error {
+	_, err := s.updateProject(ws.Id, p.Name, func(stored *project.Project) error {
+		err := stored.Lifecycle.Transition(state)
+		if err != nil {
+			return err
+		}
+
+		p.Lifecycle = stored.Lifecycle
+		return nil
+	})
+
+	return err
+}
+
+// failWorkspace records the error on the workspace and on every project that was in the middle of an operation
+func (s *WorkspaceService) failWorkspace(ws *workspace.Workspace, err error) {
+	stored, saveErr := s.updateWorkspace(ws.Id, func(stored *workspace.Workspace) error {
+		stored.Lifecycle.Fail(err)
+
+		for _, p := range stored.Projects {
+			if isTransitional(p.Lifecycle.State) {
+				p.Lifecycle.Fail(err)
+			}
+		}
+
+		return nil
+	})
+	if saveErr != nil {
+		log.Errorf("failed to save the error state of workspace %s: %s", ws.Id, saveErr)
+		return
+	}
+
+	ws.Lifecycle = stored.Lifecycle
+	for _, p := range ws.Projects {
+		for _, storedProject := range stored.Projects {
+			if storedProject.Name == p.Name {
+				p.Lifecycle = storedProject.Lifecycle
+			}
+		}
+	}
+}
+
+func (s *WorkspaceService) failProject(ws *workspace.Workspace, p *project.Project, err error) {
+	_, saveErr := s.updateProject(ws.Id, p.Name, func(stored *project.Project) error {
+		stored.Lifecycle.Fail(err)
+		p.Lifecycle = stored.Lifecycle
+		return nil
+	})
+	if saveErr != nil {
+		log.Errorf("failed to save the error state of project %s: %s", p.Name, saveErr)
+	}
+}
+
+func isTransitional(state lifecycle.State) bool {
+	switch state {
+	case lifecycle.StateCreating, lifecycle.StateStarting, lifecycle.StateStopping, lifecycle.StateDeleting:
+		return true
+	}
+
+	return false
+}
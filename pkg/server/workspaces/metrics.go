@@ -0,0 +1,89 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+// Number of samples kept for each project, about 5 minutes at the agent's reporting interval of 2 seconds
+const metricsHistorySize = 150
+
+// GetProjectMetrics returns the recent resource usage samples of the project, oldest first.
+// The history is kept in memory and starts over when the server restarts.
+func (s *WorkspaceService) GetProjectMetrics(workspaceId, projectName string) ([]project.ProjectMetrics, error) {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	p, err := w.GetProject(projectName)
+	if err != nil {
+		return nil, ErrProjectNotFound
+	}
+
+	return s.metricsHistory.get(w.Id, p.Name), nil
+}
+
+type metricsHistory struct {
+	mutex   sync.Mutex
+	samples map[string][]project.ProjectMetrics
+}
+
+func (h *metricsHistory) add(workspaceId, projectName string, metrics project.ProjectMetrics) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	if h.samples == nil {
+		h.samples = map[string][]project.ProjectMetrics{}
+	}
+
+	key := getMetricsKey(workspaceId, projectName)
+
+	samples := append(h.samples[key], metrics)
+	if len(samples) > metricsHistorySize {
+		samples = samples[len(samples)-metricsHistorySize:]
+	}
+
+	h.samples[key] = samples
+}
+
+func (h *metricsHistory) get(workspaceId, projectName string) []project.ProjectMetrics {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	samples := slices.Clone(h.samples[getMetricsKey(workspaceId, projectName)])
+	if samples == nil {
+		return []project.ProjectMetrics{}
+	}
+
+	return samples
+}
+
+func (h *metricsHistory) removeProject(workspaceId, projectName string) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	delete(h.samples, getMetricsKey(workspaceId, projectName))
+}
+
+func (h *metricsHistory) removeWorkspace(workspaceId string) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	for key := range h.samples {
+		if strings.HasPrefix(key, workspaceId+"/") {
+			delete(h.samples, key)
+		}
+	}
+}
+
+func getMetricsKey(workspaceId, projectName string) string {
+	return fmt.Sprintf("%s/%s", workspaceId, projectName)
+}
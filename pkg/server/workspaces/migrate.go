@@ -0,0 +1,168 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/lifecycle"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	log "github.com/sirupsen/logrus"
+)
+
+// MigrateWorkspace recreates the workspace on another target and then destroys its resources and snapshots on the current target.
+// The uncommitted changes of started projects are fetched from their agents and applied to the recreated projects,
+// which are cloned at the commit the changes are based on. Migration is refused if that commit has not been pushed.
+// If the workspace cannot be created on the new target, it is left on the current target.
+func (s *WorkspaceService) MigrateWorkspace(ctx context.Context, workspaceId, targetName string) (*workspace.Workspace, error) {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	if w.Target == targetName {
+		return nil, ErrWorkspaceAlreadyOnTarget
+	}
+
+	if isTransitional(w.Lifecycle.State) {
+		return nil, fmt.Errorf("%w: workspace %s is %s", lifecycle.ErrInvalidTransition, w.Name, w.Lifecycle.State)
+	}
+
+	for _, p := range w.Projects {
+		err = checkProjectIsSettled(p)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	oldTarget, err := s.targetStore.Find(w.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	newTarget, err := s.targetStore.Find(targetName)
+	if err != nil {
+		return nil, err
+	}
+
+	wsLogger := s.loggerFactory.CreateWorkspaceLogger(w.Id, logs.LogSourceServer)
+	defer wsLogger.Close()
+
+	wsLogger.Write([]byte(fmt.Sprintf("Migrating workspace %s from target %s to %s\n", w.Name, oldTarget.Name, newTarget.Name)))
+
+	patches := map[string]*project.Patch{}
+	repositories := map[string]*gitprovider.GitRepository{}
+	for _, p := range w.Projects {
+		if p.Lifecycle.State != lifecycle.StateStarted {
+			wsLogger.Write([]byte(fmt.Sprintf("Project %s is not started, its uncommitted changes are not migrated\n", p.Name)))
+			continue
+		}
+
+		patch, err := s.getProjectPatch(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+
+		repositories[p.Name], err = withPatch(p.Repository, p.Name, patch)
+		if err != nil {
+			return nil, err
+		}
+		patches[p.Name] = patch
+	}
+
+	oldWorkspace := copyWorkspace(w)
+
+	w.Target = newTarget.Name
+	w.Lifecycle = lifecycle.New()
+
+	for _, p := range w.Projects {
+		p.Target = newTarget.Name
+		p.Lifecycle = lifecycle.New()
+
+		if patch, ok := patches[p.Name]; ok {
+			p.Repository = repositories[p.Name]
+			p.Patch = patch.Diff
+		}
+		// The state is reported again by the agent on the new target
+		p.State = nil
+	}
+
+	err = s.workspaceStore.Save(w)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.createWorkspace(ctx, w, newTarget)
+	if err != nil {
+		wsLogger.Write([]byte(fmt.Sprintf("Failed to migrate workspace %s: %s\n", w.Name, err)))
+		wsLogger.Write([]byte(fmt.Sprintf("Removing the resources created on target %s\n", newTarget.Name)))
+
+		destroyErr := s.destroyWorkspaceResources(w, newTarget)
+		if destroyErr != nil {
+			log.Error(destroyErr)
+		}
+
+		saveErr := s.workspaceStore.Save(oldWorkspace)
+		if saveErr != nil {
+			return nil, errors.Join(err, saveErr)
+		}
+
+		return nil, err
+	}
+
+	wsLogger.Write([]byte(fmt.Sprintf("Removing the resources on target %s\n", oldTarget.Name)))
+
+	// Snapshots are stored on the old target and cannot be restored on the new one
+	for _, p := range oldWorkspace.Projects {
+		s.deleteProjectSnapshots(p, oldTarget)
+	}
+
+	err = s.destroyWorkspaceResources(oldWorkspace, oldTarget)
+	if err != nil {
+		// The workspace has been migrated so the leftovers on the old target are only reported
+		wsLogger.Write([]byte(fmt.Sprintf("Failed to remove the resources on target %s: %s\n", oldTarget.Name, err)))
+		log.Error(err)
+	}
+
+	wsLogger.Write([]byte(fmt.Sprintf("Workspace %s migrated to target %s\n", w.Name, newTarget.Name)))
+
+	return w, nil
+}
+
+// destroyWorkspaceResources destroys the projects and the workspace on the target without changing the stored workspace
+func (s *WorkspaceService) destroyWorkspaceResources(w *workspace.Workspace, target *provider.ProviderTarget) error {
+	var errs []error
+
+	for _, p := range w.Projects {
+		err := s.provisioner.DestroyProject(p, target)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	err := s.provisioner.DestroyWorkspace(w, target)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+func copyWorkspace(w *workspace.Workspace) *workspace.Workspace {
+	workspaceCopy := *w
+	workspaceCopy.Projects = []*project.Project{}
+
+	for _, p := range w.Projects {
+		projectCopy := *p
+		workspaceCopy.Projects = append(workspaceCopy.Projects, &projectCopy)
+	}
+
+	return &workspaceCopy
+}
@@ -0,0 +1,42 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+// Maximum number of projects of a single workspace that are provisioned at the same time
+const maxConcurrentProjectOperations = 4
+
+// forEachProject runs fn for every project concurrently, bounded by maxConcurrentProjectOperations.
+// A failing project does not stop the others; the returned error joins the errors of all failed projects.
+func forEachProject(projects []*project.Project, fn func(p *project.Project) error) error {
+	var wg sync.WaitGroup
+	workers := make(chan struct{}, maxConcurrentProjectOperations)
+	errs := make([]error, len(projects))
+
+	for i, p := range projects {
+		wg.Add(1)
+		workers <- struct{}{}
+
+		go func(i int, p *project.Project) {
+			defer wg.Done()
+			defer func() { <-workers }()
+
+			err := fn(p)
+			if err != nil {
+				errs[i] = fmt.Errorf("project %s: %w", p.Name, err)
+			}
+		}(i, p)
+	}
+
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
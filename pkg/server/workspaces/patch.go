@@ -0,0 +1,135 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	toolbox_config "github.com/daytonaio/daytona/pkg/agent/toolbox/config"
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/lifecycle"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+const getPatchTimeout = time.Minute
+
+// projectNetwork connects the server to the project agents
+type projectNetwork interface {
+	HTTPClient() *http.Client
+}
+
+// GetProjectPatch returns the uncommitted changes of the project, computed by its agent at the time of the request
+func (s *WorkspaceService) GetProjectPatch(ctx context.Context, workspaceId, projectName string) (string, error) {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return "", ErrWorkspaceNotFound
+	}
+
+	p, err := w.GetProject(projectName)
+	if err != nil {
+		return "", ErrProjectNotFound
+	}
+
+	patch, err := s.getProjectPatch(ctx, p)
+	if err != nil {
+		return "", err
+	}
+
+	return patch.Diff, nil
+}
+
+// GetPendingProjectPatch returns the uncommitted changes that the agent has to apply after cloning the repository
+func (s *WorkspaceService) GetPendingProjectPatch(workspaceId, projectName string) (string, error) {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return "", ErrWorkspaceNotFound
+	}
+
+	p, err := w.GetProject(projectName)
+	if err != nil {
+		return "", ErrProjectNotFound
+	}
+
+	return p.Patch, nil
+}
+
+// ClearPendingProjectPatch removes the uncommitted changes once the agent has applied them
+// so that they are no longer stored with the project
+func (s *WorkspaceService) ClearPendingProjectPatch(workspaceId, projectName string) error {
+	_, err := s.updateProject(workspaceId, projectName, func(p *project.Project) error {
+		p.Patch = ""
+		return nil
+	})
+	if err != nil && workspace.IsWorkspaceNotFound(err) {
+		return ErrWorkspaceNotFound
+	}
+
+	return err
+}
+
+func (s *WorkspaceService) getProjectPatch(ctx context.Context, p *project.Project) (*project.Patch, error) {
+	if p.Lifecycle.State != lifecycle.StateStarted {
+		return nil, ErrProjectNotStarted
+	}
+
+	if s.projectNetwork == nil {
+		return nil, errors.New("the project network is not available")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, getPatchTimeout)
+	defer cancel()
+
+	url := fmt.Sprintf("http://%s:%d/git/patch", project.GetProjectHostname(p.WorkspaceId, p.Name), toolbox_config.TOOLBOX_PORT)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.projectNetwork.HTTPClient().Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the uncommitted changes of project %s: %w", p.Name, err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get the uncommitted changes of project %s: %s", p.Name, strings.TrimSpace(string(body)))
+	}
+
+	var patch project.Patch
+	err = json.Unmarshal(body, &patch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the uncommitted changes of project %s: %w", p.Name, err)
+	}
+
+	return &patch, nil
+}
+
+// withPatch makes the repository point to the commit the patch is based on so that the patch applies cleanly.
+// Commits that have not been pushed cannot be cloned, so the patch is refused for them
+func withPatch(repository *gitprovider.GitRepository, projectName string, patch *project.Patch) (*gitprovider.GitRepository, error) {
+	if patch.Branch == "" {
+		return nil, fmt.Errorf("%w: project %s", ErrProjectChangesNotPushed, projectName)
+	}
+
+	repositoryAtPatch := *repository
+	repositoryAtPatch.Branch = patch.Branch
+	repositoryAtPatch.Sha = patch.Sha
+	repositoryAtPatch.Target = gitprovider.CloneTargetCommit
+
+	return &repositoryAtPatch, nil
+}
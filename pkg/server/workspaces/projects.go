@@ -0,0 +1,152 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/lifecycle"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	log "github.com/sirupsen/logrus"
+)
+
+// AddProject provisions and starts a new project in an existing, started workspace
+func (s *WorkspaceService) AddProject(ctx context.Context, workspaceId string, req dto.CreateProjectDTO) (*project.Project, error) {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	_, err = w.GetProject(req.Name)
+	if err == nil {
+		return nil, ErrProjectAlreadyExists
+	}
+
+	if w.Lifecycle.State != lifecycle.StateStarted {
+		return nil, ErrWorkspaceNotStarted
+	}
+
+	target, err := s.targetStore.Find(w.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := s.newProject(w, req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.appendProject(w, p)
+	if err != nil {
+		return nil, err
+	}
+
+	projectLogger := s.loggerFactory.CreateProjectLogger(w.Id, p.Name, logs.LogSourceServer)
+	defer projectLogger.Close()
+
+	err = s.createProject(ctx, p, target, projectLogger)
+	if err != nil {
+		s.failProject(w, p, err)
+		return p, err
+	}
+
+	return p, s.startProject(ctx, w, p, target, projectLogger)
+}
+
+// RemoveProject destroys a single project and removes it from its workspace.
+// The last project of a workspace cannot be removed; the workspace should be removed instead.
+func (s *WorkspaceService) RemoveProject(ctx context.Context, workspaceId, projectName string) error {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return ErrWorkspaceNotFound
+	}
+
+	p, err := w.GetProject(projectName)
+	if err != nil {
+		return ErrProjectNotFound
+	}
+
+	if len(w.Projects) == 1 {
+		return ErrLastProject
+	}
+
+	target, err := s.targetStore.Find(p.Target)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Destroying project %s in workspace %s", p.Name, w.Id)
+
+	err = s.transitionProject(w, p, lifecycle.StateDeleting)
+	if err != nil {
+		return err
+	}
+
+	s.deleteProjectSnapshots(p, target)
+
+	err = s.provisioner.DestroyProject(p, target)
+	if err != nil {
+		s.failProject(w, p, err)
+		return err
+	}
+
+	err = s.apiKeyService.Revoke(fmt.Sprintf("%s/%s", w.Id, p.Name))
+	if err != nil {
+		// Should not fail the whole operation if the API key cannot be revoked
+		log.Error(err)
+	}
+
+	projectLogger := s.loggerFactory.CreateProjectLogger(w.Id, p.Name, logs.LogSourceServer)
+	err = projectLogger.Cleanup()
+	if err != nil {
+		// Should not fail the whole operation if the project logger cannot be cleaned up
+		log.Error(err)
+	}
+
+	s.metricsHistory.removeProject(w.Id, p.Name)
+
+	return s.deleteProject(w, p)
+}
+
+// appendProject adds the project to the stored workspace, which may have changed while the project was being prepared
+func (s *WorkspaceService) appendProject(w *workspace.Workspace, p *project.Project) error {
+	_, err := s.updateWorkspace(w.Id, func(stored *workspace.Workspace) error {
+		_, err := stored.GetProject(p.Name)
+		if err == nil {
+			return ErrProjectAlreadyExists
+		}
+
+		stored.Projects = append(stored.Projects, p)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	w.Projects = append(w.Projects, p)
+	return nil
+}
+
+// deleteProject removes the project from the stored workspace, leaving the other projects as they are currently stored
+func (s *WorkspaceService) deleteProject(w *workspace.Workspace, p *project.Project) error {
+	isProject := func(wp *project.Project) bool {
+		return wp.Name == p.Name
+	}
+
+	_, err := s.updateWorkspace(w.Id, func(stored *workspace.Workspace) error {
+		stored.Projects = slices.DeleteFunc(stored.Projects, isProject)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	w.Projects = slices.DeleteFunc(w.Projects, isProject)
+	return nil
+}
@@ -8,7 +8,9 @@ import (
 	"fmt"
 
 	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/server/events"
 	"github.com/daytonaio/daytona/pkg/telemetry"
+	"github.com/daytonaio/daytona/pkg/workspace/lifecycle"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -25,16 +27,25 @@ func (s *WorkspaceService) RemoveWorkspace(ctx context.Context, workspaceId stri
 		return err
 	}
 
+	err = s.transitionWorkspace(workspace, lifecycle.StateDeleting)
+	if err != nil {
+		return err
+	}
+
 	for _, project := range workspace.Projects {
+		s.deleteProjectSnapshots(project, target)
+
 		//	todo: go routines
 		err := s.provisioner.DestroyProject(project, target)
 		if err != nil {
+			s.failWorkspace(workspace, err)
 			return err
 		}
 	}
 
 	err = s.provisioner.DestroyWorkspace(workspace, target)
 	if err != nil {
+		s.failWorkspace(workspace, err)
 		return err
 	}
 
@@ -65,7 +76,10 @@ func (s *WorkspaceService) RemoveWorkspace(ctx context.Context, workspaceId stri
 		log.Error(err)
 	}
 
+	s.metricsHistory.removeWorkspace(workspace.Id)
+
 	err = s.workspaceStore.Delete(workspace)
+	s.publishWorkspaceEvent(events.EventTypeWorkspaceRemoved, workspace.Id, err)
 
 	if !telemetry.TelemetryEnabled(ctx) {
 		return err
@@ -98,7 +112,14 @@ func (s *WorkspaceService) ForceRemoveWorkspace(ctx context.Context, workspaceId
 
 	target, _ := s.targetStore.Find(workspace.Target)
 
+	err = s.transitionWorkspace(workspace, lifecycle.StateDeleting)
+	if err != nil {
+		log.Error(err)
+	}
+
 	for _, project := range workspace.Projects {
+		s.deleteProjectSnapshots(project, target)
+
 		//	todo: go routines
 		err := s.provisioner.DestroyProject(project, target)
 		if err != nil {
@@ -129,7 +150,10 @@ func (s *WorkspaceService) ForceRemoveWorkspace(ctx context.Context, workspaceId
 		}
 	}
 
+	s.metricsHistory.removeWorkspace(workspace.Id)
+
 	err = s.workspaceStore.Delete(workspace)
+	s.publishWorkspaceEvent(events.EventTypeWorkspaceRemoved, workspace.Id, err)
 
 	if !telemetry.TelemetryEnabled(ctx) {
 		return err
@@ -0,0 +1,54 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import "github.com/daytonaio/daytona/pkg/workspace"
+
+// GetProjectSecrets returns the values of the secrets referenced by the project keyed by name
+func (s *WorkspaceService) GetProjectSecrets(workspaceId, projectName string) (map[string]string, error) {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	p, err := w.GetProject(projectName)
+	if err != nil {
+		return nil, ErrProjectNotFound
+	}
+
+	if len(p.Secrets) == 0 {
+		return map[string]string{}, nil
+	}
+
+	return s.secretService.Resolve(p.Secrets)
+}
+
+// UsesGitProvider reports whether the repository of the project, or of any project of the workspace
+// if projectName is empty, is hosted on the git provider
+func (s *WorkspaceService) UsesGitProvider(workspaceId, projectName, gitProviderId string) (bool, error) {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		if workspace.IsWorkspaceNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	for _, p := range w.Projects {
+		if projectName != "" && p.Name != projectName {
+			continue
+		}
+
+		gc, err := s.gitProviderService.GetConfigForUrl(p.Repository.Url)
+		if err != nil {
+			continue
+		}
+
+		if gc.Id == gitProviderId {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -5,36 +5,57 @@ package workspaces
 
 import (
 	"context"
-	"errors"
 	"io"
+	"sync"
+	"time"
 
 	"github.com/daytonaio/daytona/pkg/logs"
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/provisioner"
+	secret_backend "github.com/daytonaio/daytona/pkg/secret/backend"
 	"github.com/daytonaio/daytona/pkg/server/apikeys"
 	"github.com/daytonaio/daytona/pkg/server/builds"
 	"github.com/daytonaio/daytona/pkg/server/containerregistries"
+	"github.com/daytonaio/daytona/pkg/server/events"
 	"github.com/daytonaio/daytona/pkg/server/gitproviders"
 	"github.com/daytonaio/daytona/pkg/server/projectconfig"
+	"github.com/daytonaio/daytona/pkg/server/secrets"
 	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
 	"github.com/daytonaio/daytona/pkg/telemetry"
 	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/daytonaio/daytona/pkg/workspace/project/snapshot"
+	log "github.com/sirupsen/logrus"
 )
 
 type IWorkspaceService interface {
 	CreateWorkspace(ctx context.Context, req dto.CreateWorkspaceDTO) (*workspace.Workspace, error)
+	CloneWorkspace(ctx context.Context, workspaceId string, req dto.CloneWorkspaceDTO) (*workspace.Workspace, error)
 	GetWorkspace(ctx context.Context, workspaceId string) (*dto.WorkspaceDTO, error)
 	GetWorkspaceLogReader(workspaceId string) (io.Reader, error)
 	GetProjectLogReader(workspaceId, projectName string) (io.Reader, error)
 	ListWorkspaces(verbose bool) ([]dto.WorkspaceDTO, error)
 	RemoveWorkspace(ctx context.Context, workspaceId string) error
 	ForceRemoveWorkspace(ctx context.Context, workspaceId string) error
-	SetProjectState(workspaceId string, projectName string, state *project.ProjectState) (*workspace.Workspace, error)
+	SetProjectState(workspaceId string, projectName string, target string, state *project.ProjectState) (*workspace.Workspace, error)
 	StartProject(ctx context.Context, workspaceId string, projectName string) error
 	StartWorkspace(ctx context.Context, workspaceId string) error
 	StopProject(ctx context.Context, workspaceId string, projectName string) error
 	StopWorkspace(ctx context.Context, workspaceId string) error
+	ExtendWorkspace(workspaceId string, duration time.Duration) (*workspace.Workspace, error)
+	MigrateWorkspace(ctx context.Context, workspaceId string, targetName string) (*workspace.Workspace, error)
+	AddProject(ctx context.Context, workspaceId string, req dto.CreateProjectDTO) (*project.Project, error)
+	RemoveProject(ctx context.Context, workspaceId string, projectName string) error
+	SnapshotProject(ctx context.Context, workspaceId string, projectName string, name string) (*snapshot.Snapshot, error)
+	RestoreProject(ctx context.Context, workspaceId string, projectName string, snapshotId string) error
+	ListSnapshots(workspaceId string) ([]*snapshot.Snapshot, error)
+	DeleteSnapshot(ctx context.Context, workspaceId string, projectName string, snapshotId string) error
+	GetProjectPatch(ctx context.Context, workspaceId string, projectName string) (string, error)
+	GetPendingProjectPatch(workspaceId string, projectName string) (string, error)
+	ClearPendingProjectPatch(workspaceId string, projectName string) error
+	GetProjectMetrics(workspaceId string, projectName string) ([]project.ProjectMetrics, error)
+	GetProjectSecrets(workspaceId string, projectName string) (map[string]string, error)
+	UsesGitProvider(workspaceId string, projectName string, gitProviderId string) (bool, error)
 }
 
 type targetStore interface {
@@ -44,6 +65,7 @@ type targetStore interface {
 type WorkspaceServiceConfig struct {
 	WorkspaceStore           workspace.Store
 	TargetStore              targetStore
+	SnapshotStore            snapshot.Store
 	ContainerRegistryService containerregistries.IContainerRegistryService
 	BuildService             builds.IBuildService
 	ProjectConfigService     projectconfig.IProjectConfigService
@@ -55,13 +77,18 @@ type WorkspaceServiceConfig struct {
 	ApiKeyService            apikeys.IApiKeyService
 	LoggerFactory            logs.LoggerFactory
 	GitProviderService       gitproviders.IGitProviderService
+	SecretService            secrets.ISecretService
+	SecretResolver           secret_backend.IResolver
 	TelemetryService         telemetry.TelemetryService
+	EventBus                 events.IEventBus
+	ProjectNetwork           projectNetwork
 }
 
 func NewWorkspaceService(config WorkspaceServiceConfig) IWorkspaceService {
 	return &WorkspaceService{
 		workspaceStore:           config.WorkspaceStore,
 		targetStore:              config.TargetStore,
+		snapshotStore:            config.SnapshotStore,
 		containerRegistryService: config.ContainerRegistryService,
 		buildService:             config.BuildService,
 		projectConfigService:     config.ProjectConfigService,
@@ -73,13 +100,18 @@ func NewWorkspaceService(config WorkspaceServiceConfig) IWorkspaceService {
 		loggerFactory:            config.LoggerFactory,
 		apiKeyService:            config.ApiKeyService,
 		gitProviderService:       config.GitProviderService,
+		secretService:            config.SecretService,
+		secretResolver:           config.SecretResolver,
 		telemetryService:         config.TelemetryService,
+		eventBus:                 config.EventBus,
+		projectNetwork:           config.ProjectNetwork,
 	}
 }
 
 type WorkspaceService struct {
 	workspaceStore           workspace.Store
 	targetStore              targetStore
+	snapshotStore            snapshot.Store
 	containerRegistryService containerregistries.IContainerRegistryService
 	buildService             builds.IBuildService
 	projectConfigService     projectconfig.IProjectConfigService
@@ -91,23 +123,125 @@ type WorkspaceService struct {
 	defaultProjectUser       string
 	loggerFactory            logs.LoggerFactory
 	gitProviderService       gitproviders.IGitProviderService
+	secretService            secrets.ISecretService
+	secretResolver           secret_backend.IResolver
 	telemetryService         telemetry.TelemetryService
+	eventBus                 events.IEventBus
+	projectNetwork           projectNetwork
+	// Serializes lifecycle updates of projects that are provisioned concurrently
+	lifecycleMutex sync.Mutex
+	metricsHistory metricsHistory
 }
 
-func (s *WorkspaceService) SetProjectState(workspaceId, projectName string, state *project.ProjectState) (*workspace.Workspace, error) {
-	ws, err := s.workspaceStore.Find(workspaceId)
-	if err != nil {
-		return nil, err
+// SetProjectState stores the state reported by the project agent.
+// States reported from a target other than the project's are ignored, e.g. the agent left on the old target of a migrated workspace.
+// Agents that do not report their target are always accepted.
+func (s *WorkspaceService) SetProjectState(workspaceId, projectName, target string, state *project.ProjectState) (*workspace.Workspace, error) {
+	var previousState *project.ProjectState
+	ignored := false
+
+	ws, err := s.updateProject(workspaceId, projectName, func(p *project.Project) error {
+		if target != "" && target != p.Target {
+			log.Debugf("Ignoring state of project %s reported from target %s", p.Name, target)
+			ignored = true
+			return nil
+		}
+
+		previousState = p.State
+		p.State = state
+		return nil
+	})
+	if err != nil || ignored {
+		return ws, err
+	}
+
+	if state != nil && state.Metrics != nil {
+		s.metricsHistory.add(ws.Id, projectName, *state.Metrics)
+	}
+
+	// Agents report their state on every heartbeat, so only actual changes are published
+	if projectStateChanged(previousState, state) {
+		s.publishEvent(events.Event{
+			Type:        events.EventTypeProjectStateUpdated,
+			WorkspaceId: ws.Id,
+			ProjectName: projectName,
+			State:       getProjectRunState(state),
+		})
+	}
+
+	return ws, nil
+}
+
+func getProjectRunState(state *project.ProjectState) string {
+	if state == nil {
+		return ""
+	}
+
+	if state.Uptime > 0 {
+		return "running"
+	}
+
+	return "stopped"
+}
+
+func projectStateChanged(previous, current *project.ProjectState) bool {
+	if getProjectRunState(previous) != getProjectRunState(current) {
+		return true
+	}
+
+	if previous == nil || current == nil {
+		return false
 	}
 
-	for _, project := range ws.Projects {
-		if project.Name == projectName {
-			project.State = state
-			return ws, s.workspaceStore.Save(ws)
+	return !gitStatusEqual(previous.GitStatus, current.GitStatus)
+}
+
+func gitStatusEqual(a, b *project.GitStatus) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	if a.CurrentBranch != b.CurrentBranch || len(a.Files) != len(b.Files) {
+		return false
+	}
+
+	for i := range a.Files {
+		if a.Files[i] == nil || b.Files[i] == nil {
+			if a.Files[i] != b.Files[i] {
+				return false
+			}
+			continue
+		}
+
+		if *a.Files[i] != *b.Files[i] {
+			return false
 		}
 	}
 
-	return nil, errors.New("project not found")
+	return true
+}
+
+func (s *WorkspaceService) publishEvent(event events.Event) {
+	if s.eventBus == nil {
+		return
+	}
+
+	s.eventBus.Publish(event)
+}
+
+// publishWorkspaceEvent publishes eventType for the workspace, or a workspace error event if err is set
+func (s *WorkspaceService) publishWorkspaceEvent(eventType events.EventType, workspaceId string, err error) {
+	event := events.Event{
+		Type:        eventType,
+		WorkspaceId: workspaceId,
+	}
+
+	if err != nil {
+		event.Type = events.EventTypeWorkspaceError
+		event.Error = err.Error()
+	}
+
+	s.publishEvent(event)
 }
 
 func (s *WorkspaceService) GetWorkspaceLogReader(workspaceId string) (io.Reader, error) {
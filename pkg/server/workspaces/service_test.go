@@ -5,11 +5,17 @@ package workspaces_test
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
 	t_targets "github.com/daytonaio/daytona/internal/testing/provider/targets"
+	t_snapshots "github.com/daytonaio/daytona/internal/testing/server/snapshots"
 	t_workspaces "github.com/daytonaio/daytona/internal/testing/server/workspaces"
 	"github.com/daytonaio/daytona/internal/testing/server/workspaces/mocks"
 	"github.com/daytonaio/daytona/internal/util"
@@ -18,10 +24,13 @@ import (
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/logs"
 	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/server/events"
 	"github.com/daytonaio/daytona/pkg/server/workspaces"
 	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
 	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/lifecycle"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/daytonaio/daytona/pkg/workspace/project/snapshot"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -88,6 +97,9 @@ func TestWorkspaceService(t *testing.T) {
 	apiKeyService := mocks.NewMockApiKeyService()
 	gitProviderService := mocks.NewMockGitProviderService()
 	provisioner := mocks.NewMockProvisioner()
+	snapshotStore := t_snapshots.NewInMemorySnapshotStore()
+	eventBus := events.NewEventBus()
+	projectNetwork := newTestProjectNetwork(t)
 
 	wsLogsDir := t.TempDir()
 	buildLogsDir := t.TempDir()
@@ -95,6 +107,7 @@ func TestWorkspaceService(t *testing.T) {
 	service := workspaces.NewWorkspaceService(workspaces.WorkspaceServiceConfig{
 		WorkspaceStore:           workspaceStore,
 		TargetStore:              targetStore,
+		SnapshotStore:            snapshotStore,
 		ServerApiUrl:             serverApiUrl,
 		ServerUrl:                serverUrl,
 		ContainerRegistryService: containerRegistryService,
@@ -105,6 +118,8 @@ func TestWorkspaceService(t *testing.T) {
 		Provisioner:              provisioner,
 		LoggerFactory:            logs.NewLoggerFactory(&wsLogsDir, &buildLogsDir),
 		GitProviderService:       gitProviderService,
+		EventBus:                 eventBus,
+		ProjectNetwork:           projectNetwork,
 	})
 
 	t.Run("CreateWorkspace", func(t *testing.T) {
@@ -295,7 +310,7 @@ func TestWorkspaceService(t *testing.T) {
 
 		projectName := ws.Projects[0].Name
 		updatedAt := time.Now().Format(time.RFC1123)
-		res, err := service.SetProjectState(ws.Id, projectName, &project.ProjectState{
+		res, err := service.SetProjectState(ws.Id, projectName, target.Name, &project.ProjectState{
 			UpdatedAt: updatedAt,
 			Uptime:    10,
 			GitStatus: &project.GitStatus{
@@ -309,6 +324,389 @@ func TestWorkspaceService(t *testing.T) {
 		require.Equal(t, "main", project.State.GitStatus.CurrentBranch)
 	})
 
+	t.Run("SetProjectState publishes only state changes", func(t *testing.T) {
+		projectName := createWorkspaceDto.Projects[0].Name
+
+		stateEvents, unsubscribe := eventBus.Subscribe(&events.EventFilter{
+			Types: []events.EventType{events.EventTypeProjectStateUpdated},
+		})
+		defer unsubscribe()
+
+		setState := func(branch string) {
+			_, err := service.SetProjectState(createWorkspaceDto.Id, projectName, "", &project.ProjectState{
+				UpdatedAt: time.Now().Format(time.RFC1123),
+				Uptime:    20,
+				GitStatus: &project.GitStatus{
+					CurrentBranch: branch,
+					Files: []*project.FileStatus{
+						{Name: "README.md", Staging: project.Unmodified, Worktree: project.Modified},
+					},
+				},
+			})
+			require.Nil(t, err)
+		}
+
+		setState("main")
+		setState("main")
+		setState("main")
+		setState("feature")
+
+		require.Len(t, stateEvents, 2)
+		event := <-stateEvents
+		require.Equal(t, "running", event.State)
+		require.Equal(t, projectName, event.ProjectName)
+	})
+
+	t.Run("GetProjectMetrics", func(t *testing.T) {
+		projectName := createWorkspaceDto.Projects[0].Name
+
+		for i := 1; i <= 3; i++ {
+			_, err := service.SetProjectState(createWorkspaceDto.Id, projectName, "", &project.ProjectState{
+				UpdatedAt: time.Now().Format(time.RFC1123),
+				Uptime:    uint64(10 + i),
+				GitStatus: &project.GitStatus{
+					CurrentBranch: "main",
+				},
+				Metrics: &project.ProjectMetrics{
+					CpuUsage:    float64(i * 10),
+					MemoryUsage: 512,
+					MemoryLimit: 2048,
+				},
+			})
+			require.Nil(t, err)
+		}
+
+		metrics, err := service.GetProjectMetrics(createWorkspaceDto.Id, projectName)
+		require.Nil(t, err)
+		require.Len(t, metrics, 3)
+		require.Equal(t, float64(10), metrics[0].CpuUsage)
+		require.Equal(t, float64(30), metrics[2].CpuUsage)
+
+		_, err = service.GetProjectMetrics(createWorkspaceDto.Id, "missing")
+		require.Equal(t, workspaces.ErrProjectNotFound, err)
+	})
+
+	t.Run("ExtendWorkspace", func(t *testing.T) {
+		ws, err := workspaceStore.Find(createWorkspaceDto.Id)
+		require.Nil(t, err)
+
+		_, err = service.ExtendWorkspace(ws.Id, time.Hour)
+		require.Equal(t, workspaces.ErrWorkspaceWithoutExpiry, err)
+
+		expiresAt := time.Now().Add(time.Hour)
+		ws.ExpiresAt = &expiresAt
+		require.Nil(t, workspaceStore.Save(ws))
+
+		res, err := service.ExtendWorkspace(ws.Id, time.Hour)
+		require.Nil(t, err)
+		require.Equal(t, expiresAt.Add(time.Hour), *res.ExpiresAt)
+	})
+
+	t.Run("SnapshotProject", func(t *testing.T) {
+		projectName := createWorkspaceDto.Projects[0].Name
+
+		provisioner.On("SnapshotProject", mock.Anything, &target, mock.Anything).Return(nil)
+
+		snap, err := service.SnapshotProject(context.TODO(), createWorkspaceDto.Id, projectName, "before-upgrade")
+		require.Nil(t, err)
+		require.Equal(t, "before-upgrade", snap.Name)
+		require.Equal(t, projectName, snap.ProjectName)
+
+		_, err = service.SnapshotProject(context.TODO(), createWorkspaceDto.Id, projectName, "before-upgrade")
+		require.Equal(t, workspaces.ErrSnapshotAlreadyExists, err)
+
+		snapshots, err := service.ListSnapshots(createWorkspaceDto.Id)
+		require.Nil(t, err)
+		require.Len(t, snapshots, 1)
+	})
+
+	t.Run("RestoreProject", func(t *testing.T) {
+		projectName := createWorkspaceDto.Projects[0].Name
+
+		snapshots, err := service.ListSnapshots(createWorkspaceDto.Id)
+		require.Nil(t, err)
+
+		provisioner.On("RestoreProject", mock.Anything, &target, snapshots[0].Id).Return(nil)
+
+		err = service.RestoreProject(context.TODO(), createWorkspaceDto.Id, projectName, "before-upgrade")
+		require.Nil(t, err)
+
+		ws, err := workspaceStore.Find(createWorkspaceDto.Id)
+		require.Nil(t, err)
+
+		p, err := ws.GetProject(projectName)
+		require.Nil(t, err)
+		require.Equal(t, lifecycle.StateStarted, p.Lifecycle.State)
+
+		err = service.RestoreProject(context.TODO(), createWorkspaceDto.Id, projectName, "missing")
+		require.Equal(t, snapshot.ErrSnapshotNotFound, err)
+	})
+
+	t.Run("DeleteSnapshot", func(t *testing.T) {
+		projectName := createWorkspaceDto.Projects[0].Name
+
+		snapshots, err := service.ListSnapshots(createWorkspaceDto.Id)
+		require.Nil(t, err)
+		require.Len(t, snapshots, 1)
+
+		provisioner.On("DeleteSnapshot", mock.Anything, &target, mock.Anything).Return(nil)
+
+		err = service.DeleteSnapshot(context.TODO(), createWorkspaceDto.Id, projectName, "before-upgrade")
+		require.Nil(t, err)
+
+		provisioner.AssertCalled(t, "DeleteSnapshot", mock.Anything, &target, snapshots[0].Id)
+
+		snapshots, err = service.ListSnapshots(createWorkspaceDto.Id)
+		require.Nil(t, err)
+		require.Empty(t, snapshots)
+
+		err = service.DeleteSnapshot(context.TODO(), createWorkspaceDto.Id, projectName, "before-upgrade")
+		require.Equal(t, snapshot.ErrSnapshotNotFound, err)
+	})
+
+	t.Run("AddProject", func(t *testing.T) {
+		projectDto := createWorkspaceDto.Projects[0]
+		projectDto.Name = "project2"
+		projectDto.Resources = &project.ResourceLimits{
+			Cpus:   2,
+			Memory: 4096,
+		}
+
+		apiKeyService.On("Generate", apikey.ApiKeyTypeProject, fmt.Sprintf("%s/%s", createWorkspaceDto.Id, projectDto.Name)).Return(projectDto.Name, nil)
+
+		p, err := service.AddProject(context.TODO(), createWorkspaceDto.Id, projectDto)
+		require.Nil(t, err)
+		require.Equal(t, lifecycle.StateStarted, p.Lifecycle.State)
+
+		ws, err := workspaceStore.Find(createWorkspaceDto.Id)
+		require.Nil(t, err)
+		require.Len(t, ws.Projects, 2)
+		require.Equal(t, createWorkspaceDto.Id, ws.Projects[1].WorkspaceId)
+		require.Equal(t, projectDto.Resources, ws.Projects[1].Resources)
+	})
+
+	t.Run("AddProject fails when project already exists", func(t *testing.T) {
+		_, err := service.AddProject(context.TODO(), createWorkspaceDto.Id, createWorkspaceDto.Projects[0])
+		require.Equal(t, workspaces.ErrProjectAlreadyExists, err)
+	})
+
+	t.Run("AddProject fails when workspace is not started", func(t *testing.T) {
+		ws, err := workspaceStore.Find(createWorkspaceDto.Id)
+		require.Nil(t, err)
+
+		ws.Lifecycle.State = lifecycle.StateStopped
+		require.Nil(t, workspaceStore.Save(ws))
+
+		projectDto := createWorkspaceDto.Projects[0]
+		projectDto.Name = "project3"
+
+		_, err = service.AddProject(context.TODO(), createWorkspaceDto.Id, projectDto)
+		require.Equal(t, workspaces.ErrWorkspaceNotStarted, err)
+
+		ws.Lifecycle.State = lifecycle.StateStarted
+		require.Nil(t, workspaceStore.Save(ws))
+	})
+
+	t.Run("RemoveProject", func(t *testing.T) {
+		require.Nil(t, snapshotStore.Save(&snapshot.Snapshot{
+			Id:          "project2-snapshot",
+			Name:        "project2-snapshot",
+			WorkspaceId: createWorkspaceDto.Id,
+			ProjectName: "project2",
+			CreatedAt:   time.Now(),
+		}))
+
+		err := service.RemoveProject(context.TODO(), createWorkspaceDto.Id, "project2")
+		require.Nil(t, err)
+
+		provisioner.AssertCalled(t, "DeleteSnapshot", mock.Anything, &target, "project2-snapshot")
+		_, err = snapshotStore.Find("project2-snapshot")
+		require.Equal(t, snapshot.ErrSnapshotNotFound, err)
+
+		ws, err := workspaceStore.Find(createWorkspaceDto.Id)
+		require.Nil(t, err)
+
+		_, err = ws.GetProject("project2")
+		require.NotNil(t, err)
+
+		apiKeyService.AssertCalled(t, "Revoke", fmt.Sprintf("%s/%s", createWorkspaceDto.Id, "project2"))
+	})
+
+	t.Run("RemoveProject fails for the last project", func(t *testing.T) {
+		err := service.RemoveProject(context.TODO(), createWorkspaceDto.Id, createWorkspaceDto.Projects[0].Name)
+		require.Equal(t, workspaces.ErrLastProject, err)
+	})
+
+	t.Run("CloneWorkspace", func(t *testing.T) {
+		projectName := createWorkspaceDto.Projects[0].Name
+		diff := "diff --git a/README.md b/README.md\n"
+		projectNetwork.patch = project.Patch{Sha: "456", Branch: "feature", Diff: diff}
+
+		cloneDto := dto.CloneWorkspaceDTO{
+			Id:          "test-clone",
+			Name:        "test-clone",
+			WithChanges: true,
+		}
+
+		apiKeyService.On("Generate", apikey.ApiKeyTypeWorkspace, cloneDto.Id).Return(cloneDto.Id, nil)
+		apiKeyService.On("Generate", apikey.ApiKeyTypeProject, fmt.Sprintf("%s/%s", cloneDto.Id, projectName)).Return(projectName, nil)
+
+		ws, err := service.CloneWorkspace(context.TODO(), createWorkspaceDto.Id, cloneDto)
+		require.Nil(t, err)
+		require.Equal(t, target.Name, ws.Target)
+		require.Len(t, ws.Projects, 1)
+
+		p := ws.Projects[0]
+		require.Equal(t, projectName, p.Name)
+		require.Equal(t, cloneDto.Id, p.WorkspaceId)
+		require.Equal(t, createWorkspaceDto.Projects[0].Source.Repository.Url, p.Repository.Url)
+		require.Equal(t, diff, p.Patch)
+		// The clone starts from the commit the changes are based on
+		require.Equal(t, "456", p.Repository.Sha)
+		require.Equal(t, "feature", p.Repository.Branch)
+		require.Equal(t, gitprovider.CloneTargetCommit, p.Repository.Target)
+		// The server env vars are only passed to the provisioner
+		require.NotContains(t, p.EnvVars, "DAYTONA_SERVER_API_KEY")
+		provisioner.AssertCalled(t, "CreateProject", mock.MatchedBy(func(p *project.Project) bool {
+			return p.WorkspaceId == cloneDto.Id && p.EnvVars["DAYTONA_WS_ID"] == cloneDto.Id && p.EnvVars["DAYTONA_SERVER_API_KEY"] == projectName
+		}), &target, mock.Anything, mock.Anything)
+
+		_, err = service.CloneWorkspace(context.TODO(), "missing", cloneDto)
+		require.Equal(t, workspaces.ErrWorkspaceNotFound, err)
+
+		projectNetwork.patch = project.Patch{Sha: "789", Diff: diff}
+		cloneDto.Id = "test-clone-unpushed"
+		cloneDto.Name = "test-clone-unpushed"
+
+		_, err = service.CloneWorkspace(context.TODO(), createWorkspaceDto.Id, cloneDto)
+		require.ErrorIs(t, err, workspaces.ErrProjectChangesNotPushed)
+
+		_, err = workspaceStore.Find(cloneDto.Id)
+		require.Equal(t, workspace.ErrWorkspaceNotFound, err)
+	})
+
+	t.Run("Pending project patch is cleared", func(t *testing.T) {
+		projectName := createWorkspaceDto.Projects[0].Name
+
+		patch, err := service.GetPendingProjectPatch("test-clone", projectName)
+		require.Nil(t, err)
+		require.Equal(t, "diff --git a/README.md b/README.md\n", patch)
+
+		require.Nil(t, service.ClearPendingProjectPatch("test-clone", projectName))
+
+		patch, err = service.GetPendingProjectPatch("test-clone", projectName)
+		require.Nil(t, err)
+		require.Empty(t, patch)
+
+		require.Equal(t, workspaces.ErrProjectNotFound, service.ClearPendingProjectPatch("test-clone", "missing"))
+	})
+
+	t.Run("GetProjectPatch", func(t *testing.T) {
+		projectName := createWorkspaceDto.Projects[0].Name
+		projectNetwork.patch = project.Patch{Sha: "123", Branch: "main", Diff: "diff --git a/new.go b/new.go\n"}
+
+		patch, err := service.GetProjectPatch(context.TODO(), createWorkspaceDto.Id, projectName)
+		require.Nil(t, err)
+		require.Equal(t, projectNetwork.patch.Diff, patch)
+
+		_, err = service.GetProjectPatch(context.TODO(), createWorkspaceDto.Id, "missing")
+		require.Equal(t, workspaces.ErrProjectNotFound, err)
+	})
+
+	t.Run("MigrateWorkspace", func(t *testing.T) {
+		newTarget := target
+		newTarget.Name = "test-target-2"
+		require.Nil(t, targetStore.Save(&newTarget))
+
+		workspaceId := "test-clone"
+		diff := "diff --git a/main.go b/main.go\n"
+
+		projectNetwork.patch = project.Patch{Sha: "999", Diff: diff}
+
+		_, err := service.MigrateWorkspace(context.TODO(), workspaceId, newTarget.Name)
+		require.ErrorIs(t, err, workspaces.ErrProjectChangesNotPushed)
+
+		ws, err := workspaceStore.Find(workspaceId)
+		require.Nil(t, err)
+		require.Equal(t, target.Name, ws.Target)
+
+		projectNetwork.patch = project.Patch{Sha: "999", Branch: "feature", Diff: diff}
+
+		require.Nil(t, snapshotStore.Save(&snapshot.Snapshot{
+			Id:          "clone-snapshot",
+			Name:        "clone-snapshot",
+			WorkspaceId: workspaceId,
+			ProjectName: ws.Projects[0].Name,
+			CreatedAt:   time.Now(),
+		}))
+
+		provisioner.On("CreateWorkspace", mock.Anything, &newTarget).Return(nil)
+		provisioner.On("StartWorkspace", mock.Anything, &newTarget).Return(nil)
+		provisioner.On("CreateProject", mock.Anything, &newTarget, mock.Anything, mock.Anything).Return(nil)
+		provisioner.On("StartProject", mock.Anything, &newTarget).Return(nil)
+
+		_, err = service.MigrateWorkspace(context.TODO(), workspaceId, target.Name)
+		require.Equal(t, workspaces.ErrWorkspaceAlreadyOnTarget, err)
+
+		_, err = service.MigrateWorkspace(context.TODO(), workspaceId, newTarget.Name)
+		require.Nil(t, err)
+
+		ws, err = workspaceStore.Find(workspaceId)
+		require.Nil(t, err)
+		require.Equal(t, newTarget.Name, ws.Target)
+		require.Equal(t, lifecycle.StateStarted, ws.Lifecycle.State)
+		require.Equal(t, newTarget.Name, ws.Projects[0].Target)
+		require.Equal(t, diff, ws.Projects[0].Patch)
+		require.Equal(t, "999", ws.Projects[0].Repository.Sha)
+		require.Equal(t, gitprovider.CloneTargetCommit, ws.Projects[0].Repository.Target)
+
+		// The snapshots on the old target are removed with the workspace
+		provisioner.AssertCalled(t, "DeleteSnapshot", mock.Anything, &target, "clone-snapshot")
+		_, err = snapshotStore.Find("clone-snapshot")
+		require.Equal(t, snapshot.ErrSnapshotNotFound, err)
+
+		provisioner.AssertCalled(t, "DestroyProject", mock.Anything, &target)
+		provisioner.AssertCalled(t, "DestroyWorkspace", mock.Anything, &target)
+
+		setState := func(target, branch string) *project.ProjectState {
+			res, err := service.SetProjectState(workspaceId, ws.Projects[0].Name, target, &project.ProjectState{
+				UpdatedAt: time.Now().Format(time.RFC1123),
+				Uptime:    10,
+				GitStatus: &project.GitStatus{CurrentBranch: branch},
+			})
+			require.Nil(t, err)
+
+			p, err := res.GetProject(ws.Projects[0].Name)
+			require.Nil(t, err)
+
+			return p.State
+		}
+
+		require.Equal(t, "main", setState(newTarget.Name, "main").GitStatus.CurrentBranch)
+		// The agent left on the old target is ignored
+		require.Equal(t, "main", setState(target.Name, "stale").GitStatus.CurrentBranch)
+	})
+
+	t.Run("MigrateWorkspace keeps the workspace on failure", func(t *testing.T) {
+		failingTarget := target
+		failingTarget.Name = "test-target-3"
+		require.Nil(t, targetStore.Save(&failingTarget))
+
+		workspaceId := "test-clone"
+
+		provisioner.On("CreateWorkspace", mock.Anything, &failingTarget).Return(errors.New("provider unavailable"))
+		provisioner.On("DestroyProject", mock.Anything, &failingTarget).Return(nil)
+		provisioner.On("DestroyWorkspace", mock.Anything, &failingTarget).Return(nil)
+
+		_, err := service.MigrateWorkspace(context.TODO(), workspaceId, failingTarget.Name)
+		require.NotNil(t, err)
+
+		ws, err := workspaceStore.Find(workspaceId)
+		require.Nil(t, err)
+		require.Equal(t, "test-target-2", ws.Target)
+		require.Equal(t, lifecycle.StateStarted, ws.Lifecycle.State)
+	})
+
 	t.Cleanup(func() {
 		apiKeyService.AssertExpectations(t)
 		provisioner.AssertExpectations(t)
@@ -364,3 +762,293 @@ func workspaceDtoEquals(t *testing.T, req dto.CreateWorkspaceDTO, workspace dto.
 		}
 	}
 }
+
+func TestWorkspaceService_StartProjectsConcurrently(t *testing.T) {
+	workspaceStore := t_workspaces.NewInMemoryWorkspaceStore()
+
+	targetStore := t_targets.NewInMemoryTargetStore()
+	err := targetStore.Save(&target)
+	require.Nil(t, err)
+
+	provisioner := mocks.NewMockProvisioner()
+
+	wsLogsDir := t.TempDir()
+	buildLogsDir := t.TempDir()
+
+	service := workspaces.NewWorkspaceService(workspaces.WorkspaceServiceConfig{
+		WorkspaceStore: workspaceStore,
+		TargetStore:    targetStore,
+		Provisioner:    provisioner,
+		LoggerFactory:  logs.NewLoggerFactory(&wsLogsDir, &buildLogsDir),
+	})
+
+	ws := &workspace.Workspace{
+		Id:        "multi",
+		Name:      "multi",
+		Target:    target.Name,
+		Lifecycle: lifecycle.Lifecycle{State: lifecycle.StateStopped},
+	}
+	for _, name := range []string{"project1", "project2", "project3"} {
+		ws.Projects = append(ws.Projects, &project.Project{
+			Name:        name,
+			WorkspaceId: ws.Id,
+			Target:      target.Name,
+			Repository:  &gitprovider.GitRepository{Url: "https://github.com/daytonaio/daytona"},
+			Lifecycle:   lifecycle.Lifecycle{State: lifecycle.StateStopped},
+		})
+	}
+	require.Nil(t, workspaceStore.Save(ws))
+
+	isProject := func(name string) interface{} {
+		return mock.MatchedBy(func(p *project.Project) bool { return p.Name == name })
+	}
+
+	provisioner.On("StartWorkspace", mock.Anything, &target).Return(nil)
+	provisioner.On("StartProject", isProject("project1"), &target).Return(nil)
+	provisioner.On("StartProject", isProject("project2"), &target).Return(errors.New("provider unavailable"))
+	provisioner.On("StartProject", isProject("project3"), &target).Return(nil)
+
+	err = service.StartWorkspace(context.TODO(), ws.Id)
+	require.ErrorContains(t, err, "project project2: provider unavailable")
+
+	ws, err = workspaceStore.Find(ws.Id)
+	require.Nil(t, err)
+	require.Equal(t, lifecycle.StateError, ws.Lifecycle.State)
+
+	for _, p := range ws.Projects {
+		if p.Name == "project2" {
+			require.Equal(t, lifecycle.StateError, p.Lifecycle.State)
+			require.Equal(t, "provider unavailable", p.Lifecycle.Error)
+			continue
+		}
+		require.Equal(t, lifecycle.StateStarted, p.Lifecycle.State)
+	}
+
+	provisioner.AssertExpectations(t)
+}
+
+func TestWorkspaceService_UpdatesDuringStart(t *testing.T) {
+	workspaceStore := t_workspaces.NewInMemoryWorkspaceStore()
+
+	targetStore := t_targets.NewInMemoryTargetStore()
+	err := targetStore.Save(&target)
+	require.Nil(t, err)
+
+	provisioner := mocks.NewMockProvisioner()
+
+	wsLogsDir := t.TempDir()
+	buildLogsDir := t.TempDir()
+
+	service := workspaces.NewWorkspaceService(workspaces.WorkspaceServiceConfig{
+		WorkspaceStore: workspaceStore,
+		TargetStore:    targetStore,
+		Provisioner:    provisioner,
+		LoggerFactory:  logs.NewLoggerFactory(&wsLogsDir, &buildLogsDir),
+	})
+
+	expiresAt := time.Now().Add(time.Hour)
+	ws := &workspace.Workspace{
+		Id:        "slow",
+		Name:      "slow",
+		Target:    target.Name,
+		ExpiresAt: &expiresAt,
+		Lifecycle: lifecycle.Lifecycle{State: lifecycle.StateStopped},
+		Projects: []*project.Project{{
+			Name:        "project1",
+			WorkspaceId: "slow",
+			Target:      target.Name,
+			Repository:  &gitprovider.GitRepository{Url: "https://github.com/daytonaio/daytona"},
+			Lifecycle:   lifecycle.Lifecycle{State: lifecycle.StateStopped},
+		}},
+	}
+	require.Nil(t, workspaceStore.Save(ws))
+
+	projectStarting := make(chan struct{})
+	releaseProject := make(chan struct{})
+
+	provisioner.On("StartWorkspace", mock.Anything, &target).Return(nil)
+	provisioner.On("StartProject", mock.Anything, &target).Run(func(args mock.Arguments) {
+		close(projectStarting)
+		<-releaseProject
+	}).Return(nil)
+
+	startErr := make(chan error)
+	go func() {
+		startErr <- service.StartWorkspace(context.TODO(), ws.Id)
+	}()
+
+	<-projectStarting
+
+	_, err = service.ExtendWorkspace(ws.Id, time.Hour)
+	require.Nil(t, err)
+
+	_, err = service.SetProjectState(ws.Id, "project1", "", &project.ProjectState{UpdatedAt: "now", Uptime: 10})
+	require.Nil(t, err)
+
+	close(releaseProject)
+	require.Nil(t, <-startErr)
+
+	ws, err = workspaceStore.Find(ws.Id)
+	require.Nil(t, err)
+	require.Equal(t, lifecycle.StateStarted, ws.Lifecycle.State)
+	require.Equal(t, expiresAt.Add(time.Hour), *ws.ExpiresAt)
+	require.Equal(t, lifecycle.StateStarted, ws.Projects[0].Lifecycle.State)
+	require.NotNil(t, ws.Projects[0].State)
+	require.Equal(t, uint64(10), ws.Projects[0].State.Uptime)
+}
+
+func TestWorkspaceService_ProjectChangesKeepConcurrentUpdates(t *testing.T) {
+	workspaceStore := t_workspaces.NewInMemoryWorkspaceStore()
+
+	targetStore := t_targets.NewInMemoryTargetStore()
+	err := targetStore.Save(&target)
+	require.Nil(t, err)
+
+	provisioner := mocks.NewMockProvisioner()
+	apiKeyService := mocks.NewMockApiKeyService()
+	gitProviderService := mocks.NewMockGitProviderService()
+	containerRegistryService := mocks.NewMockContainerRegistryService()
+
+	wsLogsDir := t.TempDir()
+	buildLogsDir := t.TempDir()
+
+	service := workspaces.NewWorkspaceService(workspaces.WorkspaceServiceConfig{
+		WorkspaceStore:           workspaceStore,
+		TargetStore:              targetStore,
+		SnapshotStore:            t_snapshots.NewInMemorySnapshotStore(),
+		ContainerRegistryService: containerRegistryService,
+		Provisioner:              provisioner,
+		ApiKeyService:            apiKeyService,
+		GitProviderService:       gitProviderService,
+		LoggerFactory:            logs.NewLoggerFactory(&wsLogsDir, &buildLogsDir),
+	})
+
+	ws := &workspace.Workspace{
+		Id:        "busy",
+		Name:      "busy",
+		Target:    target.Name,
+		Lifecycle: lifecycle.Lifecycle{State: lifecycle.StateStarted},
+	}
+	for _, name := range []string{"project1", "project2"} {
+		ws.Projects = append(ws.Projects, &project.Project{
+			Name:        name,
+			WorkspaceId: ws.Id,
+			Target:      target.Name,
+			Repository:  &gitprovider.GitRepository{Url: "https://github.com/daytonaio/daytona"},
+			Lifecycle:   lifecycle.Lifecycle{State: lifecycle.StateStarted},
+		})
+	}
+	require.Nil(t, workspaceStore.Save(ws))
+
+	reportState := func(uptime uint64) {
+		_, err := service.SetProjectState(ws.Id, "project1", "", &project.ProjectState{UpdatedAt: "now", Uptime: uptime})
+		require.Nil(t, err)
+	}
+
+	requireState := func(uptime uint64) {
+		stored, err := workspaceStore.Find(ws.Id)
+		require.Nil(t, err)
+
+		p, err := stored.GetProject("project1")
+		require.Nil(t, err)
+		require.NotNil(t, p.State)
+		require.Equal(t, uptime, p.State.Uptime)
+	}
+
+	t.Run("AddProject", func(t *testing.T) {
+		resolvingSha := make(chan struct{})
+		releaseSha := make(chan struct{})
+
+		repository := &gitprovider.GitRepository{Url: "https://github.com/daytonaio/daytona-new"}
+		gitProviderService.On("GetLastCommitSha", repository).Run(func(args mock.Arguments) {
+			close(resolvingSha)
+			<-releaseSha
+		}).Return("123", nil)
+		apiKeyService.On("Generate", apikey.ApiKeyTypeProject, "busy/project3").Return("project3", nil)
+		var containerRegistry *containerregistry.ContainerRegistry
+		containerRegistryService.On("FindByImageName", mock.Anything).Return(containerRegistry, containerregistry.ErrContainerRegistryNotFound)
+		gitProviderService.On("GetConfigForUrl", repository.Url).Return(&gitprovider.GitProviderConfig{}, nil)
+		provisioner.On("CreateProject", mock.Anything, &target, mock.Anything, mock.Anything).Return(nil)
+		provisioner.On("StartProject", mock.Anything, &target).Return(nil)
+
+		addErr := make(chan error)
+		go func() {
+			_, err := service.AddProject(context.TODO(), ws.Id, dto.CreateProjectDTO{
+				Name:   "project3",
+				Source: dto.CreateProjectSourceDTO{Repository: repository},
+			})
+			addErr <- err
+		}()
+
+		<-resolvingSha
+		reportState(10)
+		close(releaseSha)
+		require.Nil(t, <-addErr)
+
+		requireState(10)
+
+		stored, err := workspaceStore.Find(ws.Id)
+		require.Nil(t, err)
+		require.Len(t, stored.Projects, 3)
+	})
+
+	t.Run("RemoveProject", func(t *testing.T) {
+		destroying := make(chan struct{})
+		releaseDestroy := make(chan struct{})
+
+		provisioner.On("DestroyProject", mock.Anything, &target).Run(func(args mock.Arguments) {
+			close(destroying)
+			<-releaseDestroy
+		}).Return(nil)
+		apiKeyService.On("Revoke", "busy/project2").Return(nil)
+
+		removeErr := make(chan error)
+		go func() {
+			removeErr <- service.RemoveProject(context.TODO(), ws.Id, "project2")
+		}()
+
+		<-destroying
+		reportState(20)
+		close(releaseDestroy)
+		require.Nil(t, <-removeErr)
+
+		requireState(20)
+
+		stored, err := workspaceStore.Find(ws.Id)
+		require.Nil(t, err)
+		require.Len(t, stored.Projects, 2)
+
+		_, err = stored.GetProject("project2")
+		require.NotNil(t, err)
+	})
+}
+
+// testProjectNetwork routes all requests to a local server that returns the patch of any project
+type testProjectNetwork struct {
+	server *httptest.Server
+	patch  project.Patch
+}
+
+func newTestProjectNetwork(t *testing.T) *testProjectNetwork {
+	n := &testProjectNetwork{}
+	n.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/git/patch" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(n.patch)
+	}))
+	t.Cleanup(n.server.Close)
+
+	return n
+}
+
+func (n *testProjectNetwork) HTTPClient() *http.Client {
+	return &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+				return net.Dial(network, n.server.Listener.Addr().String())
+			},
+		},
+	}
+}
@@ -0,0 +1,242 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/workspace/lifecycle"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/daytonaio/daytona/pkg/workspace/project/snapshot"
+	"github.com/docker/docker/pkg/stringid"
+	log "github.com/sirupsen/logrus"
+)
+
+// SnapshotProject saves the current state of the project through its provider.
+// The snapshot name defaults to its ID.
+func (s *WorkspaceService) SnapshotProject(ctx context.Context, workspaceId, projectName, name string) (*snapshot.Snapshot, error) {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	p, err := w.GetProject(projectName)
+	if err != nil {
+		return nil, ErrProjectNotFound
+	}
+
+	err = checkProjectIsSettled(p)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots, err := s.snapshotStore.List(w.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, snap := range snapshots {
+		if name != "" && snap.ProjectName == p.Name && snap.Name == name {
+			return nil, ErrSnapshotAlreadyExists
+		}
+	}
+
+	target, err := s.targetStore.Find(p.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	snap := &snapshot.Snapshot{
+		Id:          stringid.TruncateID(stringid.GenerateRandomID()),
+		Name:        name,
+		WorkspaceId: w.Id,
+		ProjectName: p.Name,
+		CreatedAt:   time.Now(),
+	}
+
+	if snap.Name == "" {
+		snap.Name = snap.Id
+	}
+
+	projectLogger := s.loggerFactory.CreateProjectLogger(w.Id, p.Name, logs.LogSourceServer)
+	defer projectLogger.Close()
+
+	projectLogger.Write([]byte(fmt.Sprintf("Creating snapshot %s of project %s\n", snap.Name, p.Name)))
+
+	err = s.provisioner.SnapshotProject(p, target, snap.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.snapshotStore.Save(snap)
+	if err != nil {
+		return nil, err
+	}
+
+	projectLogger.Write([]byte(fmt.Sprintf("Snapshot %s created\n", snap.Name)))
+
+	return snap, nil
+}
+
+// RestoreProject brings the project back to the state saved in the snapshot, found by ID or name.
+// A started project is stopped for the restore and started again afterwards.
+func (s *WorkspaceService) RestoreProject(ctx context.Context, workspaceId, projectName, snapshotId string) error {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return ErrWorkspaceNotFound
+	}
+
+	p, err := w.GetProject(projectName)
+	if err != nil {
+		return ErrProjectNotFound
+	}
+
+	snap, err := s.findSnapshot(w.Id, p.Name, snapshotId)
+	if err != nil {
+		return err
+	}
+
+	err = checkProjectIsSettled(p)
+	if err != nil {
+		return err
+	}
+
+	target, err := s.targetStore.Find(p.Target)
+	if err != nil {
+		return err
+	}
+
+	projectLogger := s.loggerFactory.CreateProjectLogger(w.Id, p.Name, logs.LogSourceServer)
+	defer projectLogger.Close()
+
+	wasStarted := p.Lifecycle.State == lifecycle.StateStarted
+	if wasStarted {
+		err = s.stopProject(w, p, target)
+		if err != nil {
+			return err
+		}
+	}
+
+	projectLogger.Write([]byte(fmt.Sprintf("Restoring project %s from snapshot %s\n", p.Name, snap.Name)))
+
+	err = s.provisioner.RestoreProject(p, target, snap.Id)
+	if err != nil {
+		s.failProject(w, p, err)
+		return err
+	}
+
+	projectLogger.Write([]byte(fmt.Sprintf("Project %s restored\n", p.Name)))
+
+	if !wasStarted {
+		return nil
+	}
+
+	return s.startProject(ctx, w, p, target, projectLogger)
+}
+
+func (s *WorkspaceService) ListSnapshots(workspaceId string) ([]*snapshot.Snapshot, error) {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	return s.snapshotStore.List(w.Id)
+}
+
+// DeleteSnapshot removes the snapshot, found by ID or name, from the provider and from storage
+func (s *WorkspaceService) DeleteSnapshot(ctx context.Context, workspaceId, projectName, snapshotId string) error {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return ErrWorkspaceNotFound
+	}
+
+	p, err := w.GetProject(projectName)
+	if err != nil {
+		return ErrProjectNotFound
+	}
+
+	snap, err := s.findSnapshot(w.Id, p.Name, snapshotId)
+	if err != nil {
+		return err
+	}
+
+	target, err := s.targetStore.Find(p.Target)
+	if err != nil {
+		return err
+	}
+
+	projectLogger := s.loggerFactory.CreateProjectLogger(w.Id, p.Name, logs.LogSourceServer)
+	defer projectLogger.Close()
+
+	projectLogger.Write([]byte(fmt.Sprintf("Deleting snapshot %s of project %s\n", snap.Name, p.Name)))
+
+	err = s.deleteSnapshot(p, target, snap)
+	if err != nil {
+		return err
+	}
+
+	projectLogger.Write([]byte(fmt.Sprintf("Snapshot %s deleted\n", snap.Name)))
+
+	return nil
+}
+
+// deleteProjectSnapshots removes all snapshots of the project. Failures are logged so that
+// removing the project is not blocked by its snapshots
+func (s *WorkspaceService) deleteProjectSnapshots(p *project.Project, target *provider.ProviderTarget) {
+	snapshots, err := s.snapshotStore.List(p.WorkspaceId)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+
+	for _, snap := range snapshots {
+		if snap.ProjectName != p.Name {
+			continue
+		}
+
+		err = s.deleteSnapshot(p, target, snap)
+		if err != nil {
+			log.Errorf("failed to delete snapshot %s of project %s: %s", snap.Name, p.Name, err)
+		}
+	}
+}
+
+// deleteSnapshot only removes the snapshot from storage if the target is not known
+func (s *WorkspaceService) deleteSnapshot(p *project.Project, target *provider.ProviderTarget, snap *snapshot.Snapshot) error {
+	if target != nil {
+		err := s.provisioner.DeleteSnapshot(p, target, snap.Id)
+		if err != nil && !provider.IsSnapshotNotSupported(err) {
+			return err
+		}
+	}
+
+	return s.snapshotStore.Delete(snap)
+}
+
+func (s *WorkspaceService) findSnapshot(workspaceId, projectName, idOrName string) (*snapshot.Snapshot, error) {
+	snapshots, err := s.snapshotStore.List(workspaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, snap := range snapshots {
+		if snap.ProjectName == projectName && (snap.Id == idOrName || snap.Name == idOrName) {
+			return snap, nil
+		}
+	}
+
+	return nil, snapshot.ErrSnapshotNotFound
+}
+
+func checkProjectIsSettled(p *project.Project) error {
+	if isTransitional(p.Lifecycle.State) {
+		return fmt.Errorf("%w: project %s is %s", lifecycle.ErrInvalidTransition, p.Name, p.Lifecycle.State)
+	}
+
+	return nil
+}
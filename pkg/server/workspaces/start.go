@@ -10,8 +10,10 @@ import (
 
 	"github.com/daytonaio/daytona/pkg/logs"
 	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/server/events"
 	"github.com/daytonaio/daytona/pkg/telemetry"
 	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/lifecycle"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
 	log "github.com/sirupsen/logrus"
 
@@ -35,6 +37,7 @@ func (s *WorkspaceService) StartWorkspace(ctx context.Context, workspaceId strin
 	wsLogWriter := io.MultiWriter(&util.InfoLogWriter{}, workspaceLogger)
 
 	err = s.startWorkspace(ctx, w, target, wsLogWriter)
+	s.publishWorkspaceEvent(events.EventTypeWorkspaceStarted, w.Id, err)
 
 	if !telemetry.TelemetryEnabled(ctx) {
 		return err
@@ -75,10 +78,15 @@ func (s *WorkspaceService) StartProject(ctx context.Context, workspaceId, projec
 	projectLogger := s.loggerFactory.CreateProjectLogger(w.Id, project.Name, logs.LogSourceServer)
 	defer projectLogger.Close()
 
-	return s.startProject(ctx, project, target, projectLogger)
+	return s.startProject(ctx, w, project, target, projectLogger)
 }
 
 func (s *WorkspaceService) startWorkspace(ctx context.Context, ws *workspace.Workspace, target *provider.ProviderTarget, wsLogWriter io.Writer) error {
+	err := s.transitionWorkspace(ws, lifecycle.StateStarting)
+	if err != nil {
+		return err
+	}
+
 	wsLogWriter.Write([]byte("Starting workspace\n"))
 
 	ws.EnvVars = workspace.GetWorkspaceEnvVars(ws, workspace.WorkspaceEnvVarParams{
@@ -87,19 +95,26 @@ func (s *WorkspaceService) startWorkspace(ctx context.Context, ws *workspace.Wor
 		ClientId:  telemetry.ClientId(ctx),
 	}, telemetry.TelemetryEnabled(ctx))
 
-	err := s.provisioner.StartWorkspace(ws, target)
+	err = s.provisioner.StartWorkspace(ws, target)
 	if err != nil {
+		s.failWorkspace(ws, err)
 		return err
 	}
 
-	for _, project := range ws.Projects {
-		projectLogger := s.loggerFactory.CreateProjectLogger(ws.Id, project.Name, logs.LogSourceServer)
+	err = forEachProject(ws.Projects, func(p *project.Project) error {
+		projectLogger := s.loggerFactory.CreateProjectLogger(ws.Id, p.Name, logs.LogSourceServer)
 		defer projectLogger.Close()
 
-		err = s.startProject(ctx, project, target, projectLogger)
-		if err != nil {
-			return err
-		}
+		return s.startProject(ctx, ws, p, target, projectLogger)
+	})
+	if err != nil {
+		s.failWorkspace(ws, err)
+		return err
+	}
+
+	err = s.transitionWorkspace(ws, lifecycle.StateStarted)
+	if err != nil {
+		return err
 	}
 
 	wsLogWriter.Write([]byte(fmt.Sprintf("Workspace %s started\n", ws.Name)))
@@ -107,17 +122,27 @@ func (s *WorkspaceService) startWorkspace(ctx context.Context, ws *workspace.Wor
 	return nil
 }
 
-func (s *WorkspaceService) startProject(ctx context.Context, p *project.Project, target *provider.ProviderTarget, logWriter io.Writer) error {
+func (s *WorkspaceService) startProject(ctx context.Context, ws *workspace.Workspace, p *project.Project, target *provider.ProviderTarget, logWriter io.Writer) error {
+	err := s.transitionProject(ws, p, lifecycle.StateStarting)
+	if err != nil {
+		return err
+	}
+
 	logWriter.Write([]byte(fmt.Sprintf("Starting project %s\n", p.Name)))
 
-	projectToStart := *p
-	projectToStart.EnvVars = project.GetProjectEnvVars(p, project.ProjectEnvVarParams{
-		ApiUrl:    s.serverApiUrl,
-		ServerUrl: s.serverUrl,
-		ClientId:  telemetry.ClientId(ctx),
-	}, telemetry.TelemetryEnabled(ctx))
+	projectToStart, err := s.withResolvedEnvVars(ctx, s.withProjectEnvVars(ctx, p))
+	if err != nil {
+		s.failProject(ws, p, err)
+		return err
+	}
+
+	err = s.provisioner.StartProject(projectToStart, target)
+	if err != nil {
+		s.failProject(ws, p, err)
+		return err
+	}
 
-	err := s.provisioner.StartProject(p, target)
+	err = s.transitionProject(ws, p, lifecycle.StateStarted)
 	if err != nil {
 		return err
 	}
@@ -7,7 +7,12 @@ import (
 	"context"
 	"time"
 
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/server/events"
 	"github.com/daytonaio/daytona/pkg/telemetry"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/lifecycle"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -22,22 +27,13 @@ func (s *WorkspaceService) StopWorkspace(ctx context.Context, workspaceId string
 		return err
 	}
 
-	for _, project := range workspace.Projects {
-		//	todo: go routines
-		err := s.provisioner.StopProject(project, target)
-		if err != nil {
-			return err
-		}
-		if project.State != nil {
-			project.State.Uptime = 0
-			project.State.UpdatedAt = time.Now().Format(time.RFC1123)
-		}
+	err = s.transitionWorkspace(workspace, lifecycle.StateStopping)
+	if err != nil {
+		return err
 	}
 
-	err = s.provisioner.StopWorkspace(workspace, target)
-	if err == nil {
-		err = s.workspaceStore.Save(workspace)
-	}
+	err = s.stopWorkspace(workspace, target)
+	s.publishWorkspaceEvent(events.EventTypeWorkspaceStopped, workspace.Id, err)
 
 	if !telemetry.TelemetryEnabled(ctx) {
 		return err
@@ -75,15 +71,44 @@ func (s *WorkspaceService) StopProject(ctx context.Context, workspaceId, project
 		return err
 	}
 
-	err = s.provisioner.StopProject(project, target)
+	return s.stopProject(w, project, target)
+}
+
+func (s *WorkspaceService) stopWorkspace(ws *workspace.Workspace, target *provider.ProviderTarget) error {
+	for _, project := range ws.Projects {
+		//	todo: go routines
+		err := s.stopProject(ws, project, target)
+		if err != nil {
+			s.failWorkspace(ws, err)
+			return err
+		}
+	}
+
+	err := s.provisioner.StopWorkspace(ws, target)
+	if err != nil {
+		s.failWorkspace(ws, err)
+		return err
+	}
+
+	return s.transitionWorkspace(ws, lifecycle.StateStopped)
+}
+
+func (s *WorkspaceService) stopProject(ws *workspace.Workspace, p *project.Project, target *provider.ProviderTarget) error {
+	err := s.transitionProject(ws, p, lifecycle.StateStopping)
+	if err != nil {
+		return err
+	}
+
+	err = s.provisioner.StopProject(p, target)
 	if err != nil {
+		s.failProject(ws, p, err)
 		return err
 	}
 
-	if project.State != nil {
-		project.State.Uptime = 0
-		project.State.UpdatedAt = time.Now().Format(time.RFC1123)
+	if p.State != nil {
+		p.State.Uptime = 0
+		p.State.UpdatedAt = time.Now().Format(time.RFC1123)
 	}
 
-	return s.workspaceStore.Save(w)
+	return s.transitionProject(ws, p, lifecycle.StateStopped)
 }
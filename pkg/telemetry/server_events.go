@@ -60,6 +60,8 @@ func NewWorkspaceEventProps(ctx context.Context, workspace *workspace.Workspace,
 				builders["none"]++
 			} else if project.BuildConfig.Devcontainer != nil {
 				builders["devcontainer"]++
+			} else if project.BuildConfig.Dockerfile != nil {
+				builders["dockerfile"]++
 			} else {
 				builders["automatic"]++
 			}
@@ -35,6 +35,18 @@ func Render(b *apiclient.Build, apiServerConfig *apiclient.ServerConfig, forceUn
 
 	output += getInfoLine("State", string(b.State)) + "\n"
 
+	if b.QueuePosition != nil {
+		output += getInfoLine("Queue position", fmt.Sprintf("%d", *b.QueuePosition)) + "\n"
+	}
+
+	if b.RetryCount > 0 {
+		output += getInfoLine("Retries", fmt.Sprintf("%d", b.RetryCount)) + "\n"
+	}
+
+	if b.NextRetryAt != nil {
+		output += getInfoLine("Next retry", util.FormatTimestamp(*b.NextRetryAt)) + "\n"
+	}
+
 	output += getInfoLine("Repository", b.Repository.Url) + "\n"
 
 	output += getInfoLine("Image", b.Image) + "\n"
@@ -57,6 +69,10 @@ func Render(b *apiclient.Build, apiServerConfig *apiclient.ServerConfig, forceUn
 		output += getInfoLine("Devcontainer path", b.BuildConfig.Devcontainer.FilePath) + "\n"
 	}
 
+	if b.BuildConfig != nil && b.BuildConfig.Dockerfile != nil {
+		output += getInfoLine("Dockerfile path", b.BuildConfig.Dockerfile.FilePath) + "\n"
+	}
+
 	output += getInfoLine("Prebuild ID", b.PrebuildId) + "\n"
 
 	output += getInfoLine("Created", util.FormatTimestamp(b.CreatedAt)) + "\n"
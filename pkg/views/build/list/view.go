@@ -99,6 +99,9 @@ func getTableRowData(build apiclient.Build) *RowData {
 
 	rowData.Id = build.Id + views_util.AdditionalPropertyPadding
 	rowData.State = string(build.State)
+	if build.QueuePosition != nil {
+		rowData.State = fmt.Sprintf("%s (#%d in queue)", build.State, *build.QueuePosition)
+	}
 	rowData.PrebuildId = build.PrebuildId
 	if rowData.PrebuildId == "" {
 		rowData.PrebuildId = "/"
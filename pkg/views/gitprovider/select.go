@@ -13,15 +13,17 @@ import (
 	"github.com/charmbracelet/lipgloss"
 	"github.com/daytonaio/daytona/cmd/daytona/config"
 	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/views"
 )
 
 type GitProviderView struct {
-	Id         string
-	Name       string
-	Username   string
-	BaseApiUrl string
-	Token      string
+	Id          string
+	Name        string
+	Username    string
+	BaseApiUrl  string
+	Token       string
+	TokenStatus string
 }
 
 var commonGitProviderIds = []string{"github", "gitlab", "bitbucket"}
@@ -127,6 +129,55 @@ func GitProviderSelectionView(gitProviderAddView *apiclient.SetGitProviderConfig
 	}
 }
 
+// OAuthGitProviderSelectionView prompts for a Git provider that supports the OAuth device flow
+func OAuthGitProviderSelectionView(gitProviderId *string, baseApiUrl *string) {
+	var gitProviderOptions []huh.Option[string]
+	for _, supportedProvider := range config.GetSupportedGitProviders() {
+		if slices.Contains(gitprovider.OAuthProviderIds, supportedProvider.Id) {
+			gitProviderOptions = append(gitProviderOptions, huh.Option[string]{Key: supportedProvider.Name, Value: supportedProvider.Id})
+		}
+	}
+
+	gitProviderForm := huh.NewForm(
+		huh.NewGroup(
+			huh.NewSelect[string]().
+				Title("Choose a Git provider").
+				Options(
+					gitProviderOptions...,
+				).
+				Value(gitProviderId)),
+	).WithTheme(views.GetCustomTheme())
+
+	err := gitProviderForm.Run()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if !providerRequiresApiUrl(*gitProviderId) {
+		return
+	}
+
+	apiUrlForm := huh.NewForm(
+		huh.NewGroup(
+			huh.NewInput().
+				Title("Self-managed API URL").
+				Value(baseApiUrl).
+				Description(getApiUrlDescription(*gitProviderId)).
+				Validate(func(str string) error {
+					if str == "" {
+						return errors.New("URL can not be blank")
+					}
+					return nil
+				}),
+		),
+	).WithTheme(views.GetCustomTheme())
+
+	err = apiUrlForm.Run()
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
 func providerRequiresUsername(gitProviderId string) bool {
 	return gitProviderId == "bitbucket" || gitProviderId == "bitbucket-server" || gitProviderId == "aws-codecommit"
 }
@@ -24,6 +24,7 @@ type PrebuildAddView struct {
 	CommitInterval    string
 	TriggerFiles      []string
 	Retention         string
+	MaxRetries        string
 	RunBuildOnAdd     bool
 }
 
@@ -71,6 +72,23 @@ func PrebuildCreationView(prebuildAddView *PrebuildAddView, editing bool) {
 					_, err := strconv.Atoi(str)
 					return err
 				}),
+			huh.NewInput().
+				Title("Max retries").
+				Description("Number of times a failed build is retried. Leave blank to use the server default").
+				Value(&prebuildAddView.MaxRetries).
+				Validate(func(str string) error {
+					if str == "" {
+						return nil
+					}
+					num, err := strconv.Atoi(str)
+					if err != nil {
+						return err
+					}
+					if num < 0 {
+						return errors.New("max retries cannot be negative")
+					}
+					return nil
+				}),
 			huh.NewConfirm().
 				Title("Run the build once on submit?").
 				Value(&prebuildAddView.RunBuildOnAdd),
@@ -42,6 +42,10 @@ func Render(prebuild *apiclient.PrebuildDTO, forceUnstyled bool) {
 
 	output += getInfoLine("Build retention", fmt.Sprint(prebuild.Retention)) + "\n"
 
+	if prebuild.MaxRetries != nil {
+		output += getInfoLine("Max retries", fmt.Sprint(*prebuild.MaxRetries)) + "\n"
+	}
+
 	triggerFileCount := len(prebuild.TriggerFiles)
 
 	if triggerFileCount > 0 {
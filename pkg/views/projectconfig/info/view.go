@@ -66,6 +66,18 @@ func Render(projectConfig *apiclient.ProjectConfig, apiServerConfig *apiclient.S
 		output += getInfoLine("Devcontainer path", projectConfig.BuildConfig.Devcontainer.FilePath) + "\n"
 	}
 
+	if projectConfig.BuildConfig != nil && projectConfig.BuildConfig.Dockerfile != nil {
+		output += getInfoLine("Dockerfile path", projectConfig.BuildConfig.Dockerfile.FilePath) + "\n"
+	}
+
+	if resources := getResourcesLabel(projectConfig.Resources); resources != "" {
+		output += getInfoLine("Resources", resources) + "\n"
+	}
+
+	if len(projectConfig.Secrets) > 0 {
+		output += getInfoLine("Secrets", strings.Join(projectConfig.Secrets, ", ")) + "\n"
+	}
+
 	prebuildCount := len(projectConfig.Prebuilds)
 
 	if prebuildCount > 0 {
@@ -96,6 +108,28 @@ func Render(projectConfig *apiclient.ProjectConfig, apiServerConfig *apiclient.S
 	renderTUIView(output, views.GetContainerBreakpointWidth(terminalWidth))
 }
 
+func getResourcesLabel(resources *apiclient.ResourceLimits) string {
+	if resources == nil {
+		return ""
+	}
+
+	labels := []string{}
+
+	if resources.Cpus != nil {
+		labels = append(labels, fmt.Sprintf("%g CPUs", *resources.Cpus))
+	}
+
+	if resources.Memory != nil {
+		labels = append(labels, fmt.Sprintf("%d MB memory", *resources.Memory))
+	}
+
+	if resources.Disk != nil {
+		labels = append(labels, fmt.Sprintf("%d GB disk", *resources.Disk))
+	}
+
+	return strings.Join(labels, ", ")
+}
+
 func renderUnstyledInfo(output string) {
 	fmt.Println(output)
 }
@@ -139,5 +173,9 @@ func GetLabelFromBuild(build *apiclient.BuildConfig) string {
 		return fmt.Sprintf("Devcontainer (%s)", build.Devcontainer.FilePath)
 	}
 
+	if build.Dockerfile != nil {
+		return fmt.Sprintf("Dockerfile (%s)", build.Dockerfile.FilePath)
+	}
+
 	return ""
 }
@@ -0,0 +1,108 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secret
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"golang.org/x/term"
+)
+
+type RowData struct {
+	Name    string
+	Value   string
+	Updated string
+}
+
+func getRowFromRowData(rowData RowData) []string {
+	row := []string{
+		views.NameStyle.Render(rowData.Name),
+		views.DefaultRowDataStyle.Render(rowData.Value),
+		views.DefaultRowDataStyle.Render(rowData.Updated),
+	}
+
+	return row
+}
+
+func getRowData(secret *apiclient.Secret) *RowData {
+	rowData := RowData{"", "", ""}
+
+	rowData.Name = secret.Name
+	// Secret values are write-only
+	rowData.Value = strings.Repeat("*", 10)
+	rowData.Updated = util.FormatTimestamp(secret.UpdatedAt)
+
+	return &rowData
+}
+
+func ListSecrets(secretList []apiclient.Secret) {
+	re := lipgloss.NewRenderer(os.Stdout)
+
+	headers := []string{"Name", "Value", "Updated"}
+
+	data := [][]string{}
+
+	for _, secret := range secretList {
+		var rowData *RowData
+		var row []string
+
+		rowData = getRowData(&secret)
+		if rowData == nil {
+			continue
+		}
+		row = getRowFromRowData(*rowData)
+		data = append(data, row)
+	}
+
+	terminalWidth, _, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil {
+		fmt.Println(data)
+		return
+	}
+
+	breakpointWidth := views.GetContainerBreakpointWidth(terminalWidth)
+
+	if breakpointWidth == 0 || terminalWidth < views.TUITableMinimumWidth {
+		renderUnstyledList(secretList)
+		return
+	}
+
+	t := table.New().
+		Headers(headers...).
+		Rows(data...).
+		BorderStyle(re.NewStyle().Foreground(views.LightGray)).
+		BorderRow(false).BorderColumn(false).BorderLeft(false).BorderRight(false).BorderTop(false).BorderBottom(false).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			if row == 0 {
+				return views.TableHeaderStyle
+			}
+			return views.BaseCellStyle
+		}).Width(breakpointWidth - 2*views.BaseTableStyleHorizontalPadding)
+
+	fmt.Println(views.BaseTableStyle.Render(t.String()))
+}
+
+func renderUnstyledList(secretList []apiclient.Secret) {
+	output := "\n"
+
+	for _, secret := range secretList {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Name: "), secret.Name) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Updated: "), util.FormatTimestamp(secret.UpdatedAt)) + "\n\n"
+
+		if secret.Name != secretList[len(secretList)-1].Name {
+			output += views.SeparatorString + "\n\n"
+		}
+	}
+
+	fmt.Println(output)
+}
@@ -0,0 +1,34 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secret
+
+import (
+	"errors"
+	"log"
+
+	"github.com/charmbracelet/huh"
+	"github.com/daytonaio/daytona/pkg/views"
+)
+
+func SecretValueView(name string, value *string) {
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewInput().
+				Title("Value of " + name).
+				Password(true).
+				Value(value).
+				Validate(func(str string) error {
+					if str == "" {
+						return errors.New("value can not be blank")
+					}
+					return nil
+				}),
+		),
+	).WithTheme(views.GetCustomTheme())
+
+	err := form.Run()
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -6,7 +6,9 @@ package apikey
 import (
 	"fmt"
 	"os"
+	"time"
 
+	"github.com/daytonaio/daytona/internal/util"
 	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/views"
 
@@ -16,24 +18,33 @@ import (
 )
 
 type RowData struct {
-	Name string
-	Type string
+	Name     string
+	Type     string
+	Role     string
+	Expires  string
+	LastUsed string
 }
 
 func getRowFromRowData(rowData RowData) []string {
 	row := []string{
 		views.NameStyle.Render(rowData.Name),
 		views.DefaultRowDataStyle.Render(rowData.Type),
+		views.DefaultRowDataStyle.Render(rowData.Role),
+		views.DefaultRowDataStyle.Render(rowData.Expires),
+		views.DefaultRowDataStyle.Render(rowData.LastUsed),
 	}
 
 	return row
 }
 
 func getRowData(apiKey *apiclient.ApiKey) *RowData {
-	rowData := RowData{"", ""}
+	rowData := RowData{"", "", "", "", ""}
 
 	rowData.Name = apiKey.Name
 	rowData.Type = string(apiKey.Type)
+	rowData.Role = string(apiKey.Role)
+	rowData.Expires = getExpiresLabel(apiKey.ExpiresAt)
+	rowData.LastUsed = getLastUsedLabel(apiKey.LastUsedAt)
 
 	return &rowData
 }
@@ -42,7 +53,7 @@ func ListApiKeys(apiKeyList []apiclient.ApiKey) {
 
 	re := lipgloss.NewRenderer(os.Stdout)
 
-	headers := []string{"Name", "Type"}
+	headers := []string{"Name", "Type", "Role", "Expires", "Last Used"}
 
 	data := [][]string{}
 
@@ -94,6 +105,12 @@ func renderUnstyledList(apiKeyList []apiclient.ApiKey) {
 
 		output += fmt.Sprintf("%s %s", views.GetPropertyKey("API Key Type: "), apiKey.Type) + "\n\n"
 
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("API Key Role: "), apiKey.Role) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Expires: "), getExpiresLabel(apiKey.ExpiresAt)) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Last Used: "), getLastUsedLabel(apiKey.LastUsedAt)) + "\n\n"
+
 		if apiKey.Name != apiKeyList[len(apiKeyList)-1].Name {
 			output += views.SeparatorString + "\n\n"
 		}
@@ -101,3 +118,28 @@ func renderUnstyledList(apiKeyList []apiclient.ApiKey) {
 
 	fmt.Println(output)
 }
+
+func getExpiresLabel(expiresAt *string) string {
+	if expiresAt == nil {
+		return "never"
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, *expiresAt)
+	if err != nil {
+		return *expiresAt
+	}
+
+	if !time.Now().Before(t) {
+		return "expired"
+	}
+
+	return t.Local().Format(time.DateTime)
+}
+
+func getLastUsedLabel(lastUsedAt *string) string {
+	if lastUsedAt == nil {
+		return "never"
+	}
+
+	return util.FormatTimestamp(*lastUsedAt)
+}
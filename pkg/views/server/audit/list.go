@@ -0,0 +1,133 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"golang.org/x/term"
+)
+
+type RowData struct {
+	Time     string
+	Key      string
+	Method   string
+	Resource string
+	Result   string
+}
+
+func getRowFromRowData(rowData RowData) []string {
+	row := []string{
+		views.DefaultRowDataStyle.Render(rowData.Time),
+		views.NameStyle.Render(rowData.Key),
+		views.DefaultRowDataStyle.Render(rowData.Method),
+		views.DefaultRowDataStyle.Render(rowData.Resource),
+		views.DefaultRowDataStyle.Render(rowData.Result),
+	}
+
+	return row
+}
+
+func getRowData(record *apiclient.AuditRecord) *RowData {
+	key := record.KeyName
+	// Requests that were not authenticated are recorded without a key type
+	if record.KeyType != "" {
+		key = fmt.Sprintf("%s (%s)", record.KeyName, record.KeyType)
+	}
+
+	return &RowData{
+		Time:     getTimeLabel(record.Timestamp),
+		Key:      key,
+		Method:   record.Method,
+		Resource: record.Resource,
+		Result:   getResultLabel(record),
+	}
+}
+
+func ListAuditRecords(recordList []apiclient.AuditRecord) {
+	re := lipgloss.NewRenderer(os.Stdout)
+
+	headers := []string{"Time", "API Key", "Method", "Resource", "Result"}
+
+	data := [][]string{}
+
+	for _, record := range recordList {
+		rowData := getRowData(&record)
+		data = append(data, getRowFromRowData(*rowData))
+	}
+
+	terminalWidth, _, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil {
+		fmt.Println(data)
+		return
+	}
+
+	breakpointWidth := views.GetContainerBreakpointWidth(terminalWidth)
+
+	if breakpointWidth == 0 || terminalWidth < views.TUITableMinimumWidth {
+		renderUnstyledList(recordList)
+		return
+	}
+
+	t := table.New().
+		Headers(headers...).
+		Rows(data...).
+		BorderStyle(re.NewStyle().Foreground(views.LightGray)).
+		BorderRow(false).BorderColumn(false).BorderLeft(false).BorderRight(false).BorderTop(false).BorderBottom(false).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			if row == 0 {
+				return views.TableHeaderStyle
+			}
+			return views.BaseCellStyle
+		}).Width(breakpointWidth - 2*views.BaseTableStyleHorizontalPadding)
+
+	fmt.Println(views.BaseTableStyle.Render(t.String()))
+}
+
+func renderUnstyledList(recordList []apiclient.AuditRecord) {
+	output := "\n"
+
+	for i, record := range recordList {
+		rowData := getRowData(&record)
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Time: "), rowData.Time) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("API Key: "), rowData.Key) + "\n\n"
+
+		output += fmt.Sprintf("%s %s %s", views.GetPropertyKey("Request: "), rowData.Method, rowData.Resource) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Result: "), rowData.Result) + "\n\n"
+
+		if i < len(recordList)-1 {
+			output += views.SeparatorString + "\n\n"
+		}
+	}
+
+	fmt.Println(output)
+}
+
+func getTimeLabel(timestamp string) string {
+	t, err := time.Parse(time.RFC3339Nano, timestamp)
+	if err != nil {
+		return timestamp
+	}
+
+	return t.Local().Format(time.DateTime)
+}
+
+func getResultLabel(record *apiclient.AuditRecord) string {
+	label := fmt.Sprintf("%s (%d)", record.Result, record.StatusCode)
+	if record.Error != nil && *record.Error != "" {
+		label += ": " + *record.Error
+	}
+
+	return label
+}
@@ -51,6 +51,26 @@ func RenderConfig(config *server.Config) {
 
 	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Build Image Namespace: "), config.BuildImageNamespace) + "\n\n"
 
+	if config.BuildTimeout > 0 {
+		output += fmt.Sprintf("%s %d minutes", views.GetPropertyKey("Build Timeout: "), config.BuildTimeout) + "\n\n"
+	} else {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Build Timeout: "), "disabled") + "\n\n"
+	}
+
+	if config.MaxConcurrentBuilds > 0 {
+		output += fmt.Sprintf("%s %d", views.GetPropertyKey("Max Concurrent Builds: "), config.MaxConcurrentBuilds) + "\n\n"
+	} else {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Max Concurrent Builds: "), "unlimited") + "\n\n"
+	}
+
+	output += fmt.Sprintf("%s %d", views.GetPropertyKey("Max Build Retries: "), config.MaxBuildRetries) + "\n\n"
+
+	if config.AuditRetentionDays > 0 {
+		output += fmt.Sprintf("%s %d days", views.GetPropertyKey("Audit Log Retention: "), config.AuditRetentionDays) + "\n\n"
+	} else {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Audit Log Retention: "), "indefinite") + "\n\n"
+	}
+
 	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Providers Dir: "), config.ProvidersDir) + "\n\n"
 
 	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Registry URL: "), config.RegistryUrl) + "\n\n"
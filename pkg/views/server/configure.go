@@ -45,6 +45,10 @@ func (m *Model) createForm(containerRegistries []apiclient.ContainerRegistry) *h
 	headscalePortView := strconv.Itoa(int(m.config.GetHeadscalePort()))
 	frpsPortView := strconv.Itoa(int(m.config.Frps.GetPort()))
 	localBuilderRegistryPort := strconv.Itoa(int(m.config.GetLocalBuilderRegistryPort()))
+	buildTimeoutView := strconv.Itoa(int(m.config.GetBuildTimeout()))
+	maxConcurrentBuildsView := strconv.Itoa(int(m.config.GetMaxConcurrentBuilds()))
+	maxBuildRetriesView := strconv.Itoa(int(m.config.GetMaxBuildRetries()))
+	auditRetentionDaysView := strconv.Itoa(int(m.config.GetAuditRetentionDays()))
 
 	builderContainerRegistryOptions := []huh.Option[string]{{
 		Key:   "Local registry managed by Daytona",
@@ -95,6 +99,42 @@ func (m *Model) createForm(containerRegistries []apiclient.ContainerRegistry) *h
 				Title("Build Image Namespace").
 				Description("Namespace to be used when tagging and pushing build images").
 				Value(m.config.BuildImageNamespace),
+			huh.NewInput().
+				Title("Build Timeout").
+				Description("Maximum build duration in minutes. Set to 0 to disable the timeout").
+				Value(&buildTimeoutView).
+				Validate(func(s string) error {
+					buildTimeout, err := strconv.Atoi(s)
+					if err != nil || buildTimeout < 0 {
+						return errors.New("build timeout must be a non-negative number of minutes")
+					}
+					m.config.BuildTimeout = int32(buildTimeout)
+					return nil
+				}),
+			huh.NewInput().
+				Title("Max Concurrent Builds").
+				Description("Maximum number of builds running at the same time. Set to 0 to disable the limit").
+				Value(&maxConcurrentBuildsView).
+				Validate(func(s string) error {
+					maxConcurrentBuilds, err := strconv.Atoi(s)
+					if err != nil || maxConcurrentBuilds < 0 {
+						return errors.New("max concurrent builds must be a non-negative number")
+					}
+					m.config.MaxConcurrentBuilds = int32(maxConcurrentBuilds)
+					return nil
+				}),
+			huh.NewInput().
+				Title("Max Build Retries").
+				Description("Number of times a failed build is retried automatically").
+				Value(&maxBuildRetriesView).
+				Validate(func(s string) error {
+					maxBuildRetries, err := strconv.Atoi(s)
+					if err != nil || maxBuildRetries < 0 {
+						return errors.New("max build retries must be a non-negative number")
+					}
+					m.config.MaxBuildRetries = int32(maxBuildRetries)
+					return nil
+				}),
 		),
 		huh.NewGroup(
 			huh.NewInput().
@@ -132,6 +172,18 @@ func (m *Model) createForm(containerRegistries []apiclient.ContainerRegistry) *h
 
 					return err
 				}),
+			huh.NewInput().
+				Title("Audit Log Retention").
+				Description("Number of days audit records are kept. Set to 0 to keep them indefinitely").
+				Value(&auditRetentionDaysView).
+				Validate(func(s string) error {
+					auditRetentionDays, err := strconv.Atoi(s)
+					if err != nil || auditRetentionDays < 0 {
+						return errors.New("audit log retention must be a non-negative number of days")
+					}
+					m.config.AuditRetentionDays = int32(auditRetentionDays)
+					return nil
+				}),
 		),
 		huh.NewGroup(
 			huh.NewInput().
@@ -0,0 +1,49 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"errors"
+	"log"
+	"net/url"
+
+	"github.com/charmbracelet/huh"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+)
+
+func WebhookCreationView(webhookUrl *string, eventTypes *[]apiclient.EventType) {
+	options := []huh.Option[apiclient.EventType]{}
+	for _, eventType := range apiclient.AllowedEventTypeEnumValues {
+		if eventType == apiclient.EventTypeWebhookTest {
+			continue
+		}
+		options = append(options, huh.NewOption(string(eventType), eventType))
+	}
+
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewInput().
+				Title("URL").
+				Value(webhookUrl).
+				Validate(func(str string) error {
+					u, err := url.Parse(str)
+					if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+						return errors.New("enter an absolute http or https URL")
+					}
+					return nil
+				}),
+			huh.NewMultiSelect[apiclient.EventType]().
+				Title("Events").
+				Description("Leave empty to subscribe to all events").
+				Options(options...).
+				Value(eventTypes),
+		),
+	).WithTheme(views.GetCustomTheme())
+
+	err := form.Run()
+	if err != nil {
+		log.Fatal(err)
+	}
+}
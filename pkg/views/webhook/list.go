@@ -0,0 +1,125 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"golang.org/x/term"
+)
+
+type RowData struct {
+	Id      string
+	Url     string
+	Events  string
+	Created string
+}
+
+func getRowFromRowData(rowData RowData) []string {
+	row := []string{
+		views.NameStyle.Render(rowData.Id),
+		views.DefaultRowDataStyle.Render(rowData.Url),
+		views.DefaultRowDataStyle.Render(rowData.Events),
+		views.DefaultRowDataStyle.Render(rowData.Created),
+	}
+
+	return row
+}
+
+func getRowData(webhook *apiclient.Webhook) *RowData {
+	rowData := RowData{"", "", "", ""}
+
+	rowData.Id = webhook.Id
+	rowData.Url = webhook.Url
+	rowData.Events = getEventsLabel(webhook.Events)
+	rowData.Created = util.FormatTimestamp(webhook.CreatedAt)
+
+	return &rowData
+}
+
+func ListWebhooks(webhookList []apiclient.Webhook) {
+	re := lipgloss.NewRenderer(os.Stdout)
+
+	headers := []string{"ID", "URL", "Events", "Created"}
+
+	data := [][]string{}
+
+	for _, webhook := range webhookList {
+		var rowData *RowData
+		var row []string
+
+		rowData = getRowData(&webhook)
+		if rowData == nil {
+			continue
+		}
+		row = getRowFromRowData(*rowData)
+		data = append(data, row)
+	}
+
+	terminalWidth, _, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil {
+		fmt.Println(data)
+		return
+	}
+
+	breakpointWidth := views.GetContainerBreakpointWidth(terminalWidth)
+
+	if breakpointWidth == 0 || terminalWidth < views.TUITableMinimumWidth {
+		renderUnstyledList(webhookList)
+		return
+	}
+
+	t := table.New().
+		Headers(headers...).
+		Rows(data...).
+		BorderStyle(re.NewStyle().Foreground(views.LightGray)).
+		BorderRow(false).BorderColumn(false).BorderLeft(false).BorderRight(false).BorderTop(false).BorderBottom(false).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			if row == 0 {
+				return views.TableHeaderStyle
+			}
+			return views.BaseCellStyle
+		}).Width(breakpointWidth - 2*views.BaseTableStyleHorizontalPadding)
+
+	fmt.Println(views.BaseTableStyle.Render(t.String()))
+}
+
+func renderUnstyledList(webhookList []apiclient.Webhook) {
+	output := "\n"
+
+	for _, webhook := range webhookList {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("ID: "), webhook.Id) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("URL: "), webhook.Url) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Events: "), getEventsLabel(webhook.Events)) + "\n\n"
+
+		if webhook.Id != webhookList[len(webhookList)-1].Id {
+			output += views.SeparatorString + "\n\n"
+		}
+	}
+
+	fmt.Println(output)
+}
+
+func getEventsLabel(eventTypes []apiclient.EventType) string {
+	if len(eventTypes) == 0 {
+		return "all"
+	}
+
+	labels := []string{}
+	for _, eventType := range eventTypes {
+		labels = append(labels, string(eventType))
+	}
+
+	return strings.Join(labels, ", ")
+}
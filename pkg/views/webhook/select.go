@@ -0,0 +1,37 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/common"
+	"github.com/daytonaio/daytona/pkg/views"
+)
+
+func GetWebhookFromPrompt(webhooks []apiclient.Webhook, title string) (*apiclient.Webhook, error) {
+	var items []list.Item
+
+	for _, w := range webhooks {
+		items = append(items, item{
+			webhook: w,
+		})
+	}
+
+	l := views.GetStyledSelectList(items)
+	m := model{list: l}
+	m.list.Title = views.GetStyledMainTitle(title)
+
+	p, err := tea.NewProgram(m, tea.WithAltScreen()).Run()
+	if err != nil {
+		return nil, err
+	}
+
+	if m, ok := p.(model); ok && m.choice != nil {
+		return m.choice, nil
+	}
+
+	return nil, common.ErrCtrlCAbort
+}
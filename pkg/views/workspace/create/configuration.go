@@ -20,6 +20,7 @@ import (
 
 const (
 	DEVCONTAINER_FILEPATH = ".devcontainer/devcontainer.json"
+	DOCKERFILE_FILEPATH   = "Dockerfile"
 )
 
 var configurationHelpLine = lipgloss.NewStyle().Foreground(views.Gray).Render("enter: next  f10: advanced configuration")
@@ -27,6 +28,7 @@ var configurationHelpLine = lipgloss.NewStyle().Foreground(views.Gray).Render("e
 type ProjectConfigurationData struct {
 	BuildChoice          string
 	DevcontainerFilePath string
+	DockerfilePath       string
 	Image                string
 	User                 string
 	EnvVars              map[string]string
@@ -36,6 +38,7 @@ func NewConfigurationData(buildChoice views_util.BuildChoice, devContainerFilePa
 	projectConfigurationData := &ProjectConfigurationData{
 		BuildChoice:          string(buildChoice),
 		DevcontainerFilePath: defaults.DevcontainerFilePath,
+		DockerfilePath:       defaults.DockerfilePath,
 		Image:                *defaults.Image,
 		User:                 *defaults.ImageUser,
 		EnvVars:              map[string]string{},
@@ -53,6 +56,10 @@ func NewConfigurationData(buildChoice views_util.BuildChoice, devContainerFilePa
 		projectConfigurationData.EnvVars = currentProject.EnvVars
 	}
 
+	if currentProject.BuildConfig != nil && currentProject.BuildConfig.Dockerfile != nil {
+		projectConfigurationData.DockerfilePath = currentProject.BuildConfig.Dockerfile.FilePath
+	}
+
 	return projectConfigurationData
 }
 
@@ -80,6 +87,9 @@ func RunProjectConfiguration(projectList *[]apiclient.CreateProjectDTO, defaults
 			builderChoice = views_util.DEVCONTAINER
 			devContainerFilePath = currentProject.BuildConfig.Devcontainer.FilePath
 		}
+		if currentProject.BuildConfig.Dockerfile != nil {
+			builderChoice = views_util.DOCKERFILE
+		}
 	} else {
 		if currentProject.Image == nil && currentProject.User == nil ||
 			*currentProject.Image == *defaults.Image && *currentProject.User == *defaults.ImageUser {
@@ -127,6 +137,22 @@ func RunProjectConfiguration(projectList *[]apiclient.CreateProjectDTO, defaults
 				(*projectList)[i].User = nil
 			}
 
+			if projectConfigurationData.BuildChoice == string(views_util.DOCKERFILE) {
+				dockerfileConfig := apiclient.DockerfileConfig{
+					FilePath: projectConfigurationData.DockerfilePath,
+				}
+				// Keep the context, build args and target that were set through flags
+				if currentProject.BuildConfig != nil && currentProject.BuildConfig.Dockerfile != nil {
+					dockerfileConfig = *currentProject.BuildConfig.Dockerfile
+					dockerfileConfig.FilePath = projectConfigurationData.DockerfilePath
+				}
+				(*projectList)[i].BuildConfig = &apiclient.BuildConfig{
+					Dockerfile: &dockerfileConfig,
+				}
+				(*projectList)[i].Image = nil
+				(*projectList)[i].User = nil
+			}
+
 			(*projectList)[i].EnvVars = projectConfigurationData.EnvVars
 		}
 	}
@@ -146,10 +172,18 @@ func validateDevcontainerFilename(filename string) error {
 	return nil
 }
 
+func validateDockerfilePath(filePath string) error {
+	if filePath == "" {
+		return errors.New("Dockerfile path is required")
+	}
+	return nil
+}
+
 func GetProjectConfigurationForm(projectConfiguration *ProjectConfigurationData) *huh.Form {
 	buildOptions := []huh.Option[string]{
 		{Key: "Automatic", Value: string(views_util.AUTOMATIC)},
 		{Key: "Devcontainer", Value: string(views_util.DEVCONTAINER)},
+		{Key: "Dockerfile", Value: string(views_util.DOCKERFILE)},
 		{Key: "Custom image", Value: string(views_util.CUSTOMIMAGE)},
 		{Key: "None", Value: string(views_util.NONE)},
 	}
@@ -180,6 +214,13 @@ func GetProjectConfigurationForm(projectConfiguration *ProjectConfigurationData)
 		).WithHideFunc(func() bool {
 			return projectConfiguration.BuildChoice != string(views_util.DEVCONTAINER)
 		}),
+		huh.NewGroup(
+			huh.NewInput().
+				Title("Dockerfile path").
+				Value(&projectConfiguration.DockerfilePath).Validate(validateDockerfilePath),
+		).WithHideFunc(func() bool {
+			return projectConfiguration.BuildChoice != string(views_util.DOCKERFILE)
+		}),
 		huh.NewGroup(
 			views.GetEnvVarsInput(&projectConfiguration.EnvVars),
 		),
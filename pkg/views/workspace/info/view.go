@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/daytonaio/daytona/pkg/apiclient"
@@ -41,6 +42,10 @@ func Render(workspace *apiclient.WorkspaceDTO, ide string, forceUnstyled bool) {
 
 	output += getInfoLine("ID", workspace.Id) + "\n"
 
+	if expiresAt, err := time.Parse(time.RFC3339Nano, workspace.GetExpiresAt()); err == nil {
+		output += getInfoLine("Expires", expiresAt.Local().Format(time.RFC1123)) + "\n"
+	}
+
 	if isCreationView {
 		output += getInfoLine("Editor", ide) + "\n"
 	}
@@ -94,9 +99,15 @@ func getSingleProjectOutput(project *apiclient.Project, isCreationView bool) str
 	repositoryUrl = strings.TrimPrefix(repositoryUrl, "https://")
 	repositoryUrl = strings.TrimPrefix(repositoryUrl, "http://")
 
+	output += getInfoLineState("State", project) + "\n"
+	if project.Lifecycle.Error != nil {
+		output += getInfoLine("Error", *project.Lifecycle.Error) + "\n"
+	}
 	if project.State != nil {
-		output += getInfoLineState("State", project.State) + "\n"
 		output += getInfoLineGitStatus("Branch", &project.State.GitStatus) + "\n"
+		if project.State.Metrics != nil && project.Lifecycle.State == apiclient.StateStarted {
+			output += getInfoLine("Usage", GetMetricsLabel(project.State.Metrics)) + "\n"
+		}
 	}
 
 	if !isCreationView {
@@ -116,9 +127,15 @@ func getProjectsOutputs(projects []apiclient.Project, isCreationView bool) strin
 	var output string
 	for i, project := range projects {
 		output += getInfoLine(fmt.Sprintf("Project #%d", i+1), project.Name)
-		output += getInfoLineState("State", project.State)
+		output += getInfoLineState("State", &project)
+		if project.Lifecycle.Error != nil {
+			output += getInfoLine("Error", *project.Lifecycle.Error)
+		}
 		if project.State != nil {
 			output += getInfoLineGitStatus("Branch", &project.State.GitStatus)
+			if project.State.Metrics != nil && project.Lifecycle.State == apiclient.StateStarted {
+				output += getInfoLine("Usage", GetMetricsLabel(project.State.Metrics))
+			}
 		}
 		if !isCreationView {
 			output += getInfoLine("Target", project.Target)
@@ -135,20 +152,17 @@ func getInfoLine(key, value string) string {
 	return propertyNameStyle.Render(fmt.Sprintf("%-*s", propertyNameWidth, key)) + propertyValueStyle.Render(value) + "\n"
 }
 
-func getInfoLineState(key string, state *apiclient.ProjectState) string {
-	var uptime int
+func getInfoLineState(key string, project *apiclient.Project) string {
 	var stateProperty string
+	state := strings.ToUpper(string(project.Lifecycle.State))
 
-	if state == nil {
-		uptime = 0
-	} else {
-		uptime = int(state.Uptime)
-	}
-
-	if uptime == 0 {
-		stateProperty = propertyValueStyle.Foreground(views.Gray).Render("STOPPED")
-	} else {
-		stateProperty = propertyValueStyle.Foreground(views.Green).Render("RUNNING")
+	switch project.Lifecycle.State {
+	case apiclient.StateStarted:
+		stateProperty = propertyValueStyle.Foreground(views.Green).Render(state)
+	case apiclient.StateError:
+		stateProperty = propertyValueStyle.Foreground(views.Orange).Render(state)
+	default:
+		stateProperty = propertyValueStyle.Foreground(views.Gray).Render(state)
 	}
 
 	return propertyNameStyle.Render(fmt.Sprintf("%-*s", propertyNameWidth, key)) + stateProperty + propertyValueStyle.Foreground(views.Light).Render("\n")
@@ -173,3 +187,9 @@ func getInfoLineGitStatus(key string, status *apiclient.GitStatus) string {
 
 	return output
 }
+
+// GetMetricsLabel summarizes the resource usage of a project in a single line
+func GetMetricsLabel(metrics *apiclient.ProjectMetrics) string {
+	return fmt.Sprintf("%.1f%% CPU, %d/%d MB memory, %.1f/%.1f GB disk, %d processes",
+		metrics.CpuUsage, metrics.MemoryUsage, metrics.MemoryLimit, float64(metrics.DiskUsage)/1024, float64(metrics.DiskTotal)/1024, metrics.Processes)
+}
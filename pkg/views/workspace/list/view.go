@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"os"
 	"sort"
+	"strings"
+	"time"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/lipgloss/table"
@@ -22,6 +24,7 @@ type RowData struct {
 	Name       string
 	Repository string
 	Target     string
+	State      apiclient.LifecycleState
 	Status     string
 	Created    string
 	Branch     string
@@ -115,12 +118,7 @@ func renderUnstyledList(workspaceList []apiclient.WorkspaceDTO) {
 }
 
 func getRowFromRowData(rowData RowData, isMultiProjectAccordion bool) []string {
-	var state string
-	if rowData.Status == "" {
-		state = views.InactiveStyle.Render("STOPPED")
-	} else {
-		state = views.ActiveStyle.Render("RUNNING")
-	}
+	state := getStateLabel(rowData)
 
 	if isMultiProjectAccordion {
 		return []string{rowData.Name, "", "", "", "", ""}
@@ -135,13 +133,26 @@ func getRowFromRowData(rowData RowData, isMultiProjectAccordion bool) []string {
 		views.DefaultRowDataStyle.Render(views.GetBranchNameLabel(rowData.Branch)),
 	}
 
-	if rowData.Status != "" {
+	if rowData.Status != "" && rowData.State == apiclient.StateStarted {
 		row[3] = fmt.Sprintf("%s %s", state, views.DefaultRowDataStyle.Render(fmt.Sprintf("(%s)", rowData.Status)))
 	}
 
 	return row
 }
 
+func getStateLabel(rowData RowData) string {
+	label := strings.ToUpper(string(rowData.State))
+
+	switch rowData.State {
+	case apiclient.StateStarted:
+		return views.ActiveStyle.Render(label)
+	case apiclient.StateStopped, apiclient.StateError:
+		return views.InactiveStyle.Render(label)
+	default:
+		return views.DefaultRowDataStyle.Render(label)
+	}
+}
+
 func sortWorkspaces(workspaceList *[]apiclient.WorkspaceDTO, verbose bool) {
 	if verbose {
 		sort.Slice(*workspaceList, func(i, j int) bool {
@@ -170,7 +181,7 @@ func sortWorkspaces(workspaceList *[]apiclient.WorkspaceDTO, verbose bool) {
 }
 
 func getWorkspaceTableRowData(workspace apiclient.WorkspaceDTO, specifyGitProviders bool) *RowData {
-	rowData := RowData{"", "", "", "", "", ""}
+	rowData := RowData{}
 	rowData.Name = workspace.Name + views_util.AdditionalPropertyPadding
 	if len(workspace.Projects) > 0 {
 		rowData.Repository = util.GetRepositorySlugFromUrl(workspace.Projects[0].Repository.Url, specifyGitProviders)
@@ -179,7 +190,10 @@ func getWorkspaceTableRowData(workspace apiclient.WorkspaceDTO, specifyGitProvid
 
 	rowData.Target = workspace.Target + views_util.AdditionalPropertyPadding
 
-	if workspace.Info != nil && workspace.Info.Projects != nil && len(workspace.Info.Projects) > 0 {
+	rowData.State = workspace.Lifecycle.State
+	if isTimestampSet(workspace.Lifecycle.CreatedAt) {
+		rowData.Created = util.FormatTimestamp(workspace.Lifecycle.CreatedAt)
+	} else if workspace.Info != nil && workspace.Info.Projects != nil && len(workspace.Info.Projects) > 0 {
 		rowData.Created = util.FormatTimestamp(workspace.Info.Projects[0].Created)
 	}
 	if len(workspace.Projects) > 0 && workspace.Projects[0].State != nil && workspace.Projects[0].State.Uptime > 0 {
@@ -189,7 +203,7 @@ func getWorkspaceTableRowData(workspace apiclient.WorkspaceDTO, specifyGitProvid
 }
 
 func getProjectTableRowData(workspaceDTO apiclient.WorkspaceDTO, project apiclient.Project, specifyGitProviders bool) *RowData {
-	rowData := RowData{"", "", "", "", "", ""}
+	rowData := RowData{}
 	rowData.Name = " └ " + project.Name
 
 	rowData.Repository = util.GetRepositorySlugFromUrl(project.Repository.Url, specifyGitProviders)
@@ -201,6 +215,12 @@ func getProjectTableRowData(workspaceDTO apiclient.WorkspaceDTO, project apiclie
 		rowData.Status = util.FormatUptime(project.State.Uptime)
 	}
 
+	rowData.State = project.Lifecycle.State
+	if isTimestampSet(project.Lifecycle.CreatedAt) {
+		rowData.Created = util.FormatTimestamp(project.Lifecycle.CreatedAt)
+		return &rowData
+	}
+
 	if workspaceDTO.Info == nil || workspaceDTO.Info.Projects == nil {
 		return &rowData
 	}
@@ -214,3 +234,9 @@ func getProjectTableRowData(workspaceDTO apiclient.WorkspaceDTO, project apiclie
 
 	return &rowData
 }
+
+// Workspaces created before lifecycle states were recorded have no creation timestamp
+func isTimestampSet(timestamp string) bool {
+	t, err := time.Parse(time.RFC3339Nano, timestamp)
+	return err == nil && !t.IsZero()
+}
@@ -55,6 +55,9 @@ func selectProjectRequestPrompt(projects *[]apiclient.CreateProjectDTO, choiceCh
 		if project.BuildConfig != nil && project.BuildConfig.Devcontainer != nil {
 			devcontainerConfig = fmt.Sprintf("%s %s", "Devcontainer Config:", project.BuildConfig.Devcontainer.FilePath)
 		}
+		if project.BuildConfig != nil && project.BuildConfig.Dockerfile != nil {
+			devcontainerConfig = fmt.Sprintf("%s %s", "Dockerfile:", project.BuildConfig.Dockerfile.FilePath)
+		}
 
 		newItem := projectRequestItem{name: name, image: image, user: user, project: project, devcontainerConfig: devcontainerConfig}
 
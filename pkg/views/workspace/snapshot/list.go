@@ -0,0 +1,113 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"golang.org/x/term"
+)
+
+type RowData struct {
+	Id      string
+	Name    string
+	Project string
+	Created string
+}
+
+func getRowFromRowData(rowData RowData) []string {
+	row := []string{
+		views.NameStyle.Render(rowData.Id),
+		views.DefaultRowDataStyle.Render(rowData.Name),
+		views.DefaultRowDataStyle.Render(rowData.Project),
+		views.DefaultRowDataStyle.Render(rowData.Created),
+	}
+
+	return row
+}
+
+func getRowData(snapshot *apiclient.ProjectSnapshot) *RowData {
+	rowData := RowData{"", "", "", ""}
+
+	rowData.Id = snapshot.Id
+	rowData.Name = snapshot.Name
+	rowData.Project = snapshot.ProjectName
+	rowData.Created = util.FormatTimestamp(snapshot.CreatedAt)
+
+	return &rowData
+}
+
+func ListSnapshots(snapshotList []apiclient.ProjectSnapshot) {
+	re := lipgloss.NewRenderer(os.Stdout)
+
+	headers := []string{"ID", "Name", "Project", "Created"}
+
+	data := [][]string{}
+
+	for _, snapshot := range snapshotList {
+		var rowData *RowData
+		var row []string
+
+		rowData = getRowData(&snapshot)
+		if rowData == nil {
+			continue
+		}
+		row = getRowFromRowData(*rowData)
+		data = append(data, row)
+	}
+
+	terminalWidth, _, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil {
+		fmt.Println(data)
+		return
+	}
+
+	breakpointWidth := views.GetContainerBreakpointWidth(terminalWidth)
+
+	if breakpointWidth == 0 || terminalWidth < views.TUITableMinimumWidth {
+		renderUnstyledList(snapshotList)
+		return
+	}
+
+	t := table.New().
+		Headers(headers...).
+		Rows(data...).
+		BorderStyle(re.NewStyle().Foreground(views.LightGray)).
+		BorderRow(false).BorderColumn(false).BorderLeft(false).BorderRight(false).BorderTop(false).BorderBottom(false).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			if row == 0 {
+				return views.TableHeaderStyle
+			}
+			return views.BaseCellStyle
+		}).Width(breakpointWidth - 2*views.BaseTableStyleHorizontalPadding)
+
+	fmt.Println(views.BaseTableStyle.Render(t.String()))
+}
+
+func renderUnstyledList(snapshotList []apiclient.ProjectSnapshot) {
+	output := "\n"
+
+	for _, snapshot := range snapshotList {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("ID: "), snapshot.Id) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Name: "), snapshot.Name) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Project: "), snapshot.ProjectName) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Created: "), util.FormatTimestamp(snapshot.CreatedAt)) + "\n\n"
+
+		if snapshot.Id != snapshotList[len(snapshotList)-1].Id {
+			output += views.SeparatorString + "\n\n"
+		}
+	}
+
+	fmt.Println(output)
+}
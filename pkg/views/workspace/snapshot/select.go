@@ -0,0 +1,37 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/common"
+	"github.com/daytonaio/daytona/pkg/views"
+)
+
+func GetSnapshotFromPrompt(snapshots []apiclient.ProjectSnapshot, title string) (*apiclient.ProjectSnapshot, error) {
+	var items []list.Item
+
+	for _, s := range snapshots {
+		items = append(items, item{
+			snapshot: s,
+		})
+	}
+
+	l := views.GetStyledSelectList(items)
+	m := model{list: l}
+	m.list.Title = views.GetStyledMainTitle(title)
+
+	p, err := tea.NewProgram(m, tea.WithAltScreen()).Run()
+	if err != nil {
+		return nil, err
+	}
+
+	if m, ok := p.(model); ok && m.choice != nil {
+		return m.choice, nil
+	}
+
+	return nil, common.ErrCtrlCAbort
+}
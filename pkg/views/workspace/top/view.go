@@ -0,0 +1,142 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package top
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	info_view "github.com/daytonaio/daytona/pkg/views/workspace/info"
+	"golang.org/x/term"
+)
+
+type ProjectUsage struct {
+	WorkspaceName string
+	ProjectName   string
+	// Oldest first
+	History []apiclient.ProjectMetrics
+}
+
+type RowData struct {
+	Workspace string
+	Project   string
+	Cpu       string
+	CpuAvg    string
+	Memory    string
+	Disk      string
+	Processes string
+}
+
+func getRowFromRowData(rowData RowData) []string {
+	row := []string{
+		views.NameStyle.Render(rowData.Workspace),
+		views.DefaultRowDataStyle.Render(rowData.Project),
+		views.DefaultRowDataStyle.Render(rowData.Cpu),
+		views.DefaultRowDataStyle.Render(rowData.CpuAvg),
+		views.DefaultRowDataStyle.Render(rowData.Memory),
+		views.DefaultRowDataStyle.Render(rowData.Disk),
+		views.DefaultRowDataStyle.Render(rowData.Processes),
+	}
+
+	return row
+}
+
+func getRowData(usage *ProjectUsage) *RowData {
+	rowData := RowData{
+		Workspace: usage.WorkspaceName,
+		Project:   usage.ProjectName,
+		Cpu:       "-",
+		CpuAvg:    "-",
+		Memory:    "-",
+		Disk:      "-",
+		Processes: "-",
+	}
+
+	if len(usage.History) == 0 {
+		return &rowData
+	}
+
+	latest := usage.History[len(usage.History)-1]
+
+	rowData.Cpu = fmt.Sprintf("%.1f%%", latest.CpuUsage)
+	rowData.CpuAvg = fmt.Sprintf("%.1f%%", getAverageCpuUsage(usage.History))
+	rowData.Memory = fmt.Sprintf("%d/%d MB", latest.MemoryUsage, latest.MemoryLimit)
+	rowData.Disk = fmt.Sprintf("%.1f/%.1f GB", float64(latest.DiskUsage)/1024, float64(latest.DiskTotal)/1024)
+	rowData.Processes = fmt.Sprint(latest.Processes)
+
+	return &rowData
+}
+
+func getAverageCpuUsage(history []apiclient.ProjectMetrics) float32 {
+	var total float32
+	for _, metrics := range history {
+		total += metrics.CpuUsage
+	}
+
+	return total / float32(len(history))
+}
+
+func ListUsage(usageList []ProjectUsage) {
+	re := lipgloss.NewRenderer(os.Stdout)
+
+	headers := []string{"Workspace", "Project", "CPU", "CPU (5m avg)", "Memory", "Disk", "Processes"}
+
+	data := [][]string{}
+
+	for _, usage := range usageList {
+		rowData := getRowData(&usage)
+		data = append(data, getRowFromRowData(*rowData))
+	}
+
+	terminalWidth, _, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil {
+		fmt.Println(data)
+		return
+	}
+
+	breakpointWidth := views.GetContainerBreakpointWidth(terminalWidth)
+
+	if breakpointWidth == 0 || terminalWidth < views.TUITableMinimumWidth {
+		renderUnstyledList(usageList)
+		return
+	}
+
+	t := table.New().
+		Headers(headers...).
+		Rows(data...).
+		BorderStyle(re.NewStyle().Foreground(views.LightGray)).
+		BorderRow(false).BorderColumn(false).BorderLeft(false).BorderRight(false).BorderTop(false).BorderBottom(false).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			if row == 0 {
+				return views.TableHeaderStyle
+			}
+			return views.BaseCellStyle
+		}).Width(breakpointWidth - 2*views.BaseTableStyleHorizontalPadding)
+
+	fmt.Println(views.BaseTableStyle.Render(t.String()))
+}
+
+func renderUnstyledList(usageList []ProjectUsage) {
+	output := "\n"
+
+	for i, usage := range usageList {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Workspace: "), usage.WorkspaceName) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Project: "), usage.ProjectName) + "\n\n"
+
+		if len(usage.History) > 0 {
+			output += fmt.Sprintf("%s %s", views.GetPropertyKey("Usage: "), info_view.GetMetricsLabel(&usage.History[len(usage.History)-1])) + "\n\n"
+		}
+
+		if i < len(usageList)-1 {
+			output += views.SeparatorString + "\n\n"
+		}
+	}
+
+	fmt.Println(output)
+}
@@ -0,0 +1,30 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import "errors"
+
+type Store interface {
+	List() ([]*Webhook, error)
+	Find(id string) (*Webhook, error)
+	Save(webhook *Webhook) error
+	Delete(webhook *Webhook) error
+}
+
+type DeliveryStore interface {
+	// List returns the deliveries of a webhook, newest first
+	List(webhookId string) ([]*Delivery, error)
+	Save(delivery *Delivery) error
+	// Prune removes all but the newest keep deliveries of a webhook
+	Prune(webhookId string, keep int) error
+	DeleteAll(webhookId string) error
+}
+
+var (
+	ErrWebhookNotFound = errors.New("webhook not found")
+)
+
+func IsWebhookNotFound(err error) bool {
+	return err.Error() == ErrWebhookNotFound.Error()
+}
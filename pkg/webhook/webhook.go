@@ -0,0 +1,45 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"slices"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/server/events"
+)
+
+type Webhook struct {
+	Id  string `json:"id" validate:"required"`
+	Url string `json:"url" validate:"required"`
+	// Event types the webhook is subscribed to. An empty list subscribes to all events except project state updates
+	Events []events.EventType `json:"events" validate:"required"`
+	// Only returned when the webhook is created
+	Secret    string    `json:"secret,omitempty" validate:"optional"`
+	CreatedAt time.Time `json:"createdAt" validate:"required"`
+} // @name Webhook
+
+// Frequent event types that webhooks only receive when subscribed to them explicitly
+var ExplicitOnlyEventTypes = []events.EventType{events.EventTypeProjectStateUpdated}
+
+func (w *Webhook) IsSubscribed(eventType events.EventType) bool {
+	if len(w.Events) == 0 {
+		return !slices.Contains(ExplicitOnlyEventTypes, eventType)
+	}
+
+	return slices.Contains(w.Events, eventType)
+}
+
+type Delivery struct {
+	Id         string           `json:"id" validate:"required"`
+	WebhookId  string           `json:"webhookId" validate:"required"`
+	EventId    string           `json:"eventId" validate:"required"`
+	EventType  events.EventType `json:"eventType" validate:"required"`
+	Attempts   int              `json:"attempts" validate:"required"`
+	StatusCode int              `json:"statusCode" validate:"required"`
+	Success    bool             `json:"success" validate:"required"`
+	Error      string           `json:"error,omitempty" validate:"optional"`
+	CreatedAt  time.Time        `json:"createdAt" validate:"required"`
+	UpdatedAt  time.Time        `json:"updatedAt" validate:"required"`
+} // @name WebhookDelivery
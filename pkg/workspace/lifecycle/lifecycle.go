@@ -0,0 +1,88 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+type State string // @name LifecycleState
+
+const (
+	StateCreating State = "creating"
+	StateStarting State = "starting"
+	StateStarted  State = "started"
+	StateStopping State = "stopping"
+	StateStopped  State = "stopped"
+	StateError    State = "error"
+	StateDeleting State = "deleting"
+)
+
+var ErrInvalidTransition = errors.New("invalid lifecycle state transition")
+
+// Transitional states allow repeating the operation so that a resource
+// is not stuck if the server was interrupted in the middle of it
+var transitions = map[State][]State{
+	StateCreating: {StateStarting, StateError, StateDeleting},
+	StateStarting: {StateStarting, StateStarted, StateStopping, StateError, StateDeleting},
+	StateStarted:  {StateStarting, StateStopping, StateError, StateDeleting},
+	StateStopping: {StateStarting, StateStopping, StateStopped, StateError, StateDeleting},
+	StateStopped:  {StateStarting, StateStopping, StateError, StateDeleting},
+	StateError:    {StateStarting, StateStopping, StateError, StateDeleting},
+	StateDeleting: {StateDeleting, StateError},
+}
+
+type Lifecycle struct {
+	State         State      `json:"state" validate:"required"`
+	Error         string     `json:"error,omitempty" validate:"optional"`
+	CreatedAt     time.Time  `json:"createdAt" validate:"required"`
+	UpdatedAt     time.Time  `json:"updatedAt" validate:"required"`
+	LastStartedAt *time.Time `json:"lastStartedAt,omitempty" validate:"optional"`
+} // @name Lifecycle
+
+func New() Lifecycle {
+	now := time.Now()
+
+	return Lifecycle{
+		State:     StateCreating,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// CanTransition reports whether the lifecycle can move to the given state
+func (l *Lifecycle) CanTransition(to State) bool {
+	return slices.Contains(transitions[l.State], to)
+}
+
+// Transition moves the lifecycle to the given state and records the time of the change
+func (l *Lifecycle) Transition(to State) error {
+	if !l.CanTransition(to) {
+		return fmt.Errorf("%w: cannot go from %s to %s", ErrInvalidTransition, l.State, to)
+	}
+
+	now := time.Now()
+
+	l.State = to
+	l.Error = ""
+	l.UpdatedAt = now
+
+	if to == StateStarted {
+		l.LastStartedAt = &now
+	}
+
+	return nil
+}
+
+// Fail moves the lifecycle to the error state and records the error message
+func (l *Lifecycle) Fail(err error) {
+	l.State = StateError
+	l.UpdatedAt = time.Now()
+	if err != nil {
+		l.Error = err.Error()
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/workspace/lifecycle"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLifecycle(t *testing.T) {
+	l := lifecycle.New()
+	require.Equal(t, lifecycle.StateCreating, l.State)
+	require.False(t, l.CreatedAt.IsZero())
+	require.Nil(t, l.LastStartedAt)
+
+	require.ErrorIs(t, l.Transition(lifecycle.StateStopped), lifecycle.ErrInvalidTransition)
+
+	require.Nil(t, l.Transition(lifecycle.StateStarting))
+	require.Nil(t, l.Transition(lifecycle.StateStarted))
+	require.NotNil(t, l.LastStartedAt)
+
+	l.Fail(errors.New("provider unavailable"))
+	require.Equal(t, lifecycle.StateError, l.State)
+	require.Equal(t, "provider unavailable", l.Error)
+
+	require.Nil(t, l.Transition(lifecycle.StateDeleting))
+	require.Empty(t, l.Error)
+	require.ErrorIs(t, l.Transition(lifecycle.StateStarting), lifecycle.ErrInvalidTransition)
+}
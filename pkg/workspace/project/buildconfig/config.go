@@ -5,6 +5,7 @@ package buildconfig
 
 type BuildConfig struct {
 	Devcontainer *DevcontainerConfig `json:"devcontainer,omitempty" validate:"optional"`
+	Dockerfile   *DockerfileConfig   `json:"dockerfile,omitempty" validate:"optional"`
 	CachedBuild  *CachedBuild        `json:"cachedBuild,omitempty" validate:"optional"`
 } // @name BuildConfig
 
@@ -12,6 +13,16 @@ type DevcontainerConfig struct {
 	FilePath string `json:"filePath" validate:"required"`
 } // @name DevcontainerConfig
 
+type DockerfileConfig struct {
+	// Path to the Dockerfile, relative to the repository root
+	FilePath string `json:"filePath" validate:"required"`
+	// Build context directory, relative to the repository root; defaults to the repository root
+	Context   string            `json:"context,omitempty" validate:"optional"`
+	BuildArgs map[string]string `json:"buildArgs,omitempty" validate:"optional"`
+	// Target stage of a multi-stage Dockerfile
+	Target string `json:"target,omitempty" validate:"optional"`
+} // @name DockerfileConfig
+
 type CachedBuild struct {
 	User  string `json:"user" validate:"required"`
 	Image string `json:"image" validate:"required"`
@@ -6,6 +6,7 @@ package config
 import (
 	"errors"
 
+	"github.com/daytonaio/daytona/pkg/workspace/project"
 	"github.com/daytonaio/daytona/pkg/workspace/project/buildconfig"
 )
 
@@ -14,8 +15,10 @@ type ProjectConfig struct {
 	Image         string                   `json:"image" validate:"required"`
 	User          string                   `json:"user" validate:"required"`
 	BuildConfig   *buildconfig.BuildConfig `json:"buildConfig,omitempty" validate:"optional"`
+	Resources     *project.ResourceLimits  `json:"resources,omitempty" validate:"optional"`
 	RepositoryUrl string                   `json:"repositoryUrl" validate:"required"`
 	EnvVars       map[string]string        `json:"envVars" validate:"required"`
+	Secrets       []string                 `json:"secrets,omitempty" validate:"optional"`
 	IsDefault     bool                     `json:"default" validate:"required"`
 	Prebuilds     []*PrebuildConfig        `json:"prebuilds" validate:"optional"`
 } // @name ProjectConfig
@@ -27,6 +30,7 @@ func (pc *ProjectConfig) SetPrebuild(p *PrebuildConfig) error {
 		CommitInterval: p.CommitInterval,
 		TriggerFiles:   p.TriggerFiles,
 		Retention:      p.Retention,
+		MaxRetries:     p.MaxRetries,
 	}
 
 	for _, pb := range pc.Prebuilds {
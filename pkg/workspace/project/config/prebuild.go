@@ -17,6 +17,7 @@ type PrebuildConfig struct {
 	CommitInterval *int     `json:"commitInterval" validate:"required"`
 	TriggerFiles   []string `json:"triggerFiles" validate:"required"`
 	Retention      int      `json:"retention" validate:"required"`
+	MaxRetries     *int     `json:"maxRetries,omitempty" validate:"optional"`
 } // @name PrebuildConfig
 
 func (p *PrebuildConfig) GenerateId() error {
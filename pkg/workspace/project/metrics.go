@@ -0,0 +1,20 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package project
+
+// ProjectMetrics is a sample of the resource usage reported by the project agent
+type ProjectMetrics struct {
+	// CPU usage in percent, where 100 is one fully used CPU
+	CpuUsage float64 `json:"cpuUsage" validate:"required"`
+	// Memory usage in MB
+	MemoryUsage uint64 `json:"memoryUsage" validate:"required"`
+	// Memory available to the project in MB
+	MemoryLimit uint64 `json:"memoryLimit" validate:"required"`
+	// Disk usage of the project directory's filesystem in MB
+	DiskUsage uint64 `json:"diskUsage" validate:"required"`
+	// Size of the project directory's filesystem in MB
+	DiskTotal   uint64 `json:"diskTotal" validate:"required"`
+	Processes   uint64 `json:"processes" validate:"required"`
+	CollectedAt string `json:"collectedAt" validate:"required"`
+} // @name ProjectMetrics
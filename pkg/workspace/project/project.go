@@ -9,6 +9,7 @@ import (
 
 	"github.com/daytonaio/daytona/internal"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/workspace/lifecycle"
 	"github.com/daytonaio/daytona/pkg/workspace/project/buildconfig"
 )
 
@@ -17,12 +18,19 @@ type Project struct {
 	Image       string                     `json:"image" validate:"required"`
 	User        string                     `json:"user" validate:"required"`
 	BuildConfig *buildconfig.BuildConfig   `json:"buildConfig,omitempty" validate:"optional"`
+	Resources   *ResourceLimits            `json:"resources,omitempty" validate:"optional"`
 	Repository  *gitprovider.GitRepository `json:"repository" validate:"required"`
 	EnvVars     map[string]string          `json:"envVars" validate:"required"`
-	WorkspaceId string                     `json:"workspaceId" validate:"required"`
-	ApiKey      string                     `json:"-"`
-	Target      string                     `json:"target" validate:"required"`
-	State       *ProjectState              `json:"state,omitempty" validate:"optional"`
+	// Names of the secrets injected into the project as environment variables
+	Secrets     []string            `json:"secrets,omitempty" validate:"optional"`
+	WorkspaceId string              `json:"workspaceId" validate:"required"`
+	ApiKey      string              `json:"-"`
+	Target      string              `json:"target" validate:"required"`
+	State       *ProjectState       `json:"state,omitempty" validate:"optional"`
+	Lifecycle   lifecycle.Lifecycle `json:"lifecycle" validate:"required"`
+	// Uncommitted changes applied to the repository once it is cloned.
+	// They are only read by the project agent and cleared after they have been applied
+	Patch string `json:"-"`
 } // @name Project
 
 type ProjectInfo struct {
@@ -34,9 +42,11 @@ type ProjectInfo struct {
 } // @name ProjectInfo
 
 type ProjectState struct {
-	UpdatedAt string     `json:"updatedAt" validate:"required"`
-	Uptime    uint64     `json:"uptime" validate:"required"`
-	GitStatus *GitStatus `json:"gitStatus" validate:"required"`
+	UpdatedAt    string          `json:"updatedAt" validate:"required"`
+	Uptime       uint64          `json:"uptime" validate:"required"`
+	GitStatus    *GitStatus      `json:"gitStatus" validate:"required"`
+	LastActivity string          `json:"lastActivity,omitempty" validate:"optional"`
+	Metrics      *ProjectMetrics `json:"metrics,omitempty" validate:"optional"`
 } // @name ProjectState
 
 type GitStatus struct {
@@ -44,6 +54,14 @@ type GitStatus struct {
 	Files         []*FileStatus `json:"fileStatus" validate:"required"`
 } // @name GitStatus
 
+// Patch holds the uncommitted changes of a project and the commit they are based on
+type Patch struct {
+	Sha string `json:"sha" validate:"required"`
+	// Remote branch that contains the commit, empty if the commit has not been pushed
+	Branch string `json:"branch,omitempty" validate:"optional"`
+	Diff   string `json:"diff" validate:"required"`
+} // @name ProjectPatch
+
 type FileStatus struct {
 	Name     string `json:"name" validate:"required"`
 	Extra    string `json:"extra" validate:"required"`
@@ -76,6 +94,7 @@ func GetProjectEnvVars(project *Project, params ProjectEnvVarParams, telemetryEn
 		"DAYTONA_WS_ID":                     project.WorkspaceId,
 		"DAYTONA_WS_PROJECT_NAME":           project.Name,
 		"DAYTONA_WS_PROJECT_REPOSITORY_URL": project.Repository.Url,
+		"DAYTONA_WS_PROJECT_TARGET":         project.Target,
 		"DAYTONA_SERVER_API_KEY":            project.ApiKey,
 		"DAYTONA_SERVER_VERSION":            internal.Version,
 		"DAYTONA_SERVER_URL":                params.ServerUrl,
@@ -92,6 +111,12 @@ func GetProjectEnvVars(project *Project, params ProjectEnvVarParams, telemetryEn
 	return envVars
 }
 
+// IsServerEnvVar returns true if the env var is one of the env vars the server sets on projects
+func IsServerEnvVar(name string) bool {
+	_, ok := GetProjectEnvVars(&Project{Repository: &gitprovider.GitRepository{}}, ProjectEnvVarParams{}, true)[name]
+	return ok
+}
+
 func GetProjectHostname(workspaceId string, projectName string) string {
 	// Replace special chars with hyphen to form valid hostname
 	// String resulting in consecutive hyphens is also valid
@@ -0,0 +1,15 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package project
+
+// ResourceLimits caps the resources a project container can use.
+// Limits that are not set are not enforced, and providers that do not support limits ignore them.
+type ResourceLimits struct {
+	// Number of CPUs, fractions are allowed
+	Cpus float64 `json:"cpus,omitempty" validate:"optional"`
+	// Memory in MB
+	Memory uint64 `json:"memory,omitempty" validate:"optional"`
+	// Disk size in GB
+	Disk uint64 `json:"disk,omitempty" validate:"optional"`
+} // @name ResourceLimits
@@ -0,0 +1,14 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import "time"
+
+type Snapshot struct {
+	Id          string    `json:"id" validate:"required"`
+	Name        string    `json:"name" validate:"required"`
+	WorkspaceId string    `json:"workspaceId" validate:"required"`
+	ProjectName string    `json:"projectName" validate:"required"`
+	CreatedAt   time.Time `json:"createdAt" validate:"required"`
+} // @name ProjectSnapshot
@@ -0,0 +1,22 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import "errors"
+
+type Store interface {
+	// List returns the snapshots of a workspace, oldest first
+	List(workspaceId string) ([]*Snapshot, error)
+	Find(id string) (*Snapshot, error)
+	Save(snapshot *Snapshot) error
+	Delete(snapshot *Snapshot) error
+}
+
+var (
+	ErrSnapshotNotFound = errors.New("snapshot not found")
+)
+
+func IsSnapshotNotFound(err error) bool {
+	return err.Error() == ErrSnapshotNotFound.Error()
+}
@@ -5,18 +5,25 @@ package workspace
 
 import (
 	"errors"
+	"time"
 
 	"github.com/daytonaio/daytona/internal"
+	"github.com/daytonaio/daytona/pkg/workspace/lifecycle"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
 )
 
 type Workspace struct {
-	Id       string             `json:"id" validate:"required"`
-	Name     string             `json:"name" validate:"required"`
-	Projects []*project.Project `json:"projects" validate:"required"`
-	Target   string             `json:"target" validate:"required"`
-	ApiKey   string             `json:"-"`
-	EnvVars  map[string]string  `json:"-"`
+	Id        string              `json:"id" validate:"required"`
+	Name      string              `json:"name" validate:"required"`
+	Projects  []*project.Project  `json:"projects" validate:"required"`
+	Target    string              `json:"target" validate:"required"`
+	Lifecycle lifecycle.Lifecycle `json:"lifecycle" validate:"required"`
+	// Minutes of inactivity after which the workspace projects are stopped, 0 falls back to the target setting
+	AutoStop uint32 `json:"autoStop,omitempty" validate:"optional"`
+	// The workspace is removed once it expires, nil means it never expires
+	ExpiresAt *time.Time        `json:"expiresAt,omitempty" validate:"optional"`
+	ApiKey    string            `json:"-"`
+	EnvVars   map[string]string `json:"-"`
 } // @name Workspace
 
 type WorkspaceInfo struct {